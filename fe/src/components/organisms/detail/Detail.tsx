@@ -1,7 +1,7 @@
 'use client';
 import React, { useEffect, useState } from "react";
 import apiConfig from "@/config/api";
-import crypto from "crypto";
+import {signedHeaders} from "@/library/signature";
 import axios from "axios";
 import dynamic from 'next/dynamic';
 import 'owl.carousel/dist/assets/owl.carousel.css';
@@ -18,19 +18,9 @@ export default function Detail({ params }: { params: { uuid: any } }) {
   useEffect(() => {
     const fetchData = async () => {
       try {
-        const serviceName = apiConfig.field.serviceName;
-        const signatureKey = apiConfig.field.signatureKey;
-        const unixTimestamp = Math.floor(Date.now() / 1000);
-        const validateKey = `${serviceName}:${signatureKey}:${unixTimestamp}`;
-        const hash = crypto.createHash('sha256').update(validateKey);
-        const apiKey = hash.digest('hex');
-
-        const response = await axios.get(`${apiConfig.field.baseUrl}/api/v1/field/${uuid}`, {
-          headers: {
-            "x-service-name": serviceName,
-            "x-request-at": unixTimestamp.toString(),
-            "x-api-key": apiKey,
-          },
+        const url = `${apiConfig.field.baseUrl}/api/v1/field/${uuid}`;
+        const response = await axios.get(url, {
+          headers: signedHeaders(apiConfig.field, 'GET', url),
         });
         setResponse(response.data.data);
       } catch (error: any) {
@@ -1,9 +1,9 @@
 'use client'
-import React, {useContext, useEffect, useState} from "react";
+import React, {useContext, useEffect, useRef, useState} from "react";
 import moment from "moment/moment";
 import apiConfig from "@/config/api";
-import {Hash} from "node:crypto";
-import crypto from "crypto";
+import {signedHeaders} from "@/library/signature";
+import {watchSchedules} from "@/library/scheduleStream";
 import axios from "axios";
 import DatePicker from "react-datepicker";
 // @ts-ignore
@@ -21,6 +21,7 @@ export default function Schedule({params}: { params: { uuid: any } }) {
   const [today, setToday] = useState<Date | null>(new Date());
   const [cards, setCards] = useState<any>([]);
   const [selectedSchedule, setSelectedSchedule] = useState<any>([]);
+  const selectedRef = useRef<string[]>([]);
   const [isPayButtonVisible, setPayButtonVisible] = useState(false);
   const [isLoading, setIsLoading] = useState(false);
   const router = useRouter();
@@ -29,22 +30,10 @@ export default function Schedule({params}: { params: { uuid: any } }) {
   const fetchData = async (selectedDate: any) => {
     try {
       const now: string = moment().format('YYYY-MM-DD');
-      const serviceName: string | undefined = apiConfig.field.serviceName;
-      const signatureKey: string | undefined = apiConfig.field.signatureKey;
-      const unixTimestamp: number = Math.floor(Date.now() / 1000);
-      const validateKey: string = `${serviceName}:${signatureKey}:${unixTimestamp}`;
-      const hash: Hash = crypto.createHash('sha256');
-      hash.update(validateKey);
-      const apiKey: string | undefined = hash.digest('hex');
-      const response = await axios.get(`${apiConfig.field.baseUrl}/api/v1/field/schedule/lists/${uuid}`, {
-        headers: {
-          "x-service-name": serviceName,
-          "x-request-at": unixTimestamp.toString(),
-          "x-api-key": apiKey,
-        },
-        params: {
-          date: (selectedDate) ? selectedDate : now,
-        },
+      const query = new URLSearchParams({date: (selectedDate) ? selectedDate : now});
+      const url = `${apiConfig.field.baseUrl}/api/v1/field/schedule/lists/${uuid}?${query}`;
+      const response = await axios.get(url, {
+        headers: signedHeaders(apiConfig.field, 'GET', url),
       });
       const fetchedCards = response.data.data.map((item: any) => ({
         uuid: item.uuid,
@@ -83,12 +72,46 @@ export default function Schedule({params}: { params: { uuid: any } }) {
     return `Rp.${number.toLocaleString('id-ID')}`;
   }
 
+  // Applies status changes pushed by field-service. A selected slot that someone else has
+  // just held or booked is unselected so it is not sent with the order.
+  const applyStatuses = (schedules: any[]) => {
+    const statusByUUID = new Map<string, string>(schedules.map((item: any) => [item.uuid, item.status]));
+    setCards((prev: any) => prev.map((card: any) => {
+      const newStatus = statusByUUID.get(card.uuid);
+      if (newStatus === undefined) {
+        return card;
+      }
+      return {...card, status: newStatus, isSelected: card.isSelected && newStatus === status.AVAILABLE};
+    }));
+
+    const taken = schedules.filter((item: any) => item.status !== status.AVAILABLE).map((item: any) => item.uuid);
+    if (selectedRef.current.some((id: string) => taken.includes(id))) {
+      setSelectedSchedule((prev: any) => prev.filter((id: any) => !taken.includes(id)));
+      toast.warning('Jadwal yang kamu pilih baru saja dipesan orang lain.');
+    }
+  }
+
   useEffect(() => {
     const today: any = moment().format('YYYY-MM-DD');
     setToday(today);
     fetchData(null);
   }, []);
 
+  useEffect(() => {
+    selectedRef.current = selectedSchedule;
+    setPayButtonVisible(selectedSchedule.length > 0);
+  }, [selectedSchedule]);
+
+  // status jadwal diperbarui langsung dari field-service selama halaman terbuka
+  useEffect(() => {
+    const controller = new AbortController();
+    watchSchedules(apiConfig.field, uuid, moment(today).format('YYYY-MM-DD'), {
+      onSnapshot: applyStatuses,
+      onStatus: (schedule: any) => applyStatuses([schedule]),
+    }, controller.signal);
+    return () => controller.abort();
+  }, [today]);
+
   const handleSubmit = async (e: React.MouseEvent<HTMLButtonElement>) => {
     e.preventDefault();
     if (!user) {
@@ -98,13 +121,6 @@ export default function Schedule({params}: { params: { uuid: any } }) {
       }, 2000)
     } else {
       setIsLoading(true);
-      const serviceName: string | undefined = apiConfig.order.serviceName;
-      const signatureKey: string | undefined = apiConfig.order.signatureKey;
-      const unixTimestamp: number = Math.floor(Date.now() / 1000);
-      const validateKey: string = `${serviceName}:${signatureKey}:${unixTimestamp}`;
-      const hash: Hash = crypto.createHash('sha256');
-      hash.update(validateKey);
-      const apiKey: string | undefined = hash.digest('hex');
       const totalPrice = calculateTotalPrice(cards, selectedSchedule);
       Swal.fire({
         title: "Apakah Jadwal sudah sesuai?",
@@ -117,14 +133,13 @@ export default function Schedule({params}: { params: { uuid: any } }) {
       }).then(async (result) => {
         if (result.isConfirmed) {
           console.log("ISI TOKEN:", user.token);
-          await axios.post(`${apiConfig.order.baseUrl}/api/v1/order`, {
-            fieldScheduleIDs: selectedSchedule,
-          }, {
+          const url = `${apiConfig.order.baseUrl}/api/v1/order`;
+          const body = JSON.stringify({fieldScheduleIDs: selectedSchedule});
+          await axios.post(url, body, {
             headers: {
               Authorization: `Bearer ${user.token}`,
-              "x-service-name": serviceName,
-              "x-request-at": unixTimestamp.toString(),
-              "x-api-key": apiKey,
+              "Content-Type": "application/json",
+              ...signedHeaders(apiConfig.order, 'POST', url, body),
             }
           }).then((response: any) => {
             setTimeout(() => {
@@ -165,7 +180,7 @@ export default function Schedule({params}: { params: { uuid: any } }) {
     const updatedCards: any = [...cards];
     const card = updatedCards[index];
 
-    if (card.status !== status.BOOKED) {
+    if (card.status === status.AVAILABLE) {
       card.isSelected = !card.isSelected;
 
       if (card.isSelected) {
@@ -226,13 +241,13 @@ export default function Schedule({params}: { params: { uuid: any } }) {
                 cards.map((card: any, index: number) => (
                   <div className="col-lg-2 mt-3" key={index}>
                     <div
-                      className={`card clickable-card ${card.isSelected ? 'selected' : ''} ${(card.status == status.BOOKED) ? 'booked' : ''}`}
+                      className={`card clickable-card ${card.isSelected ? 'selected' : ''} ${(card.status != status.AVAILABLE) ? 'booked' : ''}`}
                       style={{width: '11rem'}}
                       onClick={() => toggleCardSelection(index, card.uuid)}>
                       <div className="card-body">
                         <div className="d-flex justify-content-between align-items-center">
                           <i
-                            className={`fa-solid fa-xl ${card.isSelected || (card.status == status.BOOKED) ? 'fa-circle-minus' : 'fa-circle-plus'} icon`}></i>
+                            className={`fa-solid fa-xl ${card.isSelected || (card.status != status.AVAILABLE) ? 'fa-circle-minus' : 'fa-circle-plus'} icon`}></i>
                           <p className="mb-0 poppins-medium"><b>{card.date}</b></p>
                         </div>
                         <div className="mt-3">
@@ -296,7 +311,7 @@ export default function Schedule({params}: { params: { uuid: any } }) {
                         <div className="col-4 mt-4" key={index}>
                           <div className="team">
                             <div
-                              className={`card clickable-card ${card.isSelected ? 'selected' : ''} ${(card.status == status.BOOKED) ? 'booked' : ''}`}
+                              className={`card clickable-card ${card.isSelected ? 'selected' : ''} ${(card.status != status.AVAILABLE) ? 'booked' : ''}`}
                               style={{width: '6rem'}}
                               onClick={() => toggleCardSelection(index, card.uuid)}
                             >
@@ -1,8 +1,7 @@
 'use client'
 import { useState, useEffect } from "react";
 import axios from "axios";
-import crypto from "crypto";
-import {Hash} from "node:crypto";
+import {signedHeaders} from "@/library/signature";
 import apiConfig from "@/config/api";
 import Link from "next/link";
 import {toast} from "react-toastify";
@@ -15,19 +14,9 @@ export default function FieldList() {
   useEffect(() => {
     const fetchFields = async () => {
       try {
-        const serviceName: string | undefined = apiConfig.field.serviceName;
-        const signatureKey: string | undefined = apiConfig.field.signatureKey;
-        const unixTimestamp: number = Math.floor(Date.now() / 1000);
-        const validateKey: string = `${serviceName}:${signatureKey}:${unixTimestamp}`;
-        const hash: Hash = crypto.createHash('sha256');
-        hash.update(validateKey);
-        const apiKey: string = hash.digest('hex');
-        const response = await axios.get(`${apiConfig.field.baseUrl}/api/v1/field`, {
-          headers: {
-            "x-service-name": serviceName,
-            "x-request-at": unixTimestamp.toString(),
-            "x-api-key": apiKey,
-          }
+        const url = `${apiConfig.field.baseUrl}/api/v1/field`;
+        const response = await axios.get(url, {
+          headers: signedHeaders(apiConfig.field, 'GET', url),
         });
         setFields(response.data.data);
       } catch (error: any) {
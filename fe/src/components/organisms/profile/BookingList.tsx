@@ -2,8 +2,7 @@
 import Link from "next/link";
 import React, {useContext, useEffect, useState} from "react";
 import apiConfig from "@/config/api";
-import {Hash} from "node:crypto";
-import crypto from "crypto";
+import {signedHeaders} from "@/library/signature";
 import axios from "axios";
 import {toast} from "react-toastify";
 import {AuthContext} from "@/context/AuthProvider";
@@ -16,19 +15,11 @@ export default function BookingList() {
 
   const getBookingData = async () => {
     try {
-      const serviceName: string | undefined = apiConfig.order.serviceName;
-      const signatureKey: string | undefined = apiConfig.order.signatureKey;
-      const unixTimestamp: number = Math.floor(Date.now() / 1000);
-      const validateKey: string = `${serviceName}:${signatureKey}:${unixTimestamp}`;
-      const hash: Hash = crypto.createHash('sha256');
-      hash.update(validateKey);
-      const apiKey: string = hash.digest('hex');
-      const response = await axios.get(`${apiConfig.order.baseUrl}/api/v1/order/user`, {
+      const url = `${apiConfig.order.baseUrl}/api/v1/order/user`;
+      const response = await axios.get(url, {
         headers: {
           Authorization: `Bearer ${userData.token}`,
-          "x-service-name": serviceName,
-          "x-request-at": unixTimestamp.toString(),
-          "x-api-key": apiKey,
+          ...signedHeaders(apiConfig.order, 'GET', url),
         },
       });
       const {data} = response.data;
@@ -7,25 +7,17 @@ import {useRouter} from "next/navigation";
 import axios from "axios";
 import apiConfig from "@/config/api";
 import {toast} from "react-toastify";
-import {Hash} from "node:crypto";
-import crypto from "crypto";
+import {signedHeaders} from "@/library/signature";
 import {AuthContext} from "@/context/AuthProvider";
 import {message} from "@/constants/message";
 
-const serviceName: string | undefined = apiConfig.user.serviceName;
-const signatureKey: string | undefined = apiConfig.user.signatureKey;
-const unixTimestamp: number = Math.floor(Date.now() / 1000);
-const validateKey: string = `${serviceName}:${signatureKey}:${unixTimestamp}`;
-const hash: Hash = crypto.createHash('sha256');
-hash.update(validateKey);
-const apiKey: string = hash.digest('hex');
-
 export default function Detail() {
   const {user} = useContext(AuthContext) as any;
   const [name, setName] = useState('');
   const [username, setUsername] = useState('');
   const [email, setEmail] = useState('');
   const [phoneNumber, setPhoneNumber] = useState('');
+  const [currentPassword, setCurrentPassword] = useState('');
   const [password, setPassword] = useState('');
   const [confirmPassword, setConfirmPassword] = useState('');
   const [errors, setErrors] = useState<any>({});
@@ -38,6 +30,11 @@ export default function Detail() {
     setFieldError('username', e.target.value);
   }
 
+  const handleCurrentPasswordChange = (e: React.ChangeEvent<HTMLInputElement>) => {
+    setCurrentPassword(e.target.value);
+    setFieldError('current_password', e.target.value);
+  }
+
   const handlePasswordChange = (e: React.ChangeEvent<HTMLInputElement>) => {
     setPassword(e.target.value);
     setFieldError('password', e.target.value);
@@ -68,6 +65,7 @@ export default function Detail() {
     name: (value: string) => value.length >= 3,
     email: (value: string) => value.length >= 5,
     phone_number: (value: string) => value.length >= 9,
+    current_password: (value: string) => value.length > 0,
     password: (value: string) => value.length >= 8,
     confirm_password: (value: string) => value === password
   };
@@ -90,6 +88,7 @@ export default function Detail() {
         email: email,
         phoneNumber: phoneNumber,
         username: username,
+        currentPassword: currentPassword,
         password: password,
         confirmPassword: confirmPassword,
       }
@@ -101,12 +100,13 @@ export default function Detail() {
         username: username,
       }
     }
-    await axios.put(`${apiConfig.user.baseUrl}/api/v1/auth/${userData.uuid}`, data, {
+    const url = `${apiConfig.user.baseUrl}/api/v1/auth/me`;
+    const body = JSON.stringify(data);
+    await axios.put(url, body, {
       headers: {
         Authorization: `Bearer ${userData.token}`,
-        "x-service-name": serviceName,
-        "x-request-at": unixTimestamp.toString(),
-        "x-api-key": apiKey,
+        "Content-Type": "application/json",
+        ...signedHeaders(apiConfig.user, 'PUT', url, body),
       },
     }).then(() => {
       setIsLoading(false);
@@ -127,12 +127,11 @@ export default function Detail() {
 
   const getProfile = async () => {
     try {
-      const response = await axios.get(`${apiConfig.user.baseUrl}/api/v1/auth/user`, {
+      const url = `${apiConfig.user.baseUrl}/api/v1/auth/user`;
+      const response = await axios.get(url, {
         headers: {
           Authorization: `Bearer ${userData.token}`,
-          "x-service-name": serviceName,
-          "x-request-at": unixTimestamp.toString(),
-          "x-api-key": apiKey,
+          ...signedHeaders(apiConfig.user, 'GET', url),
         },
       });
       const {data} = response.data;
@@ -261,6 +260,22 @@ export default function Detail() {
                   <div className="col-xl-12 col-lg-12 col-md-12 col-sm-12 col-12">
                     <h6 className="mt-3 mb-2 text-primary poppins-bold">Ganti Password</h6>
                   </div>
+                  <div className="col-xl-6 col-lg-6 col-md-6 col-sm-6 col-12">
+                    <div className="form-group">
+                      <FormGroup
+                        type="password"
+                        name="current_password"
+                        className={`form-control ${styles['form-input']}`}
+                        placeholder="Masukan Password Lama"
+                        label="Password Lama"
+                        onChange={handleCurrentPasswordChange}
+                        labelClassName="poppins-semibold"
+                      />
+                      {errors.CurrentPassword ?
+                        <span className="text-xs text-danger ml-2">{errors.CurrentPassword}</span> : null}
+                    </div>
+                  </div>
+                  <div className="col-xl-6 col-lg-6 col-md-6 col-sm-6 col-12"></div>
                   <div className="col-xl-6 col-lg-6 col-md-6 col-sm-6 col-12">
                     <div className="form-group">
                       <FormGroup
@@ -1,4 +1,5 @@
 export const status = {
   AVAILABLE: "Available",
+  HELD: "Held",
   BOOKED: "Booked",
-}
\ No newline at end of file
+}
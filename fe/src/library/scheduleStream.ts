@@ -0,0 +1,82 @@
+import {signedHeaders} from "@/library/signature";
+
+type SignedService = Parameters<typeof signedHeaders>[0] & { baseUrl: string | undefined };
+
+type ScheduleHandlers = {
+  // all schedules of the date, sent on every (re)connect
+  onSnapshot: (schedules: any[]) => void;
+  // one schedule whose status has just changed
+  onStatus: (schedule: any) => void;
+};
+
+const retryDelay = 3000;
+
+// Follows the status stream of a field's schedules on one date until the signal is
+// aborted. EventSource cannot send the signature headers field-service requires, so the
+// stream is read with fetch instead. Every reconnect is signed again with a fresh nonce
+// and starts with a new snapshot, so no change is missed while disconnected.
+export function watchSchedules(service: SignedService, fieldUUID: string, date: string, handlers: ScheduleHandlers, signal: AbortSignal) {
+  const query = new URLSearchParams({date});
+  const url = `${service.baseUrl}/api/v1/field/schedule/lists/${fieldUUID}/stream?${query}`;
+
+  const follow = async () => {
+    while (!signal.aborted) {
+      try {
+        const response = await fetch(url, {
+          headers: signedHeaders(service, 'GET', url),
+          signal,
+        });
+        if (response.ok && response.body) {
+          await readEvents(response.body, (event, data) => {
+            if (event === 'snapshot') {
+              handlers.onSnapshot(JSON.parse(data));
+            } else if (event === 'status') {
+              handlers.onStatus(JSON.parse(data));
+            }
+          });
+        }
+      } catch (error) {
+        if (signal.aborted) {
+          return;
+        }
+      }
+      await new Promise((resolve) => setTimeout(resolve, retryDelay));
+    }
+  };
+
+  follow();
+}
+
+// Parses a text/event-stream body and calls onEvent for every event that carries data.
+// Comment lines (the server's heartbeat) are skipped.
+async function readEvents(body: ReadableStream<Uint8Array>, onEvent: (event: string, data: string) => void) {
+  const reader = body.pipeThrough(new TextDecoderStream()).getReader();
+  let buffer = '';
+  while (true) {
+    const {value, done} = await reader.read();
+    if (done) {
+      return;
+    }
+    buffer += value;
+
+    let boundary = buffer.indexOf('\n\n');
+    while (boundary >= 0) {
+      const block = buffer.slice(0, boundary);
+      buffer = buffer.slice(boundary + 2);
+      boundary = buffer.indexOf('\n\n');
+
+      let event = 'message';
+      const data: string[] = [];
+      for (const line of block.split('\n')) {
+        if (line.startsWith('event:')) {
+          event = line.slice('event:'.length).trim();
+        } else if (line.startsWith('data:')) {
+          data.push(line.slice('data:'.length).replace(/^ /, ''));
+        }
+      }
+      if (data.length > 0) {
+        onEvent(event, data.join('\n'));
+      }
+    }
+  }
+}
@@ -0,0 +1,43 @@
+import assert from "node:assert/strict";
+import crypto from "crypto";
+import test from "node:test";
+import {canonicalRequest, sign, signedHeaders} from "./signature";
+
+// Same fixed vector as common/signature/signature_test.go in the services.
+const vector = {
+  key: "kita-futsal-signature-key",
+  serviceName: "order-service",
+  method: "post",
+  url: "http://localhost:8001/api/v1/field/schedule/status?source=order",
+  body: '{"fieldScheduleIds":["6f1c2a52-3b0e-4c1e-9c55-2f4a8e7d9b10"]}',
+  timestamp: "1760000000",
+  nonce: "00112233445566778899aabbccddeeff",
+  canonical:
+    "order-service\n" +
+    "POST\n" +
+    "/api/v1/field/schedule/status?source=order\n" +
+    "c66d3b9e64a016e35dd7875306a52f99b4c7e0097f18b6aeb883a51381f7a9af\n" +
+    "1760000000\n" +
+    "00112233445566778899aabbccddeeff",
+  signature: "a909217e3777ace2ebfa460ac02c32cdefcfbc1288d7df5d31b8dd7bbf8233d9",
+};
+
+test("canonical string matches the backend vector", () => {
+  const canonical = canonicalRequest(vector.serviceName, vector.method, vector.url, vector.body, vector.timestamp, vector.nonce);
+  assert.equal(canonical, vector.canonical);
+  assert.equal(sign(vector.key, canonical), vector.signature);
+});
+
+test("signed headers carry a fresh nonce and a matching signature", () => {
+  const service = {serviceName: vector.serviceName, signatureKey: vector.key};
+  const first = signedHeaders(service, "GET", "http://localhost:8001/api/v1/field?page=1");
+  const second = signedHeaders(service, "GET", "http://localhost:8001/api/v1/field?page=1");
+
+  assert.notEqual(first["x-nonce"], second["x-nonce"]);
+  assert.match(first["x-nonce"], /^[0-9a-f]{32}$/);
+  assert.ok(Math.abs(Number(first["x-request-at"]) - Date.now() / 1000) < 5);
+
+  const emptyBodyHash = crypto.createHash("sha256").update("").digest("hex");
+  const canonical = [vector.serviceName, "GET", "/api/v1/field?page=1", emptyBodyHash, first["x-request-at"], first["x-nonce"]].join("\n");
+  assert.equal(first["x-signature"], sign(vector.key, canonical));
+});
@@ -0,0 +1,36 @@
+import crypto from "crypto";
+
+type SignedService = {
+  serviceName: string | undefined;
+  signatureKey: string | undefined;
+};
+
+// Builds the string the backend signs (common/signature in every service). It
+// must stay byte-identical to the Go version; signature.test.ts checks both
+// against the same fixed vector.
+export function canonicalRequest(serviceName: string, method: string, url: string, body: string, timestamp: string, nonce: string) {
+  const {pathname, search} = new URL(url);
+  const bodyHash = crypto.createHash("sha256").update(body).digest("hex");
+  return [serviceName, method.toUpperCase(), pathname + search, bodyHash, timestamp, nonce].join("\n");
+}
+
+export function sign(key: string, canonical: string) {
+  return crypto.createHmac("sha256", key).update(canonical).digest("hex");
+}
+
+// Builds the signature headers for one request. The HMAC covers the method, the
+// path with its query string, the body hash, the timestamp and a nonce that the
+// backend accepts only once, so every request needs a fresh set.
+export function signedHeaders(service: SignedService, method: string, url: string, body: string = "") {
+  const serviceName = service.serviceName ?? "";
+  const timestamp = Math.floor(Date.now() / 1000).toString();
+  const nonce = crypto.randomBytes(16).toString("hex");
+  const canonical = canonicalRequest(serviceName, method, url, body, timestamp, nonce);
+
+  return {
+    "x-service-name": serviceName,
+    "x-request-at": timestamp,
+    "x-nonce": nonce,
+    "x-signature": sign(service.signatureKey ?? "", canonical),
+  };
+}
@@ -0,0 +1,32 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Ping memanggil endpoint liveness milik downstream. Circuit breaker dan retry sengaja
+// dilewati agar readiness probe tidak membuka breaker dan tidak menutupi gangguan.
+func (c *ClientConfig) Ping(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseUrl+"/healthz", nil)
+	if err != nil {
+		return err
+	}
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	_, _ = io.Copy(io.Discard, response.Body)
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s liveness returned %d", c.name, response.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/anddriii/kita-futsal/field-service/clients/config"
+	"github.com/anddriii/kita-futsal/field-service/constants"
+)
+
+// OrderClient adalah struct yang digunakan untuk melakukan komunikasi dengan Order Service.
+type OrderClient struct {
+	client config.IClientConfig
+}
+
+type IOrderClient interface {
+	CheckReviewEligibility(ctx context.Context, orderID string, fieldID string) (*ReviewEligibilityData, error)
+}
+
+func NewOrderClient(client config.IClientConfig) IOrderClient {
+	return &OrderClient{client: client}
+}
+
+// CheckReviewEligibility menanyakan ke order-service apakah order milik user yang sedang login
+// sudah dibayar dan jadwalnya di lapangan tersebut sudah selesai dimainkan.
+// Token user diteruskan apa adanya sehingga order-service bisa memastikan pemilik order.
+func (o *OrderClient) CheckReviewEligibility(ctx context.Context, orderID string, fieldID string) (*ReviewEligibilityData, error) {
+	token := ctx.Value(constants.Token).(string)
+	bearerToken := fmt.Sprintf("Bearer %s", token)
+
+	resp, err := o.client.Do(ctx, config.Request{
+		Method: http.MethodGet,
+		URL:    fmt.Sprintf("%s/api/v1/order/%s/review-eligibility", o.client.BaseUrl(), orderID),
+		Header: map[string]string{
+			constants.Authorization: bearerToken,
+		},
+		Query: url.Values{"fieldID": []string{fieldID}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response ReviewEligibilityResponse
+	err = resp.Decode(&response)
+	if err != nil {
+		return nil, err
+	}
+
+	// order-service menolak order yang tidak ditemukan, bukan milik user, atau belum memenuhi syarat
+	if resp.StatusCode == http.StatusBadRequest || resp.StatusCode == http.StatusForbidden {
+		return &ReviewEligibilityData{Eligible: false}, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("order response: %s", response.Message)
+	}
+
+	return &response.Data, nil
+}
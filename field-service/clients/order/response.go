@@ -0,0 +1,18 @@
+package clients
+
+import "github.com/google/uuid"
+
+type ReviewEligibilityResponse struct {
+	Code    int                   `json:"code"`
+	Status  string                `json:"status"`
+	Message string                `json:"message"`
+	Data    ReviewEligibilityData `json:"data"`
+}
+
+type ReviewEligibilityData struct {
+	OrderID          uuid.UUID   `json:"orderID"`
+	UserID           uuid.UUID   `json:"userID"`
+	FieldID          uuid.UUID   `json:"fieldID"`
+	FieldScheduleIDs []uuid.UUID `json:"fieldScheduleIDs"`
+	Eligible         bool        `json:"eligible"`
+}
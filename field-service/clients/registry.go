@@ -2,6 +2,7 @@ package clients
 
 import (
 	"github.com/anddriii/kita-futsal/field-service/clients/config"
+	orderClient "github.com/anddriii/kita-futsal/field-service/clients/order"
 	clients "github.com/anddriii/kita-futsal/field-service/clients/user"
 	config2 "github.com/anddriii/kita-futsal/field-service/config"
 )
@@ -11,6 +12,8 @@ type ClientRegistry struct {
 
 type IClientRegistry interface {
 	GetUser() clients.IUserClient
+	GetOrder() orderClient.IOrderClient
+	Downstreams() []config.IClientConfig
 }
 
 func NewClientRegistry() IClientRegistry {
@@ -18,10 +21,30 @@ func NewClientRegistry() IClientRegistry {
 }
 
 func (c *ClientRegistry) GetUser() clients.IUserClient {
-	return clients.NewUserClient(
-		config.NewClientConfig(
-			config.WithBaseURL(config2.Config.InternalService.User.Host),
-			config.WithSignatureKey(config2.Config.InternalService.User.SignatureKey),
-		))
+	return clients.NewUserClient(userConfig())
+}
+
+func (c *ClientRegistry) GetOrder() orderClient.IOrderClient {
+	return orderClient.NewOrderClient(orderConfig())
+}
+
+// Downstreams berisi semua service yang dipanggil oleh service ini, untuk pemeriksaan readiness.
+func (c *ClientRegistry) Downstreams() []config.IClientConfig {
+	return []config.IClientConfig{userConfig(), orderConfig()}
+}
+
+func userConfig() config.IClientConfig {
+	return config.NewClientConfig(
+		config.WithName("user-service"),
+		config.WithBaseURL(config2.Config.InternalService.User.Host),
+		config.WithSignatureKey(config2.Config.InternalService.User.SignatureKey),
+	)
+}
 
+func orderConfig() config.IClientConfig {
+	return config.NewClientConfig(
+		config.WithName("order-service"),
+		config.WithBaseURL(config2.Config.InternalService.Order.Host),
+		config.WithSignatureKey(config2.Config.InternalService.Order.SignatureKey),
+	)
 }
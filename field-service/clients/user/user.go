@@ -4,11 +4,8 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"time"
 
 	"github.com/anddriii/kita-futsal/field-service/clients/config"
-	"github.com/anddriii/kita-futsal/field-service/common/util"
-	config2 "github.com/anddriii/kita-futsal/field-service/config"
 	"github.com/anddriii/kita-futsal/field-service/constants"
 )
 
@@ -26,27 +23,24 @@ func NewUserClient(client config.IClientConfig) IUserClient {
 }
 
 func (u *UserClient) GetUserByToken(ctx context.Context) (*UserData, error) {
-	unixTime := time.Now().Unix()
-	generateAPIKey := fmt.Sprintf("%s:%s:%d",
-		config2.Config.AppName,
-		u.client.SignatureKey(),
-		unixTime,
-	)
-	apiKey := util.GenerateSHA256(generateAPIKey)
 	token := ctx.Value(constants.Token).(string)
 	bearerToken := fmt.Sprintf("Bearer %s", token)
 
+	resp, err := u.client.Do(ctx, config.Request{
+		Method: http.MethodGet,
+		URL:    fmt.Sprintf("%s/api/v1/auth/user", u.client.BaseUrl()),
+		Header: map[string]string{
+			constants.Authorization: bearerToken,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	var response UserResponse
-	request := u.client.Client().Clone().
-		Set(constants.Authorization, bearerToken).
-		Set(constants.XServiceName, config2.Config.AppName).
-		Set(constants.XApiKey, apiKey).
-		Set(constants.XRequestAt, fmt.Sprintf("%d", unixTime)).
-		Get(fmt.Sprintf("%s/api/v1/auth/user", u.client.BaseUrl()))
-
-	resp, _, errs := request.EndStruct(&response)
-	if len(errs) > 0 {
-		return nil, errs[0]
+	err = resp.Decode(&response)
+	if err != nil {
+		return nil, err
 	}
 
 	if resp.StatusCode != http.StatusOK {
@@ -1,28 +1,47 @@
 package cmd
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
-	"log"
 	"net/http"
 	"time"
 
 	"github.com/anddriii/kita-futsal/field-service/clients"
+	"github.com/anddriii/kita-futsal/field-service/common/cache"
 	"github.com/anddriii/kita-futsal/field-service/common/gcs"
+	"github.com/anddriii/kita-futsal/field-service/common/health"
+	"github.com/anddriii/kita-futsal/field-service/common/lifecycle"
+	"github.com/anddriii/kita-futsal/field-service/common/logger"
+	"github.com/anddriii/kita-futsal/field-service/common/metrics"
+	"github.com/anddriii/kita-futsal/field-service/common/realtime"
 	"github.com/anddriii/kita-futsal/field-service/common/response"
+	"github.com/anddriii/kita-futsal/field-service/common/signature"
+	"github.com/anddriii/kita-futsal/field-service/common/tracing"
 	"github.com/anddriii/kita-futsal/field-service/config"
 	"github.com/anddriii/kita-futsal/field-service/constants"
 	"github.com/anddriii/kita-futsal/field-service/controllers"
-	"github.com/anddriii/kita-futsal/field-service/domains/models"
+	grpcController "github.com/anddriii/kita-futsal/field-service/controllers/grpc"
+	"github.com/anddriii/kita-futsal/field-service/middlewares"
+	pb "github.com/anddriii/kita-futsal/field-service/proto/field"
 	"github.com/anddriii/kita-futsal/field-service/repositories"
 	"github.com/anddriii/kita-futsal/field-service/routes"
 	"github.com/anddriii/kita-futsal/field-service/services"
 	"github.com/gin-gonic/gin"
 	"github.com/joho/godotenv"
 	"github.com/redis/go-redis/v9"
+	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
 )
 
+// shutdownTimeout membatasi waktu yang diberikan ke request yang sedang berjalan
+// dan job yang sedang dieksekusi untuk selesai setelah SIGTERM.
+const shutdownTimeout = 30 * time.Second
+
+// probeTimeout membatasi durasi setiap pemeriksaan dependency pada endpoint readiness.
+const probeTimeout = 2 * time.Second
+
 // command adalah objek Cobra untuk menjalankan perintah "serve"
 var command = cobra.Command{
 	Use:   "serve",
@@ -31,33 +50,52 @@ var command = cobra.Command{
 		// Memuat variabel lingkungan dari file .env
 		_ = godotenv.Load()
 		config.Init()
-		fmt.Printf("Config setelah Init: %+v\n", config.Config)
+		// Logger JSON dipasang paling awal supaya semua log berikutnya sudah terstruktur
+		logger.Init(config.Config.AppName)
+
+		// Tracer dipasang sebelum database supaya query pertama ikut tercatat
+		shutdownTracing, err := tracing.Init(
+			config.Config.AppName,
+			config.Config.Tracing.Exporter,
+			config.Config.Tracing.Endpoint,
+			config.Config.Tracing.Insecure,
+		)
+		if err != nil {
+			logrus.Fatalf("error in init tracing %s", err)
+		}
 
 		// Inisialisasi koneksi database
 		db, err := config.InitDB()
 		if err != nil {
-			log.Fatalf("error in config init db %s", err)
+			logrus.Fatalf("error in config init db %s", err)
 			panic(err)
 		}
 
+		// Mencatat durasi setiap query GORM ke metric Prometheus
+		err = db.Use(metrics.GormPlugin{})
+		if err != nil {
+			logrus.Fatalf("error in register gorm metrics %s", err)
+		}
+
+		// Mencatat setiap query GORM sebagai span di trace request
+		err = db.Use(tracing.GormPlugin{})
+		if err != nil {
+			logrus.Fatalf("error in register gorm tracing %s", err)
+		}
+
 		// Mengatur zona waktu lokal ke "Asia/Jakarta"
 		loc, err := time.LoadLocation("Asia/Jakarta")
 		if err != nil {
-			log.Fatalf("load location %s", err)
+			logrus.Fatalf("load location %s", err)
 			panic(err)
 		}
 		time.Local = loc
 
-		// Migrasi database untuk model Role dan User
-		err = db.AutoMigrate(
-			&models.Field{},
-			&models.FieldSchedule{},
-			&models.Time{},
-		)
-		fmt.Println(models.Field{})
+		// Menjalankan migrasi SQL yang belum diterapkan. Replica yang start bersamaan
+		// menunggu lewat advisory lock, jadi setiap migrasi hanya dijalankan sekali
+		err = runMigrations(context.Background(), loadMigrator(db))
 		if err != nil {
-			log.Fatalf("error in migrate %s", err)
-			panic(err)
+			logrus.Fatalf("error in migrate %s", err)
 		}
 
 		// Inisialisasi klien GCS
@@ -67,19 +105,58 @@ var command = cobra.Command{
 			Addr: config.Config.Redis.Addr,
 		})
 
+		// Nonce request antar service disimpan di Redis untuk menolak replay
+		middlewares.SetNonceStore(signature.NewRedisNonceStore(rdb))
+
 		// Inisialisasi repository, service, dan controller
 		repository := repositories.NewRepositoryRegistry(db)
-		service := services.NewServiceRegistry(repository, gcsClient, rdb)
+		// Cache hasil query dipakai bersama semua service, TTL default 5 menit
+		appCache := cache.New(rdb, time.Duration(config.Config.CacheTTLSecond)*time.Second)
+		// Perubahan status jadwal disebar lewat Redis pub/sub ke client di semua replica
+		hub := realtime.NewHub(rdb)
+		service := services.NewServiceRegistry(repository, gcsClient, appCache, hub, client)
 		controller := controllers.NewControllerRegistry(service)
 
+		sqlDB, err := db.DB()
+		if err != nil {
+			logrus.Fatalf("error in get sql db %s", err)
+		}
+
+		// Readiness memeriksa database dan Redis, sedangkan service lain hanya dilaporkan
+		checker := health.NewChecker(probeTimeout,
+			health.Check{Name: "postgres", Probe: sqlDB.PingContext},
+			health.Check{Name: "redis", Probe: func(ctx context.Context) error {
+				return rdb.Ping(ctx).Err()
+			}},
+		)
+		for _, downstream := range client.Downstreams() {
+			checker.Add(health.Check{Name: downstream.Name(), Optional: true, Probe: downstream.Ping})
+		}
+
 		// Membuat instance router Gin
-		router := gin.Default()
+		router := gin.New()
+		// Controller meneruskan *gin.Context ke service sebagai context.Context, jadi
+		// nilai di context request (span, user login) harus bisa dibaca lewat gin.Context
+		router.ContextWithFallback = true
+
+		// Middleware untuk memberi setiap request ID yang ikut ke log dan ke service lain
+		router.Use(middlewares.RequestID())
+
+		// Middleware untuk membuat span setiap request, menyambung trace dari header traceparent
+		router.Use(tracing.Middleware())
+
+		// Middleware untuk menulis access log JSON, menggantikan logger bawaan gin
+		router.Use(middlewares.Logger())
+
+		// Middleware untuk mencatat durasi dan status setiap request ke metric Prometheus
+		router.Use(middlewares.Metrics())
 
 		//membuat static route agar foto bisa diakses
 		router.Static("/assets", "/app/assets")
 
-		// Middleware untuk menangani panic dan mengembalikan response yang sesuai
-		// router.Use(middlewares.HandlePanic())
+		// Middleware untuk menangani panic dan mengembalikan response yang sesuai,
+		// menggantikan recovery bawaan gin
+		router.Use(middlewares.HandlePanic())
 
 		// Handler untuk route yang tidak ditemukan
 		router.NoRoute(func(ctx *gin.Context) {
@@ -97,12 +174,18 @@ var command = cobra.Command{
 			})
 		})
 
+		// Endpoint liveness dan readiness untuk probe Kubernetes
+		router.GET("/healthz", health.Liveness)
+		router.GET("/readyz", checker.Readiness)
+		router.GET("/metrics", gin.WrapH(metrics.Handler()))
+
 		// Middleware untuk menangani CORS (Cross-Origin Resource Sharing)
 		router.Use(func(ctx *gin.Context) {
 			// FIX TYPO: 'Access', bukan 'Acces'
 			ctx.Writer.Header().Set("Access-Control-Allow-Origin", "*")
 			ctx.Writer.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-			ctx.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, x-service-name, x-api-key, x-request-at")
+			ctx.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, x-service-name, x-request-at, x-nonce, x-signature, x-request-id, traceparent, tracestate, baggage")
+			ctx.Writer.Header().Set("Access-Control-Expose-Headers", "X-Request-ID")
 
 			// TANGANI PREFLIGHT REQUEST
 			// Kalau method-nya OPTIONS, kasih response sukses 204 dan STOP di sini.
@@ -126,9 +209,35 @@ var command = cobra.Command{
 		route := routes.NewRouteRegistry(controller, group, client)
 		route.Serve()
 
-		// Menjalankan server pada port yang telah dikonfigurasi
-		port := fmt.Sprintf(":%d", config.Config.Port)
-		router.Run(port)
+		// Komponen dihentikan dengan urutan terbalik: HTTP dan gRPC berhenti menerima
+		// request lebih dulu, lalu langganan realtime dan job waitlist, baru kemudian
+		// Redis dan database ditutup
+		app := lifecycle.New(shutdownTimeout)
+		app.AddCloser("tracer", shutdownTracing)
+		app.AddCloser("database", sqlDB.Close)
+		app.AddCloser("redis", rdb.Close)
+		app.Add(lifecycle.Ticker("waitlist expiry", time.Minute, func(ctx context.Context) {
+			err := service.GetWaitlist().ExpireClaims(ctx)
+			if err != nil {
+				logrus.Errorf("error in waitlist expiry %s", err)
+			}
+		}))
+		app.Add(hub.Component())
+		app.Add(lifecycle.GrpcServer(grpcServer(service), fmt.Sprintf(":%d", config.Config.GrpcPort)))
+		httpServer := &http.Server{
+			Addr:    fmt.Sprintf(":%d", config.Config.Port),
+			Handler: router,
+		}
+		// Stream status jadwal tidak pernah selesai sendiri, jadi diputus saat shutdown
+		// dimulai agar Shutdown tidak menunggu sampai timeout
+		httpServer.RegisterOnShutdown(hub.Close)
+		app.Add(lifecycle.HTTPServer(httpServer))
+
+		err = app.Run()
+		if err != nil {
+			logrus.Fatalf("error in shutdown %s", err)
+		}
+		logrus.Info("server stopped")
 	},
 }
 
@@ -136,16 +245,27 @@ var command = cobra.Command{
 func Run() {
 	err := command.Execute()
 	if err != nil {
-		log.Fatalf("error run %s", err)
+		logrus.Fatalf("error run %s", err)
 		panic(err)
 	}
-	log.Println("Server running on port 8001")
+}
+
+// grpcServer membuat server gRPC untuk API internal. Kredensial antar service
+// divalidasi oleh interceptor dengan skema yang sama seperti header HTTP, setelah
+// span panggilan dibuat.
+func grpcServer(service services.IServiceRegistry) *grpc.Server {
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		tracing.UnaryServerInterceptor(),
+		middlewares.GrpcAuthenticate(),
+	))
+	pb.RegisterFieldServiceServer(server, grpcController.NewFieldServer(service))
+	return server
 }
 
 func initGCS() gcs.IGCSClient {
 	decode, err := base64.StdEncoding.DecodeString(config.Config.GCSPrivateKey)
 	if err != nil {
-		log.Fatalf("error in initGCS %s", err)
+		logrus.Fatalf("error in initGCS %s", err)
 		panic(err)
 	}
 
@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/anddriii/kita-futsal/field-service/common/logger"
+	"github.com/anddriii/kita-futsal/field-service/common/migration"
+	"github.com/anddriii/kita-futsal/field-service/config"
+	"github.com/anddriii/kita-futsal/field-service/migrations"
+	"github.com/joho/godotenv"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"gorm.io/gorm"
+)
+
+// downSteps adalah jumlah migrasi yang dibatalkan oleh "migrate down".
+var downSteps int
+
+// migrateCommand mengelompokkan perintah untuk skema database.
+var migrateCommand = cobra.Command{
+	Use:   "migrate",
+	Short: "Manage database schema migrations",
+}
+
+var migrateUpCommand = cobra.Command{
+	Use:   "up",
+	Short: "Apply all pending migrations",
+	Run: func(cmd *cobra.Command, args []string) {
+		migrator := newMigrator()
+		err := runMigrations(context.Background(), migrator)
+		if err != nil {
+			logrus.Fatalf("error in migrate up %s", err)
+		}
+	},
+}
+
+var migrateDownCommand = cobra.Command{
+	Use:   "down",
+	Short: "Revert the latest applied migrations",
+	Run: func(cmd *cobra.Command, args []string) {
+		migrator := newMigrator()
+		reverted, err := migrator.Down(context.Background(), downSteps)
+		for _, item := range reverted {
+			logrus.Infof("reverted migration %d_%s", item.Version, item.Name)
+		}
+		if err != nil {
+			logrus.Fatalf("error in migrate down %s", err)
+		}
+	},
+}
+
+var migrateStatusCommand = cobra.Command{
+	Use:   "status",
+	Short: "Show applied and pending migrations",
+	Run: func(cmd *cobra.Command, args []string) {
+		migrator := newMigrator()
+		statuses, err := migrator.Status(context.Background())
+		if err != nil {
+			logrus.Fatalf("error in migrate status %s", err)
+		}
+
+		writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(writer, "VERSION\tNAME\tAPPLIED AT")
+		for _, status := range statuses {
+			appliedAt := "pending"
+			if status.AppliedAt != nil {
+				appliedAt = status.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Fprintf(writer, "%04d\t%s\t%s\n", status.Version, status.Name, appliedAt)
+		}
+		writer.Flush()
+	},
+}
+
+func init() {
+	migrateDownCommand.Flags().IntVar(&downSteps, "steps", 1, "number of migrations to revert")
+	migrateCommand.AddCommand(&migrateUpCommand, &migrateDownCommand, &migrateStatusCommand)
+	command.AddCommand(&migrateCommand)
+}
+
+// newMigrator memuat konfigurasi dan membuka database untuk perintah migrate.
+func newMigrator() *migration.Migrator {
+	_ = godotenv.Load()
+	config.Init()
+	logger.Init(config.Config.AppName)
+
+	db, err := config.InitDB()
+	if err != nil {
+		logrus.Fatalf("error in config init db %s", err)
+	}
+	return loadMigrator(db)
+}
+
+func loadMigrator(db *gorm.DB) *migration.Migrator {
+	migrator, err := migration.New(db, migrations.FS)
+	if err != nil {
+		logrus.Fatalf("error in load migrations %s", err)
+	}
+	return migrator
+}
+
+// runMigrations menjalankan migrasi yang belum diterapkan dan mencatat setiap versi
+// yang baru dijalankan.
+func runMigrations(ctx context.Context, migrator *migration.Migrator) error {
+	applied, err := migrator.Up(ctx)
+	for _, item := range applied {
+		logrus.Infof("applied migration %d_%s", item.Version, item.Name)
+	}
+	return err
+}
@@ -0,0 +1,82 @@
+package authz
+
+import (
+	"context"
+
+	clients "github.com/anddriii/kita-futsal/field-service/clients/user"
+	"github.com/anddriii/kita-futsal/field-service/constants"
+	errConst "github.com/anddriii/kita-futsal/field-service/constants/error"
+	"github.com/anddriii/kita-futsal/field-service/domains/models"
+	venueRepo "github.com/anddriii/kita-futsal/field-service/repositories/venue"
+)
+
+// GetUserLogin mengambil data user yang sedang login dari context.
+// Data ini diisi oleh middleware CheckRole, sehingga bernilai nil pada endpoint tanpa token.
+func GetUserLogin(ctx context.Context) *clients.UserData {
+	user, _ := ctx.Value(constants.UserLogin).(*clients.UserData)
+	return user
+}
+
+// IsVenueManager mengecek apakah user yang sedang login memiliki role venue manager.
+func IsVenueManager(ctx context.Context) bool {
+	user := GetUserLogin(ctx)
+	return user != nil && user.Role == constants.VenueManager
+}
+
+// IsAdmin mengecek apakah user yang sedang login memiliki role admin.
+func IsAdmin(ctx context.Context) bool {
+	user := GetUserLogin(ctx)
+	return user != nil && user.Role == constants.Admin
+}
+
+// CanManageVenue memastikan user boleh mengelola venue tertentu.
+// Admin dan pemanggil internal (tanpa user login) selalu diizinkan,
+// sedangkan venue manager hanya boleh mengelola venue yang dia pegang.
+func CanManageVenue(ctx context.Context, venue *models.Venue) error {
+	if !IsVenueManager(ctx) {
+		return nil
+	}
+
+	user := GetUserLogin(ctx)
+	if venue == nil || venue.ManagerID == nil || *venue.ManagerID != user.UUID {
+		return errConst.ErrForbidden
+	}
+
+	return nil
+}
+
+// ResolveVenueFilter menentukan daftar ID venue yang boleh dilihat pada endpoint listing.
+// Untuk venue manager hasilnya selalu dibatasi ke venue yang dia kelola, sedangkan admin
+// hanya difilter jika venueID dikirim. Nilai nil berarti tidak ada filter venue.
+func ResolveVenueFilter(ctx context.Context, repository venueRepo.IVenueRepository, venueID *string) ([]uint, error) {
+	if IsVenueManager(ctx) {
+		venues, err := repository.FindByManagerID(ctx, GetUserLogin(ctx).UUID.String())
+		if err != nil {
+			return nil, err
+		}
+
+		venueIDs := make([]uint, 0, len(venues))
+		for _, venue := range venues {
+			if venueID != nil && *venueID != venue.UUID.String() {
+				continue
+			}
+			venueIDs = append(venueIDs, venue.ID)
+		}
+
+		if venueID != nil && len(venueIDs) == 0 {
+			return nil, errConst.ErrForbidden
+		}
+		return venueIDs, nil
+	}
+
+	if venueID == nil || *venueID == "" {
+		return nil, nil
+	}
+
+	venue, err := repository.FindByUUID(ctx, *venueID)
+	if err != nil {
+		return nil, err
+	}
+
+	return []uint{venue.ID}, nil
+}
@@ -0,0 +1,141 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/anddriii/kita-futsal/field-service/common/logger"
+	"github.com/anddriii/kita-futsal/field-service/common/metrics"
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/sync/singleflight"
+)
+
+// Namespace mengelompokkan key cache yang dibatalkan bersama. Setiap namespace punya
+// nomor versi di Redis yang ikut menjadi bagian key; mutasi cukup menaikkan versi itu
+// sehingga semua key lama (per entity maupun per query) tidak terbaca lagi dan habis
+// sendiri oleh TTL.
+type Namespace string
+
+const (
+	// Fields berisi detail dan daftar lapangan, termasuk nama venue dan rating di dalamnya.
+	Fields Namespace = "fields"
+	// Schedules berisi detail jadwal dan daftar jadwal per lapangan dan tanggal.
+	Schedules Namespace = "schedules"
+)
+
+// DefaultTTL dipakai jika TTL cache tidak diatur di konfigurasi.
+const DefaultTTL = 5 * time.Minute
+
+// Cache menyimpan hasil query di Redis dengan pola cache-aside. Request yang meminta key
+// yang sama secara bersamaan saat cache kosong hanya memicu satu query ke database.
+type Cache struct {
+	redis *redis.Client
+	ttl   time.Duration
+	group singleflight.Group
+}
+
+// New membuat Cache. Satu instance dipakai bersama oleh semua service supaya
+// singleflight bisa menggabungkan request yang sama.
+func New(rdb *redis.Client, ttl time.Duration) *Cache {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	return &Cache{redis: rdb, ttl: ttl}
+}
+
+// Get mengembalikan nilai key dari cache atau menjalankan load lalu menyimpan hasilnya.
+// Error dari load tidak disimpan. Jika Redis tidak bisa dihubungi, load tetap dijalankan
+// supaya request tidak gagal hanya karena cache.
+func Get[T any](ctx context.Context, c *Cache, namespace Namespace, key string, load func(context.Context) (T, error)) (T, error) {
+	version, err := c.version(ctx, namespace)
+	if err != nil {
+		logger.FromContext(ctx).Errorf("failed to read cache version of %s: %v", namespace, err)
+		metrics.CacheMiss(string(namespace))
+		return load(ctx)
+	}
+	fullKey := fmt.Sprintf("cache:%s:v%d:%s", namespace, version, key)
+
+	cached, err := c.redis.Get(ctx, fullKey).Bytes()
+	if err == nil {
+		var value T
+		if json.Unmarshal(cached, &value) == nil {
+			metrics.CacheHit(string(namespace))
+			return value, nil
+		}
+	} else if !errors.Is(err, redis.Nil) {
+		logger.FromContext(ctx).Errorf("failed to read cache %s: %v", fullKey, err)
+	}
+	metrics.CacheMiss(string(namespace))
+
+	// query dijalankan tanpa pembatalan dari request pertama, karena hasilnya juga
+	// ditunggu oleh request lain yang digabungkan
+	result, err, _ := c.group.Do(fullKey, func() (any, error) {
+		loadCtx := context.WithoutCancel(ctx)
+		value, err := load(loadCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := json.Marshal(value)
+		if err == nil {
+			err = c.redis.Set(loadCtx, fullKey, data, c.ttl).Err()
+		}
+		if err != nil {
+			logger.FromContext(ctx).Errorf("failed to write cache %s: %v", fullKey, err)
+		}
+		return value, nil
+	})
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return result.(T), nil
+}
+
+// Invalidate membatalkan semua key di namespace dengan menaikkan versinya. Dipanggil
+// setelah perubahan tersimpan di database; jika gagal, data lama tetap hilang setelah TTL.
+func (c *Cache) Invalidate(ctx context.Context, namespaces ...Namespace) {
+	for _, namespace := range namespaces {
+		err := c.redis.Incr(ctx, versionKey(namespace)).Err()
+		if err != nil {
+			logger.FromContext(ctx).Errorf("failed to invalidate cache %s: %v", namespace, err)
+			continue
+		}
+		metrics.CacheInvalidated(string(namespace))
+	}
+}
+
+func (c *Cache) version(ctx context.Context, namespace Namespace) (int64, error) {
+	version, err := c.redis.Get(ctx, versionKey(namespace)).Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	return version, err
+}
+
+func versionKey(namespace Namespace) string {
+	return fmt.Sprintf("cache:%s:version", namespace)
+}
+
+// Key menyusun key dari beberapa bagian, misalnya Key("uuid", fieldUUID).
+func Key(parts ...string) string {
+	return strings.Join(parts, ":")
+}
+
+// QueryKey menyusun key untuk hasil query dari parameternya. Parameter di-hash supaya
+// panjang key tetap dan urutan field struct menentukan hasilnya.
+func QueryKey(name string, params any) string {
+	data, err := json.Marshal(params)
+	if err != nil {
+		// parameter yang tidak bisa di-encode tetap mendapat key unik per nilai
+		data = []byte(fmt.Sprintf("%#v", params))
+	}
+	sum := sha256.Sum256(data)
+	return Key(name, hex.EncodeToString(sum[:16]))
+}
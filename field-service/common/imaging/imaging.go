@@ -0,0 +1,181 @@
+package imaging
+
+import (
+	"bytes"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"net/http"
+	"path"
+	"regexp"
+	"strings"
+
+	errConst "github.com/anddriii/kita-futsal/field-service/constants/error"
+	"github.com/google/uuid"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+// MaxFileSize adalah ukuran maksimal satu file gambar yang diunggah.
+const MaxFileSize = 5 * 1024 * 1024
+
+// maxPixels membatasi resolusi gambar supaya file kecil yang berisi gambar sangat besar
+// (decompression bomb) tidak menghabiskan memori saat di-decode.
+const maxPixels = 40_000_000
+
+// jpegQuality dipakai untuk gambar asli dan semua variannya.
+const jpegQuality = 85
+
+// Variant adalah ukuran turunan gambar. MaxSide adalah panjang sisi terpanjang dalam
+// piksel; gambar yang lebih kecil tidak diperbesar.
+type Variant struct {
+	Name    string
+	MaxSide int
+}
+
+var (
+	Thumbnail = Variant{Name: "thumb", MaxSide: 320}
+	Medium    = Variant{Name: "medium", MaxSide: 800}
+	Large     = Variant{Name: "large", MaxSide: 1600}
+)
+
+// Variants adalah semua varian yang dibuat untuk setiap gambar.
+var Variants = []Variant{Thumbnail, Medium, Large}
+
+// allowedTypes memetakan MIME hasil sniffing ke nama format dari image.Decode.
+var allowedTypes = map[string]string{
+	"image/jpeg": "jpeg",
+	"image/png":  "png",
+	"image/webp": "webp",
+}
+
+// generatedName mencocokkan nama file yang dibuat oleh Process. Gambar lama yang
+// diunggah sebelum ada varian tidak cocok dengan pola ini.
+var generatedName = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}\.(jpg|png)$`)
+
+// File adalah hasil pemrosesan yang siap disimpan.
+type File struct {
+	Name        string
+	ContentType string
+	Data        []byte
+}
+
+// Process memvalidasi isi file berdasarkan magic byte, lalu meng-encode ulang gambar
+// sehingga metadata seperti EXIF (termasuk lokasi GPS) ikut terbuang. Nama file dibuat
+// oleh server; nama dari client tidak dipakai sama sekali. Elemen pertama hasilnya
+// adalah gambar asli, diikuti satu file untuk setiap varian.
+func Process(data []byte) ([]File, error) {
+	if len(data) > MaxFileSize {
+		return nil, errConst.ErrSizeTooBig
+	}
+
+	format, ok := allowedTypes[http.DetectContentType(data)]
+	if !ok {
+		return nil, errConst.ErrInvalidUploadFile
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || config.Width <= 0 || config.Height <= 0 {
+		return nil, errConst.ErrInvalidUploadFile
+	}
+	if config.Width*config.Height > maxPixels {
+		return nil, errConst.ErrSizeTooBig
+	}
+
+	img, decodedFormat, err := image.Decode(bytes.NewReader(data))
+	if err != nil || decodedFormat != format {
+		return nil, errConst.ErrInvalidUploadFile
+	}
+
+	// orientasi dari EXIF diterapkan ke piksel sebelum EXIF dibuang, supaya foto dari
+	// kamera ponsel tidak tampil miring
+	if format == "jpeg" {
+		img = orient(img, exifOrientation(data))
+	}
+
+	// gambar tanpa transparansi disimpan sebagai JPEG, sisanya PNG agar alpha tidak hilang
+	ext, contentType, encode := ".jpg", "image/jpeg", encodeJPEG
+	if !isOpaque(img) {
+		ext, contentType, encode = ".png", "image/png", png.Encode
+	}
+
+	name := uuid.NewString() + ext
+	original, err := encodeImage(img, encode)
+	if err != nil {
+		return nil, err
+	}
+
+	files := []File{{Name: name, ContentType: contentType, Data: original}}
+	for _, variant := range Variants {
+		resized, err := encodeImage(resize(img, variant.MaxSide), encode)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, File{Name: VariantName(name, variant), ContentType: contentType, Data: resized})
+	}
+
+	return files, nil
+}
+
+// VariantName mengembalikan nama file varian dari nama gambar asli, misalnya
+// "<uuid>.jpg" menjadi "<uuid>_thumb.jpg".
+func VariantName(fileName string, variant Variant) string {
+	ext := path.Ext(fileName)
+	return strings.TrimSuffix(fileName, ext) + "_" + variant.Name + ext
+}
+
+// HasVariants mengecek apakah gambar dibuat oleh Process sehingga punya file varian.
+func HasVariants(fileName string) bool {
+	return generatedName.MatchString(fileName)
+}
+
+// FileNames mengembalikan nama gambar asli beserta semua variannya.
+func FileNames(fileName string) []string {
+	names := []string{fileName}
+	if !HasVariants(fileName) {
+		return names
+	}
+	for _, variant := range Variants {
+		names = append(names, VariantName(fileName, variant))
+	}
+	return names
+}
+
+func encodeJPEG(w io.Writer, img image.Image) error {
+	return jpeg.Encode(w, img, &jpeg.Options{Quality: jpegQuality})
+}
+
+func encodeImage(img image.Image, encode func(io.Writer, image.Image) error) ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	err := encode(buffer, img)
+	if err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+func resize(img image.Image, maxSide int) image.Image {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width <= maxSide && height <= maxSide {
+		return img
+	}
+
+	if width >= height {
+		height = max(1, height*maxSide/width)
+		width = maxSide
+	} else {
+		width = max(1, width*maxSide/height)
+		height = maxSide
+	}
+
+	dst := image.NewNRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, bounds, draw.Src, nil)
+	return dst
+}
+
+func isOpaque(img image.Image) bool {
+	opaque, ok := img.(interface{ Opaque() bool })
+	return ok && opaque.Opaque()
+}
@@ -0,0 +1,118 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+)
+
+// exifOrientation membaca tag Orientation (0x0112) dari segmen APP1 EXIF sebuah JPEG.
+// Nilai 1 (normal) dikembalikan jika tag tidak ada atau EXIF tidak bisa dibaca.
+func exifOrientation(data []byte) int {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 1
+	}
+
+	offset := 2
+	for offset+4 <= len(data) {
+		if data[offset] != 0xFF {
+			return 1
+		}
+		marker := data[offset+1]
+		// start of scan: header sudah habis tanpa EXIF
+		if marker == 0xDA {
+			return 1
+		}
+
+		length := int(binary.BigEndian.Uint16(data[offset+2:]))
+		if length < 2 || offset+2+length > len(data) {
+			return 1
+		}
+		segment := data[offset+4 : offset+2+length]
+		if marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return tiffOrientation(segment[6:])
+		}
+		offset += 2 + length
+	}
+
+	return 1
+}
+
+func tiffOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+
+	ifd := int(order.Uint32(tiff[4:]))
+	if ifd < 8 || ifd+2 > len(tiff) {
+		return 1
+	}
+
+	entries := int(order.Uint16(tiff[ifd:]))
+	for i := 0; i < entries; i++ {
+		entry := ifd + 2 + i*12
+		if entry+12 > len(tiff) {
+			return 1
+		}
+		if order.Uint16(tiff[entry:]) == 0x0112 {
+			orientation := int(order.Uint16(tiff[entry+8:]))
+			if orientation < 1 || orientation > 8 {
+				return 1
+			}
+			return orientation
+		}
+	}
+
+	return 1
+}
+
+// orient memutar atau mencerminkan gambar sesuai nilai orientasi EXIF sehingga
+// hasilnya tampil tegak tanpa bergantung pada metadata.
+func orient(src image.Image, orientation int) image.Image {
+	if orientation < 2 || orientation > 8 {
+		return src
+	}
+
+	bounds := src.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	dstWidth, dstHeight := width, height
+	if orientation >= 5 {
+		dstWidth, dstHeight = height, width
+	}
+
+	dst := image.NewNRGBA(image.Rect(0, 0, dstWidth, dstHeight))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2: // cermin horizontal
+				dx, dy = width-1-x, y
+			case 3: // putar 180°
+				dx, dy = width-1-x, height-1-y
+			case 4: // cermin vertikal
+				dx, dy = x, height-1-y
+			case 5: // transpose
+				dx, dy = y, x
+			case 6: // putar 90° searah jarum jam
+				dx, dy = height-1-y, x
+			case 7: // transverse
+				dx, dy = height-1-y, width-1-x
+			case 8: // putar 90° berlawanan arah jarum jam
+				dx, dy = y, width-1-x
+			}
+			dst.Set(dx, dy, src.At(bounds.Min.X+x, bounds.Min.Y+y))
+		}
+	}
+
+	return dst
+}
@@ -0,0 +1,95 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// HTTPServer melayani request sampai dihentikan, lalu berhenti menerima koneksi
+// baru dan menunggu request yang sedang berjalan selesai.
+func HTTPServer(server *http.Server) Component {
+	return Component{
+		Name: "http server",
+		Start: func() error {
+			err := server.ListenAndServe()
+			if errors.Is(err, http.ErrServerClosed) {
+				return nil
+			}
+			return err
+		},
+		Stop: server.Shutdown,
+	}
+}
+
+// GrpcServer melayani panggilan gRPC pada addr. Saat dihentikan, panggilan yang
+// sedang berjalan ditunggu sampai selesai; jika waktu habis koneksi diputus paksa.
+func GrpcServer(server *grpc.Server, addr string) Component {
+	return Component{
+		Name: "grpc server",
+		Start: func() error {
+			listener, err := net.Listen("tcp", addr)
+			if err != nil {
+				return err
+			}
+			return server.Serve(listener)
+		},
+		Stop: func(ctx context.Context) error {
+			done := make(chan struct{})
+			go func() {
+				server.GracefulStop()
+				close(done)
+			}()
+
+			err := Wait(ctx, done)
+			if err != nil {
+				server.Stop()
+			}
+			return err
+		},
+	}
+}
+
+// Ticker memanggil run setiap interval sampai dihentikan. Run yang sedang
+// berjalan saat shutdown dibiarkan selesai; context-nya tidak pernah dibatalkan.
+func Ticker(name string, interval time.Duration, run func(ctx context.Context)) Component {
+	quit := make(chan struct{})
+	done := make(chan struct{})
+
+	return Component{
+		Name: name,
+		Start: func() error {
+			defer close(done)
+
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-quit:
+					return nil
+				case <-ticker.C:
+					run(context.Background())
+				}
+			}
+		},
+		Stop: func(ctx context.Context) error {
+			close(quit)
+			return Wait(ctx, done)
+		},
+	}
+}
+
+// Wait menunggu sampai done ditutup atau ctx selesai.
+func Wait(ctx context.Context, done <-chan struct{}) error {
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,61 @@
+package logger
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/anddriii/kita-futsal/field-service/constants"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Init mengubah logger standar logrus menjadi JSON, menambahkan nama service ke
+// setiap entry dan menyamarkan rahasia sebelum entry ditulis.
+func Init(serviceName string) {
+	logrus.SetFormatter(&logrus.JSONFormatter{TimestampFormat: time.RFC3339Nano})
+	logrus.AddHook(serviceHook{name: serviceName})
+	logrus.AddHook(redactHook{})
+}
+
+// FromContext mengembalikan entry yang membawa request ID, user yang login dan
+// trace ID dari ctx, sehingga semua baris dari satu request bisa dicari bersama.
+func FromContext(ctx context.Context) *logrus.Entry {
+	fields := logrus.Fields{}
+	if requestID, ok := ctx.Value(constants.RequestID).(string); ok && requestID != "" {
+		fields["request_id"] = requestID
+	}
+	if userUUID, ok := ctx.Value(constants.UserUUID).(string); ok && userUUID != "" {
+		fields["user_uuid"] = userUUID
+	}
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.HasTraceID() {
+		fields["trace_id"] = spanContext.TraceID().String()
+	}
+	return logrus.WithContext(ctx).WithFields(fields)
+}
+
+type serviceHook struct {
+	name string
+}
+
+func (serviceHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (h serviceHook) Fire(entry *logrus.Entry) error {
+	entry.Data["service"] = h.name
+	return nil
+}
+
+var requestIDPattern = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+// RequestID mengembalikan received jika layak dipakai sebagai request ID dan
+// membuat yang baru jika tidak, supaya pemanggil tidak bisa menyisipkan teks
+// sembarang ke log.
+func RequestID(received string) string {
+	if requestIDPattern.MatchString(received) {
+		return received
+	}
+	return uuid.NewString()
+}
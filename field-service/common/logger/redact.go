@@ -0,0 +1,81 @@
+package logger
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+const redacted = "[REDACTED]"
+
+// sensitiveKeys dicocokkan dengan nama field dalam huruf kecil. Field yang namanya
+// mengandung salah satunya tidak pernah ditulis, apa pun nilainya.
+var sensitiveKeys = []string{
+	"password",
+	"secret",
+	"token",
+	"authorization",
+	"signature",
+	"apikey",
+	"api_key",
+	"privatekey",
+	"private_key",
+	"serverkey",
+	"server_key",
+}
+
+var sensitivePatterns = []struct {
+	pattern     *regexp.Regexp
+	replacement string
+}{
+	{regexp.MustCompile(`(?i)bearer\s+[A-Za-z0-9\-._~+/]+=*`), "Bearer " + redacted},
+	{regexp.MustCompile(`eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`), redacted},
+	{regexp.MustCompile(`(?i)((?:password|secret|token|signature|api_?key|server_?key)["']?\s*[:=]\s*["']?)[^\s"',}&]+`), "${1}" + redacted},
+}
+
+// redactHook menyamarkan field sensitif serta token dan kredensial yang terselip
+// di dalam pesan atau error.
+type redactHook struct{}
+
+func (redactHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (redactHook) Fire(entry *logrus.Entry) error {
+	for key, value := range entry.Data {
+		if isSensitiveKey(key) {
+			entry.Data[key] = redacted
+			continue
+		}
+		switch value := value.(type) {
+		case string:
+			entry.Data[key] = Redact(value)
+		case error:
+			entry.Data[key] = Redact(value.Error())
+		case fmt.Stringer:
+			entry.Data[key] = Redact(value.String())
+		}
+	}
+	entry.Message = Redact(entry.Message)
+	return nil
+}
+
+// Redact menyamarkan bearer token, JWT dan kredensial berbentuk key=value di s.
+func Redact(s string) string {
+	for _, sensitive := range sensitivePatterns {
+		s = sensitive.pattern.ReplaceAllString(s, sensitive.replacement)
+	}
+	return s
+}
+
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, sensitive := range sensitiveKeys {
+		if strings.Contains(key, sensitive) {
+			return true
+		}
+	}
+	return false
+}
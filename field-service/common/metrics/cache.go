@@ -0,0 +1,32 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var cacheRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "cache_requests_total",
+	Help: "Redis cache lookups by cache and result.",
+}, []string{"cache", "result"})
+
+var cacheInvalidations = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "cache_invalidations_total",
+	Help: "Cache namespaces invalidated after a mutation.",
+}, []string{"cache"})
+
+// CacheHit mencatat data yang berhasil diambil dari cache.
+func CacheHit(cache string) {
+	cacheRequests.WithLabelValues(cache, "hit").Inc()
+}
+
+// CacheMiss mencatat data yang harus diambil ulang dari database, termasuk saat Redis error
+// atau isi cache tidak bisa dibaca.
+func CacheMiss(cache string) {
+	cacheRequests.WithLabelValues(cache, "miss").Inc()
+}
+
+// CacheInvalidated mencatat namespace cache yang dibatalkan karena datanya berubah.
+func CacheInvalidated(cache string) {
+	cacheInvalidations.WithLabelValues(cache).Inc()
+}
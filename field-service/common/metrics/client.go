@@ -0,0 +1,30 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	clientRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "client_request_duration_seconds",
+		Help:    "Duration of calls to other services, retries included, by downstream.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"service"})
+
+	clientRequestErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "client_request_errors_total",
+		Help: "Failed calls to other services by downstream and reason.",
+	}, []string{"service", "reason"})
+)
+
+// ObserveClientCall mencatat satu panggilan ke service lain, termasuk retry-nya. reason
+// kosong jika panggilan berhasil.
+func ObserveClientCall(service string, duration time.Duration, reason string) {
+	clientRequestDuration.WithLabelValues(service).Observe(duration.Seconds())
+	if reason != "" {
+		clientRequestErrors.WithLabelValues(service, reason).Inc()
+	}
+}
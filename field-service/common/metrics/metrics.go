@@ -0,0 +1,27 @@
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var httpRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "http_request_duration_seconds",
+	Help:    "Duration of HTTP requests by method, route and status code.",
+	Buckets: prometheus.DefBuckets,
+}, []string{"method", "route", "status"})
+
+// Handler menyajikan semua metric dalam format teks Prometheus.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// ObserveHTTPRequest mencatat request yang sudah dilayani. route adalah template route,
+// bukan path mentah, agar parameter path tidak membuat jumlah label membengkak.
+func ObserveHTTPRequest(method, route, status string, duration time.Duration) {
+	httpRequestDuration.WithLabelValues(method, route, status).Observe(duration.Seconds())
+}
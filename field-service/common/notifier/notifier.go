@@ -0,0 +1,40 @@
+package notifier
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// Notification berisi data penerima dan isi pesan yang akan dikirimkan.
+type Notification struct {
+	UserID      uuid.UUID
+	Name        string
+	Email       string
+	PhoneNumber string
+	Subject     string
+	Message     string
+}
+
+type INotifier interface {
+	Notify(ctx context.Context, notification Notification) error
+}
+
+// LogNotifier hanya menulis notifikasi ke log. Dipakai sebagai driver default
+// sampai channel pengiriman lain (email, WhatsApp) tersedia.
+type LogNotifier struct{}
+
+func NewLogNotifier() INotifier {
+	return &LogNotifier{}
+}
+
+// Notify implements INotifier.
+func (l *LogNotifier) Notify(ctx context.Context, notification Notification) error {
+	logrus.WithFields(logrus.Fields{
+		"userID": notification.UserID,
+		"email":  notification.Email,
+		"phone":  notification.PhoneNumber,
+	}).Infof("[notification] %s: %s", notification.Subject, notification.Message)
+	return nil
+}
@@ -0,0 +1,136 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"github.com/anddriii/kita-futsal/field-service/common/lifecycle"
+	"github.com/redis/go-redis/v9"
+	"github.com/sirupsen/logrus"
+)
+
+// channelPrefix membedakan channel pub/sub realtime dari key Redis lain.
+const channelPrefix = "realtime:"
+
+// subscriberBuffer adalah jumlah event yang boleh menumpuk untuk satu subscriber.
+// Subscriber yang tertinggal lebih jauh dari ini diputus supaya tidak menahan yang lain;
+// client cukup menyambung ulang dan menerima snapshot baru.
+const subscriberBuffer = 32
+
+// Hub meneruskan event ke client yang berlangganan sebuah topic. Event dikirim lewat
+// Redis pub/sub sehingga client yang terhubung ke replica mana pun ikut menerimanya.
+type Hub struct {
+	redis       *redis.Client
+	mu          sync.Mutex
+	subscribers map[string]map[chan []byte]struct{}
+	closed      bool
+}
+
+// NewHub membuat Hub. Event baru diterima setelah komponen dari Component berjalan.
+func NewHub(rdb *redis.Client) *Hub {
+	return &Hub{
+		redis:       rdb,
+		subscribers: make(map[string]map[chan []byte]struct{}),
+	}
+}
+
+// Publish mengirim payload dalam bentuk JSON ke semua subscriber topic di semua replica.
+func (h *Hub) Publish(ctx context.Context, topic string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	return h.redis.Publish(ctx, channelPrefix+topic, data).Err()
+}
+
+// Subscribe mendaftarkan subscriber untuk topic. Channel yang dikembalikan ditutup saat
+// unsubscribe dipanggil, saat subscriber terlalu tertinggal, atau saat Hub ditutup.
+func (h *Hub) Subscribe(topic string) (<-chan []byte, func()) {
+	events := make(chan []byte, subscriberBuffer)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.closed {
+		close(events)
+		return events, func() {}
+	}
+	if h.subscribers[topic] == nil {
+		h.subscribers[topic] = make(map[chan []byte]struct{})
+	}
+	h.subscribers[topic][events] = struct{}{}
+
+	return events, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		h.remove(topic, events)
+	}
+}
+
+// Close memutus semua subscriber. Dipanggil saat server HTTP mulai shutdown supaya
+// stream yang masih terbuka tidak menahan shutdown sampai timeout.
+func (h *Hub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.closed = true
+	for topic, subscribers := range h.subscribers {
+		for events := range subscribers {
+			h.remove(topic, events)
+		}
+	}
+}
+
+// Component berlangganan semua channel realtime di Redis dan meneruskan setiap pesan
+// ke subscriber lokal dari topic-nya.
+func (h *Hub) Component() lifecycle.Component {
+	pubsub := h.redis.PSubscribe(context.Background(), channelPrefix+"*")
+	done := make(chan struct{})
+
+	return lifecycle.Component{
+		Name: "realtime hub",
+		Start: func() error {
+			defer close(done)
+			for message := range pubsub.Channel() {
+				h.dispatch(strings.TrimPrefix(message.Channel, channelPrefix), []byte(message.Payload))
+			}
+			return nil
+		},
+		Stop: func(ctx context.Context) error {
+			err := pubsub.Close()
+			if err != nil {
+				return err
+			}
+			return lifecycle.Wait(ctx, done)
+		},
+	}
+}
+
+func (h *Hub) dispatch(topic string, payload []byte) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for events := range h.subscribers[topic] {
+		select {
+		case events <- payload:
+		default:
+			logrus.Warnf("realtime subscriber of %s is too slow, disconnecting", topic)
+			h.remove(topic, events)
+		}
+	}
+}
+
+// remove harus dipanggil dengan mu terkunci. Aman dipanggil lebih dari sekali.
+func (h *Hub) remove(topic string, events chan []byte) {
+	subscribers, ok := h.subscribers[topic]
+	if !ok {
+		return
+	}
+	if _, ok := subscribers[events]; !ok {
+		return
+	}
+	delete(subscribers, events)
+	close(events)
+	if len(subscribers) == 0 {
+		delete(h.subscribers, topic)
+	}
+}
@@ -0,0 +1,9 @@
+package realtime
+
+import "fmt"
+
+// ScheduleTopic adalah topic perubahan status jadwal satu lapangan pada satu tanggal
+// (format 2006-01-02).
+func ScheduleTopic(fieldUUID string, date string) string {
+	return fmt.Sprintf("schedule:%s:%s", fieldUUID, date)
+}
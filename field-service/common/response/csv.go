@@ -0,0 +1,29 @@
+package response
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CSVResponse mengirim data dalam format CSV sebagai file unduhan.
+func CSVResponse(c *gin.Context, filename string, header []string, rows [][]string) {
+	var buffer bytes.Buffer
+	writer := csv.NewWriter(&buffer)
+	_ = writer.Write(header)
+	_ = writer.WriteAll(rows)
+	if err := writer.Error(); err != nil {
+		HTTPResponse(ParamHTTPResp{
+			Code: http.StatusInternalServerError,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	c.Data(http.StatusOK, "text/csv; charset=utf-8", buffer.Bytes())
+}
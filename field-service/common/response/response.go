@@ -1,6 +1,7 @@
 package response
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/anddriii/kita-futsal/field-service/constants"
@@ -38,6 +39,12 @@ func HTTPResponse(param ParamHTTPResp) {
 		return
 	}
 
+	// service lain sedang tidak tersedia, balas 503 agar client bisa mencoba lagi
+	if errors.Is(param.Err, errorConstant.ErrServiceUnavailable) {
+		param.Code = http.StatusServiceUnavailable
+		param.Err = errorConstant.ErrServiceUnavailable
+	}
+
 	message := errorConstant.ErrInternalServerError.Error()
 	if param.Message != nil {
 		message = *param.Message
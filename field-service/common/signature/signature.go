@@ -0,0 +1,107 @@
+package signature
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MaxSkew adalah selisih maksimal x-request-at terhadap jam server (ke depan
+// maupun ke belakang) sebelum request ditolak.
+const MaxSkew = 5 * time.Minute
+
+var (
+	ErrMissing  = errors.New("signature headers are incomplete")
+	ErrExpired  = errors.New("request timestamp is outside the allowed skew")
+	ErrMismatch = errors.New("signature does not match")
+	ErrReplayed = errors.New("nonce has already been used")
+)
+
+// Request berisi bagian request antar service yang dicakup signature.
+// Path mencakup query string untuk HTTP dan nama method lengkap untuk gRPC.
+type Request struct {
+	ServiceName string
+	Method      string
+	Path        string
+	Body        []byte
+	Timestamp   string
+	Nonce       string
+}
+
+// New mengisi request dengan waktu sekarang dan nonce baru. Setiap percobaan
+// dari panggilan yang di-retry butuh nonce sendiri karena penerima hanya
+// menerima satu nonce sekali.
+func New(serviceName, method, path string, body []byte) Request {
+	nonce := make([]byte, 16)
+	_, _ = rand.Read(nonce)
+
+	return Request{
+		ServiceName: serviceName,
+		Method:      method,
+		Path:        path,
+		Body:        body,
+		Timestamp:   strconv.FormatInt(time.Now().Unix(), 10),
+		Nonce:       hex.EncodeToString(nonce),
+	}
+}
+
+// Sign menghasilkan HMAC-SHA256 (hex) dari request dengan key yang diberikan.
+func (r Request) Sign(key string) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(r.canonical()))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// canonical menyusun string yang ditandatangani. Signer di FE
+// (fe/src/library/signature.ts) harus menghasilkan string yang sama persis.
+func (r Request) canonical() string {
+	bodyHash := sha256.Sum256(r.Body)
+	return strings.Join([]string{
+		r.ServiceName,
+		strings.ToUpper(r.Method),
+		r.Path,
+		hex.EncodeToString(bodyHash[:]),
+		r.Timestamp,
+		r.Nonce,
+	}, "\n")
+}
+
+// Verify memeriksa jendela waktu dan signature, lalu mengklaim nonce agar
+// request yang sama tidak bisa diterima dua kali. Nonce hanya diklaim untuk
+// request yang signature-nya benar sehingga request palsu tidak memenuhi store.
+func Verify(ctx context.Context, key string, r Request, signature string, nonces NonceStore) error {
+	if r.ServiceName == "" || r.Timestamp == "" || r.Nonce == "" || signature == "" {
+		return ErrMissing
+	}
+
+	unixTime, err := strconv.ParseInt(r.Timestamp, 10, 64)
+	if err != nil {
+		return ErrMissing
+	}
+	skew := time.Since(time.Unix(unixTime, 0))
+	if skew > MaxSkew || skew < -MaxSkew {
+		return ErrExpired
+	}
+
+	if !hmac.Equal([]byte(signature), []byte(r.Sign(key))) {
+		return ErrMismatch
+	}
+
+	// timestamp boleh berada MaxSkew di masa depan, jadi nonce harus diingat
+	// sampai request tersebut tidak mungkin lagi lolos pengecekan waktu
+	claimed, err := nonces.Claim(ctx, r.ServiceName+":"+r.Nonce, 2*MaxSkew)
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		return ErrReplayed
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package tracing
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Middleware membuat span server untuk setiap request dan menyambungkannya ke trace
+// pemanggil jika ada. Nama span memakai template route, sama seperti label metric,
+// sehingga /field/:uuid dihitung sebagai satu operasi.
+func Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+
+		ctx := Extract(c.Request.Context(), propagation.HeaderCarrier(c.Request.Header))
+		ctx, span := Tracer().Start(ctx, fmt.Sprintf("%s %s", c.Request.Method, route),
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPRequestMethodKey.String(c.Request.Method),
+				semconv.HTTPRoute(route),
+				semconv.URLPath(c.Request.URL.Path),
+			),
+		)
+		defer span.End()
+
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+
+		status := c.Writer.Status()
+		span.SetAttributes(semconv.HTTPResponseStatusCode(status))
+		if status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(status))
+		}
+		if err := c.Errors.Last(); err != nil {
+			span.RecordError(err)
+		}
+	}
+}
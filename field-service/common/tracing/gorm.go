@@ -0,0 +1,66 @@
+package tracing
+
+import (
+	"errors"
+
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+const spanKey = "tracing:span"
+
+// GormPlugin mencatat span untuk setiap query yang dijalankan GORM sebagai anak dari
+// span di context statement. Daftarkan dengan db.Use.
+type GormPlugin struct{}
+
+func (GormPlugin) Name() string {
+	return "tracing"
+}
+
+func (GormPlugin) Initialize(db *gorm.DB) error {
+	callback := db.Callback()
+	return errors.Join(
+		callback.Create().Before("gorm:create").Register("tracing:before_create", startSpan("create")),
+		callback.Create().After("gorm:create").Register("tracing:after_create", endSpan),
+		callback.Query().Before("gorm:query").Register("tracing:before_query", startSpan("query")),
+		callback.Query().After("gorm:query").Register("tracing:after_query", endSpan),
+		callback.Update().Before("gorm:update").Register("tracing:before_update", startSpan("update")),
+		callback.Update().After("gorm:update").Register("tracing:after_update", endSpan),
+		callback.Delete().Before("gorm:delete").Register("tracing:before_delete", startSpan("delete")),
+		callback.Delete().After("gorm:delete").Register("tracing:after_delete", endSpan),
+		callback.Row().Before("gorm:row").Register("tracing:before_row", startSpan("row")),
+		callback.Row().After("gorm:row").Register("tracing:after_row", endSpan),
+		callback.Raw().Before("gorm:raw").Register("tracing:before_raw", startSpan("raw")),
+		callback.Raw().After("gorm:raw").Register("tracing:after_raw", endSpan),
+	)
+}
+
+func startSpan(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		_, span := Tracer().Start(db.Statement.Context, "gorm."+operation,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(semconv.DBSystemPostgreSQL, semconv.DBOperation(operation)),
+		)
+		db.InstanceSet(spanKey, span)
+	}
+}
+
+func endSpan(db *gorm.DB) {
+	value, ok := db.InstanceGet(spanKey)
+	if !ok {
+		return
+	}
+	span, ok := value.(trace.Span)
+	if !ok {
+		return
+	}
+
+	// statement hanya berisi placeholder, nilai yang di-bind tidak ikut tercatat
+	span.SetAttributes(semconv.DBSQLTable(db.Statement.Table), semconv.DBStatement(db.Statement.SQL.String()))
+	err := db.Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		err = nil
+	}
+	End(span, err)
+}
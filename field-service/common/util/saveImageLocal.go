@@ -1,48 +1,72 @@
 package util
 
 import (
+	"errors"
 	"fmt"
-	"io"
-	"mime/multipart"
+	"io/fs"
 	"os"
 	"path/filepath"
-	"strings"
-	"time"
+
+	"github.com/anddriii/kita-futsal/field-service/common/imaging"
+	"github.com/anddriii/kita-futsal/field-service/constants"
+	"github.com/anddriii/kita-futsal/field-service/domains/dto"
 )
 
-func UploadImageLocal(images []multipart.FileHeader) ([]string, error) {
-	photoNames := make([]string, 0, len(images))
+// fieldImageDir adalah folder gambar lapangan yang disajikan lewat route /assets.
+const fieldImageDir = "/app/assets/images/field-images"
 
-	for _, image := range images {
-		basePath := "/app/assets/images/field-images"
+// SaveImageLocal menyimpan gambar yang sudah diproses oleh imaging.Process beserta
+// semua variannya. Nama file sudah dibuat oleh server sehingga aman dipakai sebagai path.
+func SaveImageLocal(files []imaging.File) error {
+	if err := os.MkdirAll(fieldImageDir, os.ModePerm); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
 
-		if err := os.MkdirAll(basePath, os.ModePerm); err != nil {
-			return nil, fmt.Errorf("failed to create directory: %w", err)
+	for _, file := range files {
+		err := os.WriteFile(filepath.Join(fieldImageDir, file.Name), file.Data, 0o644)
+		if err != nil {
+			return err
 		}
+	}
 
-		FilenameRemoveSpace := strings.ReplaceAll(image.Filename, " ", "-")
-
-		fileName := fmt.Sprintf("%s-%s", time.Now().Format("20060102150405"), FilenameRemoveSpace)
-		fullPath := filepath.Join(basePath, fileName)
+	return nil
+}
 
-		out, err := os.Create(fullPath)
-		if err != nil {
-			return nil, err
+// DeleteImageLocal menghapus gambar beserta semua variannya. File yang sudah tidak ada
+// diabaikan.
+func DeleteImageLocal(fileName string) error {
+	var errs []error
+	for _, name := range imaging.FileNames(filepath.Base(fileName)) {
+		err := os.Remove(filepath.Join(fieldImageDir, name))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			errs = append(errs, err)
 		}
-		defer out.Close()
+	}
+	return errors.Join(errs...)
+}
 
-		in, err := image.Open()
-		if err != nil {
-			return nil, err
+// BuildFieldImages mengubah daftar nama file gambar lapangan menjadi URL gambar asli dan
+// URL setiap variannya. Gambar lama yang belum punya varian memakai URL gambar asli.
+func BuildFieldImages(fileNames []string) ([]string, []dto.FieldImage) {
+	urls := make([]string, 0, len(fileNames))
+	variants := make([]dto.FieldImage, 0, len(fileNames))
+	for _, fileName := range fileNames {
+		original := constants.BuildFullImagePath(fileName)
+		image := dto.FieldImage{
+			Name:      fileName,
+			Original:  original,
+			Thumbnail: original,
+			Medium:    original,
+			Large:     original,
 		}
-		defer in.Close()
-
-		if _, err := io.Copy(out, in); err != nil {
-			return nil, err
+		if imaging.HasVariants(fileName) {
+			image.Thumbnail = constants.BuildFullImagePath(imaging.VariantName(fileName, imaging.Thumbnail))
+			image.Medium = constants.BuildFullImagePath(imaging.VariantName(fileName, imaging.Medium))
+			image.Large = constants.BuildFullImagePath(imaging.VariantName(fileName, imaging.Large))
 		}
 
-		photoNames = append(photoNames, fileName)
+		urls = append(urls, original)
+		variants = append(variants, image)
 	}
-
-	return photoNames, nil
+	return urls, variants
 }
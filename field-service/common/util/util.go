@@ -10,11 +10,13 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
 	_ "github.com/spf13/viper/remote"
+	"gorm.io/gorm"
 )
 
 // PaginationParam merepresentasikan parameter untuk paginasi.
@@ -108,9 +110,6 @@ func BindFromJson(dest any, filename, path string) error {
 		v.AddConfigPath(".") // Pakai direktori saat ini jika path kosong
 	}
 
-	// Log untuk debug
-	fmt.Printf("Loading config: %s.json from %s\n", configName, path)
-
 	// Baca file konfigurasi
 	if err := v.ReadInConfig(); err != nil {
 		logrus.Errorf("Failed to read config file: %v", err)
@@ -123,7 +122,6 @@ func BindFromJson(dest any, filename, path string) error {
 		return err
 	}
 
-	fmt.Printf("Config loaded successfully: %+v\n", dest)
 	return nil
 }
 
@@ -217,3 +215,11 @@ func CalculateHaversine(lat1, lon1, lat2, lon2 float64) float64 {
 
 	return earthRadiusKm * c
 }
+
+// DeletedAt mengembalikan waktu soft delete, atau nil jika data belum dihapus.
+func DeletedAt(deletedAt gorm.DeletedAt) *time.Time {
+	if !deletedAt.Valid {
+		return nil
+	}
+	return &deletedAt.Time
+}
@@ -12,11 +12,13 @@ var Config AppConfig
 
 type AppConfig struct {
 	Port                       int             `json:"port"`
+	GrpcPort                   int             `json:"grpcPort"`
 	AppName                    string          `json:"appName"`
 	AppEnv                     string          `json:"appEnv"`
 	SignatureKey               string          `json:"signatureKey"`
 	Database                   database        `json:"database"`
 	Redis                      redisClient     `json:"redis"`
+	CacheTTLSecond             int             `json:"cacheTTLSecond"`
 	RateLimiterMaxRequest      float64         `json:"rateLimiterMaxRequest"`
 	RateLimiterTimeSecond      int             `json:"rateLimiterTimeSecond"`
 	InternalService            InternalService `json:"internalService"`
@@ -32,6 +34,7 @@ type AppConfig struct {
 	GCSClientX509CertURL       string          `json:"gcsClientX509CertURL"`
 	GCSUniverseDomain          string          `json:"gcsUniverseDomain"`
 	GCSBucketName              string          `json:"gcsBucketName"`
+	Tracing                    tracing         `json:"tracing"`
 }
 
 type database struct {
@@ -46,6 +49,14 @@ type database struct {
 	MaxIdleTime     int    `json:"maxIdleTime"`
 }
 
+type tracing struct {
+	// Exporter berisi "otlp", "stdout", atau kosong jika span tidak diekspor
+	Exporter string `json:"exporter"`
+	// Endpoint adalah host:port collector OTLP gRPC
+	Endpoint string `json:"endpoint"`
+	Insecure bool   `json:"insecure"`
+}
+
 type redisClient struct {
 	Addr     string `json:"addr"`
 	Password string `json:"password"`
@@ -53,7 +64,8 @@ type redisClient struct {
 }
 
 type InternalService struct {
-	User User `json:"user"`
+	User  User  `json:"user"`
+	Order Order `json:"order"`
 }
 
 type User struct {
@@ -61,6 +73,11 @@ type User struct {
 	SignatureKey string `json:"signatureKey"`
 }
 
+type Order struct {
+	Host         string `json:"host"`
+	SignatureKey string `json:"signatureKey"`
+}
+
 /*
 jika config dari local maka akan mengambil dari file config.json.
 Tetapi jika confignya berasal dari grpc maka akan menggunakan util "BindFromConsul"
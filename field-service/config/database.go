@@ -2,7 +2,6 @@ package config
 
 import (
 	"fmt"
-	"log"
 	"net/url"
 	"time"
 
@@ -16,8 +15,6 @@ func InitDB() (*gorm.DB, error) {
 	encodedPassword := url.QueryEscape(config.Database.Password)
 	uri := fmt.Sprintf("postgresql://%s:%s@%s:%d/%s?sslmode=disable", config.Database.UserName, encodedPassword, config.Database.Host, config.Database.Port, config.Database.Name)
 
-	fmt.Printf("Database Config: %+v\n", config.Database)
-
 	db, err := gorm.Open(postgres.Open(uri), &gorm.Config{})
 	if err != nil {
 		return nil, err
@@ -25,7 +22,7 @@ func InitDB() (*gorm.DB, error) {
 
 	sqlDB, err := db.DB()
 	if err != nil {
-		log.Fatalf("failed to get database instance: %v", err)
+		return nil, err
 	}
 	sqlDB.SetMaxOpenConns(config.Database.MaxOpenConn)
 	sqlDB.SetConnMaxLifetime(time.Duration(config.Database.MaxLifeTimeConn) * time.Second)
@@ -2,5 +2,6 @@ package constants
 
 // Auth constants represent authentication-related keys
 const (
-	Token = "token"
+	Token     = "token"
+	UserLogin = "user_login"
 )
@@ -3,12 +3,20 @@ package error
 import (
 	errField "github.com/anddriii/kita-futsal/field-service/constants/error/field"
 	errFieldSchedule "github.com/anddriii/kita-futsal/field-service/constants/error/field_schedule"
+	errReport "github.com/anddriii/kita-futsal/field-service/constants/error/report"
+	errReview "github.com/anddriii/kita-futsal/field-service/constants/error/review"
+	errVenue "github.com/anddriii/kita-futsal/field-service/constants/error/venue"
+	errWaitlist "github.com/anddriii/kita-futsal/field-service/constants/error/waitlist"
 )
 
 // ErrMapping checks if an error exists in predefined error lists
 func ErrMapping(err error) bool {
 	allErrors := make([]error, 0)
 	allErrors = append(append(GeneralErrors[:], errField.FieldsErrors[:]...), errFieldSchedule.FieldScheduleErr[:]...) // Merging general and user errors)
+	allErrors = append(allErrors, errVenue.VenueErrors[:]...)
+	allErrors = append(allErrors, errReview.ReviewErrors[:]...)
+	allErrors = append(allErrors, errWaitlist.WaitlistErrors[:]...)
+	allErrors = append(allErrors, errReport.ReportErrors[:]...)
 
 	for _, item := range allErrors {
 		if err.Error() == item.Error() {
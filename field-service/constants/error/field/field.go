@@ -2,8 +2,16 @@ package error
 
 import "errors"
 
-var ErrFieldNotFound = errors.New("Field not found")
+var (
+	ErrFieldNotFound          = errors.New("Field not found")
+	ErrFieldHasBookedSchedule = errors.New("Field still has upcoming booked schedules")
+	ErrFieldImageNotFound     = errors.New("Field image not found")
+	ErrFieldImageRequired     = errors.New("Field must have at least one image")
+)
 
 var FieldsErrors = []error{
 	ErrFieldNotFound,
+	ErrFieldHasBookedSchedule,
+	ErrFieldImageNotFound,
+	ErrFieldImageRequired,
 }
@@ -3,11 +3,15 @@ package error
 import "errors"
 
 var (
-	ErrFieldScheduleNotFound = errors.New("Field schedule not found")
-	ErrFieldScheduleExist    = errors.New("Field schedule already exist")
+	ErrFieldScheduleNotFound     = errors.New("Field schedule not found")
+	ErrFieldScheduleExist        = errors.New("Field schedule already exist")
+	ErrFieldScheduleBooked       = errors.New("Field schedule is already booked")
+	ErrFieldScheduleNotAvailable = errors.New("Field schedule is not available")
 )
 
 var FieldScheduleErr = []error{
 	ErrFieldScheduleNotFound,
 	ErrFieldScheduleExist,
+	ErrFieldScheduleBooked,
+	ErrFieldScheduleNotAvailable,
 }
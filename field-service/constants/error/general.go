@@ -12,6 +12,9 @@ var (
 	ErrForbidden           = errors.New("forbidden")
 	ErrInvalidUploadFile   = errors.New("invalid upload file")
 	ErrSizeTooBig          = errors.New("size too big")
+	ErrInvalidSortColumn   = errors.New("invalid sort column")
+	ErrInvalidSortOrder    = errors.New("invalid sort order, must be asc or desc")
+	ErrServiceUnavailable  = errors.New("service temporarily unavailable, please try again later")
 )
 
 // List of general errors
@@ -22,4 +25,9 @@ var GeneralErrors = []error{
 	ErrUnauthorized,
 	ErrInvalidToken,
 	ErrForbidden,
+	ErrInvalidUploadFile,
+	ErrSizeTooBig,
+	ErrInvalidSortColumn,
+	ErrInvalidSortOrder,
+	ErrServiceUnavailable,
 }
@@ -0,0 +1,11 @@
+package error
+
+import "errors"
+
+var (
+	ErrInvalidDateRange = errors.New("Start date must not be after end date")
+)
+
+var ReportErrors = []error{
+	ErrInvalidDateRange,
+}
@@ -0,0 +1,15 @@
+package error
+
+import "errors"
+
+var (
+	ErrReviewNotFound    = errors.New("Review not found")
+	ErrReviewExist       = errors.New("Review for this order and field already exist")
+	ErrReviewNotEligible = errors.New("Order is not eligible for review")
+)
+
+var ReviewErrors = []error{
+	ErrReviewNotFound,
+	ErrReviewExist,
+	ErrReviewNotEligible,
+}
@@ -0,0 +1,13 @@
+package error
+
+import "errors"
+
+var (
+	ErrVenueNotFound = errors.New("Venue not found")
+	ErrVenueRequired = errors.New("Venue is required")
+)
+
+var VenueErrors = []error{
+	ErrVenueNotFound,
+	ErrVenueRequired,
+}
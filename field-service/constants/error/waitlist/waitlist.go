@@ -0,0 +1,15 @@
+package error
+
+import "errors"
+
+var (
+	ErrWaitlistNotFound       = errors.New("Waitlist not found")
+	ErrAlreadyInWaitlist      = errors.New("You are already in the waitlist for this schedule")
+	ErrScheduleStillAvailable = errors.New("Field schedule is still available, please book it directly")
+)
+
+var WaitlistErrors = []error{
+	ErrWaitlistNotFound,
+	ErrAlreadyInWaitlist,
+	ErrScheduleStillAvailable,
+}
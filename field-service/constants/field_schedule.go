@@ -5,19 +5,24 @@ type FieldScheduleStatus int
 
 const (
 	Available FieldScheduleStatus = 100
-	Booked    FieldScheduleStatus = 200
+	// Held berarti jadwal sedang ditahan order yang menunggu pembayaran
+	Held   FieldScheduleStatus = 150
+	Booked FieldScheduleStatus = 200
 
 	AvailableString FieldScheduleStatusName = "Available"
+	HeldString      FieldScheduleStatusName = "Held"
 	BookedString    FieldScheduleStatusName = "Booked"
 )
 
 var mapFieldScheduleStatusIntToString = map[FieldScheduleStatus]FieldScheduleStatusName{
 	Available: AvailableString,
+	Held:      HeldString,
 	Booked:    BookedString,
 }
 
 var mapFieldScheduleStatusStringToInt = map[FieldScheduleStatusName]FieldScheduleStatus{
 	AvailableString: Available,
+	HeldString:      Held,
 	BookedString:    Booked,
 }
 
@@ -2,6 +2,7 @@ package constants
 
 // Role identifiers
 const (
-	Admin = "admin"
-	User  = "customer"
+	Admin        = "admin"
+	User         = "customer"
+	VenueManager = "venue_manager"
 )
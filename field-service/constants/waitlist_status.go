@@ -0,0 +1,36 @@
+package constants
+
+import "time"
+
+type WaitlistStatusName string
+type WaitlistStatus int
+
+const (
+	Waiting   WaitlistStatus = 100
+	Notified  WaitlistStatus = 200
+	Fulfilled WaitlistStatus = 300
+	Lapsed    WaitlistStatus = 400
+	Cancelled WaitlistStatus = 500
+
+	WaitingString   WaitlistStatusName = "Waiting"
+	NotifiedString  WaitlistStatusName = "Notified"
+	FulfilledString WaitlistStatusName = "Fulfilled"
+	LapsedString    WaitlistStatusName = "Lapsed"
+	CancelledString WaitlistStatusName = "Cancelled"
+)
+
+// WaitlistClaimDuration adalah batas waktu user terdepan di waitlist untuk memesan slot
+// yang kembali tersedia sebelum giliran berpindah ke user berikutnya.
+const WaitlistClaimDuration = 15 * time.Minute
+
+var mapWaitlistStatusIntToString = map[WaitlistStatus]WaitlistStatusName{
+	Waiting:   WaitingString,
+	Notified:  NotifiedString,
+	Fulfilled: FulfilledString,
+	Lapsed:    LapsedString,
+	Cancelled: CancelledString,
+}
+
+func (w WaitlistStatus) GetStatusString() WaitlistStatusName {
+	return mapWaitlistStatusIntToString[w]
+}
@@ -11,4 +11,5 @@ type IFieldController interface {
 	Create(*gin.Context)
 	Update(*gin.Context)
 	Delete(*gin.Context)
+	Restore(*gin.Context)
 }
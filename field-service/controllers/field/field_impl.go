@@ -1,7 +1,6 @@
 package controllers
 
 import (
-	"fmt"
 	"net/http"
 
 	errValidation "github.com/anddriii/kita-futsal/field-service/common/error"
@@ -11,7 +10,6 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
 	"github.com/go-playground/validator/v10"
-	"github.com/gofiber/fiber/v2/log"
 )
 
 type FieldController struct {
@@ -46,8 +44,6 @@ func (f *FieldController) GetNearbyFields(ctx *gin.Context) {
 		return
 	}
 
-	fmt.Println("param dari conntroller ", params)
-
 	result, err := f.service.GetField().GetNearbyFields(ctx, &params)
 	if err != nil {
 		response.HTTPResponse(response.ParamHTTPResp{
@@ -69,12 +65,10 @@ func (f *FieldController) GetNearbyFields(ctx *gin.Context) {
 func (f *FieldController) Create(c *gin.Context) {
 	// Define a variable to hold the request payload
 	var request dto.FieldRequest
-	fmt.Print("sudah masuk ke controller request: ", request)
 
 	// Bind incoming request data from multipart form into the request struct
 	err := c.ShouldBindWith(&request, binding.FormMultipart)
 	if err != nil {
-		log.Errorf("error from controller shouldiBind", err)
 		// Return a bad request response if binding fails
 		response.HTTPResponse(response.ParamHTTPResp{
 			Code: http.StatusBadRequest,
@@ -84,12 +78,9 @@ func (f *FieldController) Create(c *gin.Context) {
 		return
 	}
 
-	fmt.Print("sudah masuk ke controller shouldBind")
-
 	// Initialize a validator to validate the request data
 	validate := validator.New()
 	if err = validate.Struct(request); err != nil {
-		log.Errorf("error from controller validate", err)
 		// If validation fails, return an unprocessable entity (422) response
 		errMessage := http.StatusText(http.StatusUnprocessableEntity)
 		errorResponse := errValidation.ErrValidationResponse(err)
@@ -103,12 +94,9 @@ func (f *FieldController) Create(c *gin.Context) {
 		return
 	}
 
-	fmt.Print("berhasil validasi: ")
-
 	// Call the service layer to create a new Field record
 	result, err := f.service.GetField().Create(c, &request)
 	if err != nil {
-		log.Errorf("error from controller create", err)
 		// If an error occurs while creating the record, return a bad request response
 		response.HTTPResponse(response.ParamHTTPResp{
 			Code: http.StatusBadRequest,
@@ -118,8 +106,6 @@ func (f *FieldController) Create(c *gin.Context) {
 		return
 	}
 
-	fmt.Print("berhasil di buat", result)
-
 	// Return a success response with the created resource
 	response.HTTPResponse(response.ParamHTTPResp{
 		Code: http.StatusCreated, // HTTP 201 Created
@@ -147,6 +133,24 @@ func (f *FieldController) Delete(c *gin.Context) {
 	})
 }
 
+// Restore implements IFieldController and restores a deleted Field together with its schedules.
+func (f *FieldController) Restore(c *gin.Context) {
+	err := f.service.GetField().Restore(c, c.Param("uuid"))
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Gin:  c,
+	})
+}
+
 // GetAllWithPagination implements IFieldController and retrieves a paginated list of Fields.
 func (f *FieldController) GetAllWithPagination(c *gin.Context) {
 	// Define a variable to hold query parameters from the request
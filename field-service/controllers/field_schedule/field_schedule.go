@@ -5,10 +5,12 @@ import "github.com/gin-gonic/gin"
 type IFieldScheduleController interface {
 	GetAllWithPagination(ctx *gin.Context)
 	GetAllByFieldIdAndDate(ctx *gin.Context)
+	StreamByFieldIdAndDate(ctx *gin.Context)
 	GetByUUID(ctx *gin.Context)
 	Create(ctx *gin.Context)
 	Update(ctx *gin.Context)
 	UpdateStatus(ctx *gin.Context)
 	Delete(ctx *gin.Context)
+	Restore(ctx *gin.Context)
 	GenerateScheduleForOneMonth(ctx *gin.Context)
 }
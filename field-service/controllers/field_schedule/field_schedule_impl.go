@@ -1,7 +1,10 @@
 package controllers
 
 import (
+	"encoding/json"
+	"io"
 	"net/http"
+	"time"
 
 	errValidation "github.com/anddriii/kita-futsal/field-service/common/error"
 	"github.com/anddriii/kita-futsal/field-service/common/response"
@@ -11,6 +14,9 @@ import (
 	"github.com/go-playground/validator/v10"
 )
 
+// streamHeartbeat adalah jeda pengiriman ping di stream status jadwal.
+const streamHeartbeat = 25 * time.Second
+
 type FieldScheduleController struct {
 	service services.IServiceRegistry
 }
@@ -77,6 +83,24 @@ func (f *FieldScheduleController) Delete(ctx *gin.Context) {
 	})
 }
 
+// Restore implements IFieldScheduleController.
+func (f *FieldScheduleController) Restore(ctx *gin.Context) {
+	err := f.service.GetFieldSchedule().Restore(ctx, ctx.Param("uuid"))
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Gin:  ctx,
+	})
+}
+
 // GenerateScheduleForOneMonth implements IFieldScheduleController.
 func (f *FieldScheduleController) GenerateScheduleForOneMonth(ctx *gin.Context) {
 	var params dto.GenerateFieldScheduleForOneMonthRequest
@@ -166,6 +190,92 @@ func (f *FieldScheduleController) GetAllByFieldIdAndDate(ctx *gin.Context) {
 
 }
 
+// StreamByFieldIdAndDate implements IFieldScheduleController.
+// Membuka stream Server-Sent Events untuk halaman booking. Event "snapshot" berisi semua
+// jadwal lapangan pada tanggal tersebut, lalu setiap perubahan status dikirim sebagai
+// event "status" berisi jadwal yang berubah. Client menyambung ulang jika stream terputus
+// dan akan menerima snapshot baru.
+//
+// Endpoint ini dilindungi header signature, sedangkan EventSource di browser tidak bisa
+// mengirim header tambahan. Client harus membaca stream lewat fetch seperti
+// fe/src/library/scheduleStream.ts.
+func (f *FieldScheduleController) StreamByFieldIdAndDate(ctx *gin.Context) {
+	var params dto.FieldScheduleStreamRequestParam
+	err := ctx.ShouldBindQuery(&params)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(params)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errorResponse := errValidation.ErrValidationResponse(err)
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code:    http.StatusBadRequest,
+			Err:     err,
+			Message: &errMessage,
+			Data:    errorResponse,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	// berlangganan sebelum mengambil snapshot supaya perubahan di antara keduanya tidak terlewat
+	events, unsubscribe, err := f.service.GetFieldSchedule().SubscribeStatus(ctx, ctx.Param("uuid"), params.Date)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+	defer unsubscribe()
+
+	snapshot, err := f.service.GetFieldSchedule().FindAllFieldByIdAndDate(ctx, ctx.Param("uuid"), params.Date)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	ctx.Header("Cache-Control", "no-cache")
+	ctx.Header("Connection", "keep-alive")
+	// mencegah reverse proxy seperti nginx menahan event di buffer
+	ctx.Header("X-Accel-Buffering", "no")
+	ctx.SSEvent("snapshot", snapshot)
+	ctx.Writer.Flush()
+
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+
+	ctx.Stream(func(w io.Writer) bool {
+		select {
+		case <-ctx.Request.Context().Done():
+			return false
+		case event, ok := <-events:
+			if !ok {
+				return false
+			}
+			ctx.SSEvent("status", json.RawMessage(event))
+			return true
+		case <-heartbeat.C:
+			// komentar SSE menjaga koneksi tetap terbuka melewati idle timeout proxy
+			_, err := io.WriteString(w, ": ping\n\n")
+			return err == nil
+		}
+	})
+}
+
 // GetAllWithPagination implements IFieldScheduleController.
 func (f *FieldScheduleController) GetAllWithPagination(ctx *gin.Context) {
 	var params dto.FieldScheduleRequestParam
@@ -0,0 +1,30 @@
+package grpc
+
+import (
+	"errors"
+
+	errConst "github.com/anddriii/kita-futsal/field-service/constants/error"
+	errFieldSchedule "github.com/anddriii/kita-futsal/field-service/constants/error/field_schedule"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// toStatus mengubah error dari service menjadi status gRPC. Sama seperti response HTTP,
+// hanya pesan error yang terdaftar di ErrMapping yang diteruskan ke pemanggil.
+func toStatus(err error) error {
+	switch {
+	case errors.Is(err, errFieldSchedule.ErrFieldScheduleNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, errFieldSchedule.ErrFieldScheduleNotAvailable):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, errConst.ErrUnauthorized), errors.Is(err, errConst.ErrInvalidToken):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, errConst.ErrForbidden):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, errConst.ErrServiceUnavailable):
+		return status.Error(codes.Unavailable, errConst.ErrServiceUnavailable.Error())
+	case errConst.ErrMapping(err):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return status.Error(codes.Internal, errConst.ErrInternalServerError.Error())
+}
@@ -0,0 +1,47 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/anddriii/kita-futsal/field-service/constants"
+	"github.com/anddriii/kita-futsal/field-service/domains/dto"
+	pb "github.com/anddriii/kita-futsal/field-service/proto/field"
+	"github.com/anddriii/kita-futsal/field-service/services"
+	"github.com/go-playground/validator/v10"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FieldServer melayani API internal field-service lewat gRPC, berjalan berdampingan dengan API HTTP Gin.
+type FieldServer struct {
+	pb.UnimplementedFieldServiceServer
+	service services.IServiceRegistry
+}
+
+func NewFieldServer(service services.IServiceRegistry) *FieldServer {
+	return &FieldServer{service: service}
+}
+
+// UpdateFieldScheduleStatus mengubah status jadwal lapangan, dipanggil order-service. Held
+// menahan jadwal saat order dibuat, status kosong berarti Booked (pembayaran berhasil), dan
+// Available melepas jadwal saat pembayaran kedaluwarsa atau order dibatalkan.
+func (f *FieldServer) UpdateFieldScheduleStatus(
+	ctx context.Context,
+	req *pb.UpdateFieldScheduleStatusRequest,
+) (*pb.UpdateFieldScheduleStatusResponse, error) {
+	request := dto.UpdateStatusFieldScheduleRequest{
+		FieldScheduleIDs: req.GetFieldScheduleIds(),
+		Status:           constants.FieldScheduleStatusName(req.GetStatus()),
+	}
+	err := validator.New().Struct(request)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = f.service.GetFieldSchedule().UpdateStatus(ctx, &request)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.UpdateFieldScheduleStatusResponse{}, nil
+}
@@ -3,7 +3,11 @@ package controllers
 import (
 	fieldController "github.com/anddriii/kita-futsal/field-service/controllers/field"
 	fieldScheduleController "github.com/anddriii/kita-futsal/field-service/controllers/field_schedule"
+	reportController "github.com/anddriii/kita-futsal/field-service/controllers/report"
+	reviewController "github.com/anddriii/kita-futsal/field-service/controllers/review"
 	timeController "github.com/anddriii/kita-futsal/field-service/controllers/time"
+	venueController "github.com/anddriii/kita-futsal/field-service/controllers/venue"
+	waitlistController "github.com/anddriii/kita-futsal/field-service/controllers/waitlist"
 	"github.com/anddriii/kita-futsal/field-service/services"
 )
 
@@ -26,10 +30,34 @@ func (r *Registry) GetTime() timeController.ITimeController {
 	return timeController.NewTimeController(r.service)
 }
 
+// GetVenue implements IControllerRegistry.
+func (r *Registry) GetVenue() venueController.IVenueController {
+	return venueController.NewVenueController(r.service)
+}
+
+// GetReview implements IControllerRegistry.
+func (r *Registry) GetReview() reviewController.IReviewController {
+	return reviewController.NewReviewController(r.service)
+}
+
+// GetWaitlist implements IControllerRegistry.
+func (r *Registry) GetWaitlist() waitlistController.IWaitlistController {
+	return waitlistController.NewWaitlistController(r.service)
+}
+
+// GetReport implements IControllerRegistry.
+func (r *Registry) GetReport() reportController.IReportController {
+	return reportController.NewReportController(r.service)
+}
+
 type IControllerRegistry interface {
 	GetField() fieldController.IFieldController
 	GetFieldSchedule() fieldScheduleController.IFieldScheduleController
 	GetTime() timeController.ITimeController
+	GetVenue() venueController.IVenueController
+	GetReview() reviewController.IReviewController
+	GetWaitlist() waitlistController.IWaitlistController
+	GetReport() reportController.IReportController
 }
 
 func NewControllerRegistry(service services.IServiceRegistry) IControllerRegistry {
@@ -0,0 +1,8 @@
+package controllers
+
+import "github.com/gin-gonic/gin"
+
+type IReportController interface {
+	GetOccupancy(*gin.Context)
+	GetPeakHours(*gin.Context)
+}
@@ -0,0 +1,142 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	errValidation "github.com/anddriii/kita-futsal/field-service/common/error"
+	"github.com/anddriii/kita-futsal/field-service/common/response"
+	"github.com/anddriii/kita-futsal/field-service/domains/dto"
+	"github.com/anddriii/kita-futsal/field-service/services"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+const csvFormat = "csv"
+
+type ReportController struct {
+	service services.IServiceRegistry
+}
+
+func NewReportController(service services.IServiceRegistry) IReportController {
+	return &ReportController{service: service}
+}
+
+// bindParam membaca dan memvalidasi parameter query laporan.
+// Mengembalikan false jika response error sudah dikirim ke client.
+func (r *ReportController) bindParam(c *gin.Context) (*dto.ReportRequestParam, bool) {
+	var params dto.ReportRequestParam
+	err := c.ShouldBindQuery(&params)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return nil, false
+	}
+
+	validate := validator.New()
+	err = validate.Struct(params)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errorResponse := errValidation.ErrValidationResponse(err)
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Err:     err,
+			Message: &errMessage,
+			Data:    errorResponse,
+			Gin:     c,
+		})
+		return nil, false
+	}
+
+	return &params, true
+}
+
+// GetOccupancy implements IReportController.
+func (r *ReportController) GetOccupancy(c *gin.Context) {
+	params, ok := r.bindParam(c)
+	if !ok {
+		return
+	}
+
+	result, err := r.service.GetReport().GetOccupancy(c, params)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	if params.Format == csvFormat {
+		rows := make([][]string, 0, len(result))
+		for _, item := range result {
+			rows = append(rows, []string{
+				item.FieldID.String(),
+				item.FieldName,
+				strconv.FormatInt(item.TotalSlot, 10),
+				strconv.FormatInt(item.BookedSlot, 10),
+				strconv.FormatInt(item.AvailableSlot, 10),
+				strconv.FormatFloat(item.OccupancyRate, 'f', 2, 64),
+			})
+		}
+		response.CSVResponse(c,
+			fmt.Sprintf("occupancy_%s_%s.csv", params.StartDate, params.EndDate),
+			[]string{"field_id", "field_name", "total_slot", "booked_slot", "available_slot", "occupancy_rate"},
+			rows,
+		)
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  c,
+	})
+}
+
+// GetPeakHours implements IReportController.
+func (r *ReportController) GetPeakHours(c *gin.Context) {
+	params, ok := r.bindParam(c)
+	if !ok {
+		return
+	}
+
+	result, err := r.service.GetReport().GetPeakHours(c, params)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	if params.Format == csvFormat {
+		rows := make([][]string, 0, len(result))
+		for _, item := range result {
+			rows = append(rows, []string{
+				item.StartTime,
+				item.EndTime,
+				strconv.FormatInt(item.BookedSlot, 10),
+				strconv.FormatInt(item.TotalSlot, 10),
+			})
+		}
+		response.CSVResponse(c,
+			fmt.Sprintf("peak_hours_%s_%s.csv", params.StartDate, params.EndDate),
+			[]string{"start_time", "end_time", "booked_slot", "total_slot"},
+			rows,
+		)
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  c,
+	})
+}
@@ -0,0 +1,11 @@
+package controllers
+
+import "github.com/gin-gonic/gin"
+
+type IReviewController interface {
+	GetAllWithPagination(*gin.Context)
+	GetByFieldID(*gin.Context)
+	Create(*gin.Context)
+	Moderate(*gin.Context)
+	Delete(*gin.Context)
+}
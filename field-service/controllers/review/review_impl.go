@@ -0,0 +1,193 @@
+package controllers
+
+import (
+	"net/http"
+
+	errValidation "github.com/anddriii/kita-futsal/field-service/common/error"
+	"github.com/anddriii/kita-futsal/field-service/common/response"
+	"github.com/anddriii/kita-futsal/field-service/domains/dto"
+	"github.com/anddriii/kita-futsal/field-service/services"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type ReviewController struct {
+	service services.IServiceRegistry
+}
+
+func NewReviewController(service services.IServiceRegistry) IReviewController {
+	return &ReviewController{service: service}
+}
+
+// GetAllWithPagination implements IReviewController.
+func (r *ReviewController) GetAllWithPagination(c *gin.Context) {
+	var params dto.ReviewRequestParam
+	err := c.ShouldBindQuery(&params)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(params)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errorResponse := errValidation.ErrValidationResponse(err)
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Err:     err,
+			Message: &errMessage,
+			Data:    errorResponse,
+			Gin:     c,
+		})
+		return
+	}
+
+	result, err := r.service.GetReview().GetAllWithPagination(c, &params)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  c,
+	})
+}
+
+// GetByFieldID implements IReviewController.
+func (r *ReviewController) GetByFieldID(c *gin.Context) {
+	result, err := r.service.GetReview().GetByFieldID(c, c.Param("uuid"))
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  c,
+	})
+}
+
+// Create implements IReviewController.
+func (r *ReviewController) Create(c *gin.Context) {
+	var request dto.ReviewRequest
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errorResponse := errValidation.ErrValidationResponse(err)
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Err:     err,
+			Message: &errMessage,
+			Data:    errorResponse,
+			Gin:     c,
+		})
+		return
+	}
+
+	// memakai context dari request karena token user disimpan di sana dan diteruskan ke order-service
+	result, err := r.service.GetReview().Create(c.Request.Context(), &request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusCreated,
+		Data: result,
+		Gin:  c,
+	})
+}
+
+// Moderate implements IReviewController.
+func (r *ReviewController) Moderate(c *gin.Context) {
+	var request dto.ModerateReviewRequest
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errorResponse := errValidation.ErrValidationResponse(err)
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Err:     err,
+			Message: &errMessage,
+			Data:    errorResponse,
+			Gin:     c,
+		})
+		return
+	}
+
+	result, err := r.service.GetReview().Moderate(c, c.Param("uuid"), &request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  c,
+	})
+}
+
+// Delete implements IReviewController.
+func (r *ReviewController) Delete(c *gin.Context) {
+	err := r.service.GetReview().Delete(c, c.Param("uuid"))
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Gin:  c,
+	})
+}
@@ -0,0 +1,14 @@
+package controllers
+
+import "github.com/gin-gonic/gin"
+
+type IVenueController interface {
+	GetAllWithPagination(*gin.Context)
+	GetAllWithoutPagination(*gin.Context)
+	GetByUUID(*gin.Context)
+	GetByManagerID(*gin.Context)
+	GetMyVenues(*gin.Context)
+	Create(*gin.Context)
+	Update(*gin.Context)
+	Delete(*gin.Context)
+}
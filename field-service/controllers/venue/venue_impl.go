@@ -0,0 +1,249 @@
+package controllers
+
+import (
+	"net/http"
+
+	errValidation "github.com/anddriii/kita-futsal/field-service/common/error"
+	"github.com/anddriii/kita-futsal/field-service/common/response"
+	"github.com/anddriii/kita-futsal/field-service/domains/dto"
+	"github.com/anddriii/kita-futsal/field-service/services"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type VenueController struct {
+	service services.IServiceRegistry
+}
+
+func NewVenueController(service services.IServiceRegistry) IVenueController {
+	return &VenueController{service: service}
+}
+
+// GetAllWithPagination implements IVenueController.
+func (v *VenueController) GetAllWithPagination(c *gin.Context) {
+	var params dto.VenueRequestParam
+	err := c.ShouldBindQuery(&params)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(params)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errorResponse := errValidation.ErrValidationResponse(err)
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Err:     err,
+			Message: &errMessage,
+			Data:    errorResponse,
+			Gin:     c,
+		})
+		return
+	}
+
+	result, err := v.service.GetVenue().GetAllWithPagination(c, &params)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  c,
+	})
+}
+
+// GetAllWithoutPagination implements IVenueController.
+func (v *VenueController) GetAllWithoutPagination(c *gin.Context) {
+	result, err := v.service.GetVenue().GetAllWithoutPagination(c)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  c,
+	})
+}
+
+// GetByUUID implements IVenueController.
+func (v *VenueController) GetByUUID(c *gin.Context) {
+	result, err := v.service.GetVenue().GetByUUID(c, c.Param("uuid"))
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  c,
+	})
+}
+
+// GetByManagerID implements IVenueController.
+func (v *VenueController) GetByManagerID(c *gin.Context) {
+	result, err := v.service.GetVenue().GetByManagerID(c, c.Param("uuid"))
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  c,
+	})
+}
+
+// GetMyVenues implements IVenueController.
+func (v *VenueController) GetMyVenues(c *gin.Context) {
+	result, err := v.service.GetVenue().GetMyVenues(c)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  c,
+	})
+}
+
+// Create implements IVenueController.
+func (v *VenueController) Create(c *gin.Context) {
+	var request dto.VenueRequest
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errorResponse := errValidation.ErrValidationResponse(err)
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Err:     err,
+			Message: &errMessage,
+			Data:    errorResponse,
+			Gin:     c,
+		})
+		return
+	}
+
+	result, err := v.service.GetVenue().Create(c, &request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusCreated,
+		Data: result,
+		Gin:  c,
+	})
+}
+
+// Update implements IVenueController.
+func (v *VenueController) Update(c *gin.Context) {
+	var request dto.UpdateVenueRequest
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errorResponse := errValidation.ErrValidationResponse(err)
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Err:     err,
+			Message: &errMessage,
+			Data:    errorResponse,
+			Gin:     c,
+		})
+		return
+	}
+
+	result, err := v.service.GetVenue().Update(c, c.Param("uuid"), &request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  c,
+	})
+}
+
+// Delete implements IVenueController.
+func (v *VenueController) Delete(c *gin.Context) {
+	err := v.service.GetVenue().Delete(c, c.Param("uuid"))
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Gin:  c,
+	})
+}
@@ -0,0 +1,10 @@
+package controllers
+
+import "github.com/gin-gonic/gin"
+
+type IWaitlistController interface {
+	Join(*gin.Context)
+	GetMine(*gin.Context)
+	GetBySchedule(*gin.Context)
+	Leave(*gin.Context)
+}
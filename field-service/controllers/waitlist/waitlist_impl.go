@@ -0,0 +1,121 @@
+package controllers
+
+import (
+	"net/http"
+
+	errValidation "github.com/anddriii/kita-futsal/field-service/common/error"
+	"github.com/anddriii/kita-futsal/field-service/common/response"
+	"github.com/anddriii/kita-futsal/field-service/domains/dto"
+	"github.com/anddriii/kita-futsal/field-service/services"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type WaitlistController struct {
+	service services.IServiceRegistry
+}
+
+func NewWaitlistController(service services.IServiceRegistry) IWaitlistController {
+	return &WaitlistController{service: service}
+}
+
+// Join implements IWaitlistController.
+func (w *WaitlistController) Join(c *gin.Context) {
+	var request dto.WaitlistRequest
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errorResponse := errValidation.ErrValidationResponse(err)
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Err:     err,
+			Message: &errMessage,
+			Data:    errorResponse,
+			Gin:     c,
+		})
+		return
+	}
+
+	result, err := w.service.GetWaitlist().Join(c, &request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusCreated,
+		Data: result,
+		Gin:  c,
+	})
+}
+
+// GetMine implements IWaitlistController.
+func (w *WaitlistController) GetMine(c *gin.Context) {
+	result, err := w.service.GetWaitlist().GetMine(c)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  c,
+	})
+}
+
+// GetBySchedule implements IWaitlistController.
+func (w *WaitlistController) GetBySchedule(c *gin.Context) {
+	result, err := w.service.GetWaitlist().GetBySchedule(c, c.Param("uuid"))
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  c,
+	})
+}
+
+// Leave implements IWaitlistController.
+func (w *WaitlistController) Leave(c *gin.Context) {
+	err := w.service.GetWaitlist().Leave(c, c.Param("uuid"))
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Gin:  c,
+	})
+}
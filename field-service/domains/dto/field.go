@@ -13,6 +13,7 @@ type FieldRequest struct {
 	Latitude     float64                `form:"latitude"`
 	Lonitude     float64                `form:"lonitude"`
 	PricePerHour int                    `form:"pricePerHour" validate:"required"`
+	VenueID      string                 `form:"venueID"`
 	Images       []multipart.FileHeader `form:"images" validate:"required"`
 }
 
@@ -22,20 +23,41 @@ type UpdateFieldRequest struct {
 	Latitude     float64                `form:"latitude"`
 	Lonitude     float64                `form:"lonitude"`
 	PricePerHour int                    `form:"pricePerHour" validate:"required"`
+	VenueID      string                 `form:"venueID"`
 	Images       []multipart.FileHeader `form:"images"`
+	// KeepImages berisi nama file (atau URL) gambar lama yang dipertahankan sesuai urutan
+	// barunya. Gambar lama yang tidak disebut akan dihapus dan gambar baru ditambahkan di
+	// belakang. Jika tidak dikirim, gambar baru menggantikan semua gambar lama.
+	KeepImages []string `form:"keepImages"`
 }
 
 type FieldResponse struct {
-	UUID         uuid.UUID `json:"uuid"`
-	Code         string    `json:"code"`
-	Name         string    `json:"name"`
-	Latitude     float64   `form:"latitude"`
-	Lonitude     float64   `form:"lonitude"`
-	PricePerHour any       `json:"pricePerHour"`
-	Images       []string  `json:"images"`
-	Distance     float64   `json:"distance"`
-	CreatedAt    *time.Time
-	UpdateAt     *time.Time
+	UUID          uuid.UUID    `json:"uuid"`
+	Code          string       `json:"code"`
+	Name          string       `json:"name"`
+	Latitude      float64      `form:"latitude"`
+	Lonitude      float64      `form:"lonitude"`
+	PricePerHour  any          `json:"pricePerHour"`
+	Images        []string     `json:"images"`
+	ImageVariants []FieldImage `json:"imageVariants"`
+	Distance      float64      `json:"distance"`
+	Rating        float64      `json:"rating"`
+	ReviewCount   int64        `json:"reviewCount"`
+	VenueUUID     *uuid.UUID   `json:"venueUUID,omitempty"`
+	VenueName     string       `json:"venueName,omitempty"`
+	CreatedAt     *time.Time
+	UpdateAt      *time.Time
+	DeletedAt     *time.Time `json:"deletedAt,omitempty"`
+}
+
+// FieldImage berisi URL gambar asli dan setiap variannya. Name adalah nama file yang
+// dipakai di KeepImages saat mengubah urutan atau menghapus gambar.
+type FieldImage struct {
+	Name      string `json:"name"`
+	Original  string `json:"original"`
+	Thumbnail string `json:"thumbnail"`
+	Medium    string `json:"medium"`
+	Large     string `json:"large"`
 }
 
 type FieldDetailReponse struct {
@@ -52,8 +74,13 @@ type FieldDetailReponse struct {
 type FieldRequestParam struct {
 	Page       int     `form:"page" validate:"required"`
 	Limit      int     `form:"limit" validate:"required"`
-	SortColumn *string `form:"sortColumn"`
-	SortOrder  *string `form:"sortOrder"`
+	SortColumn *string `form:"sortColumn" validate:"omitempty,oneof=code name pricePerHour createdAt updatedAt"`
+	SortOrder  *string `form:"sortOrder" validate:"omitempty,oneof=asc desc"`
+	VenueID    *string `form:"venueID" validate:"omitempty,uuid"`
+	MinPrice   *int    `form:"minPrice" validate:"omitempty,gte=0"`
+	MaxPrice   *int    `form:"maxPrice" validate:"omitempty,gte=0"`
+	Deleted    bool    `form:"deleted"`
+	VenueIDs   []uint  `form:"-"`
 }
 
 type NearbyFields struct {
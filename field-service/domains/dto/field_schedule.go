@@ -23,7 +23,8 @@ type UpdateScheduleRequest struct {
 }
 
 type UpdateStatusFieldScheduleRequest struct {
-	FieldScheduleIDs []string `json:"fieldScheduleIDs" validate:"required"`
+	FieldScheduleIDs []string                          `json:"fieldScheduleIDs" validate:"required"`
+	Status           constants.FieldScheduleStatusName `json:"status" validate:"omitempty,oneof=Available Held Booked"`
 }
 
 type UpdateFieldScheduleRequest struct {
@@ -33,13 +34,18 @@ type UpdateFieldScheduleRequest struct {
 
 type FieldScheduleResponse struct {
 	UUID         uuid.UUID                         `json:"uuid"`
+	FieldUUID    uuid.UUID                         `json:"fieldUUID"`
 	FieldName    string                            `json:"fieldName"`
+	VenueUUID    *uuid.UUID                        `json:"venueUUID,omitempty"`
 	PricePerHour int                               `json:"pricePerHour"`
 	Date         string                            `json:"date"`
 	Status       constants.FieldScheduleStatusName `json:"status"`
 	Time         string                            `json:"time"`
+	ClaimedBy    *uuid.UUID                        `json:"claimedBy,omitempty"`
+	ClaimExpires *time.Time                        `json:"claimExpiresAt,omitempty"`
 	CreatedAt    *time.Time
 	UpdateAt     *time.Time
+	DeletedAt    *time.Time `json:"deletedAt,omitempty"`
 }
 
 type FieldScheduleForBookingReponse struct {
@@ -51,12 +57,23 @@ type FieldScheduleForBookingReponse struct {
 }
 
 type FieldScheduleRequestParam struct {
-	Page       int     `form:"page" validate:"required"`
-	Limit      int     `form:"limit" validate:"required"`
-	SortColumn *string `form:"sortColumn"`
-	SortOrder  *string `form:"sortOrder"`
+	Page       int                                `form:"page" validate:"required"`
+	Limit      int                                `form:"limit" validate:"required"`
+	SortColumn *string                            `form:"sortColumn" validate:"omitempty,oneof=date status createdAt updatedAt"`
+	SortOrder  *string                            `form:"sortOrder" validate:"omitempty,oneof=asc desc"`
+	VenueID    *string                            `form:"venueID" validate:"omitempty,uuid"`
+	FieldID    *string                            `form:"fieldID" validate:"omitempty,uuid"`
+	Status     *constants.FieldScheduleStatusName `form:"status" validate:"omitempty,oneof=Available Booked"`
+	StartDate  *string                            `form:"startDate" validate:"omitempty,datetime=2006-01-02"`
+	EndDate    *string                            `form:"endDate" validate:"omitempty,datetime=2006-01-02"`
+	Deleted    bool                               `form:"deleted"`
+	VenueIDs   []uint                             `form:"-"`
 }
 
 type FieldScheduleByFieldIDAndDateRequestParam struct {
 	Date string `form:"date" validate:"required"`
 }
+
+type FieldScheduleStreamRequestParam struct {
+	Date string `form:"date" validate:"required,datetime=2006-01-02"`
+}
@@ -0,0 +1,34 @@
+package dto
+
+import "github.com/google/uuid"
+
+type ReportRequestParam struct {
+	StartDate string  `form:"startDate" validate:"required,datetime=2006-01-02"`
+	EndDate   string  `form:"endDate" validate:"required,datetime=2006-01-02"`
+	VenueID   *string `form:"venueID" validate:"omitempty,uuid"`
+	Format    string  `form:"format" validate:"omitempty,oneof=json csv"`
+}
+
+// OccupancyReport adalah hasil agregasi jadwal per lapangan dari database.
+type OccupancyReport struct {
+	FieldID    uuid.UUID
+	FieldName  string
+	TotalSlot  int64
+	BookedSlot int64
+}
+
+type OccupancyReportResponse struct {
+	FieldID       uuid.UUID `json:"fieldID"`
+	FieldName     string    `json:"fieldName"`
+	TotalSlot     int64     `json:"totalSlot"`
+	BookedSlot    int64     `json:"bookedSlot"`
+	AvailableSlot int64     `json:"availableSlot"`
+	OccupancyRate float64   `json:"occupancyRate"`
+}
+
+type PeakHourReportResponse struct {
+	StartTime  string `json:"startTime"`
+	EndTime    string `json:"endTime"`
+	BookedSlot int64  `json:"bookedSlot"`
+	TotalSlot  int64  `json:"totalSlot"`
+}
@@ -0,0 +1,49 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ReviewRequest struct {
+	OrderID string `json:"orderID" validate:"required,uuid"`
+	FieldID string `json:"fieldID" validate:"required,uuid"`
+	Rating  int    `json:"rating" validate:"required,min=1,max=5"`
+	Comment string `json:"comment" validate:"max=1000"`
+}
+
+type ModerateReviewRequest struct {
+	IsHidden *bool   `json:"isHidden" validate:"required"`
+	Reason   *string `json:"reason"`
+}
+
+type ReviewResponse struct {
+	UUID         uuid.UUID `json:"uuid"`
+	FieldUUID    uuid.UUID `json:"fieldUUID"`
+	FieldName    string    `json:"fieldName"`
+	OrderID      uuid.UUID `json:"orderID"`
+	UserID       uuid.UUID `json:"userID"`
+	UserName     string    `json:"userName"`
+	Rating       int       `json:"rating"`
+	Comment      string    `json:"comment"`
+	IsHidden     bool      `json:"isHidden"`
+	HiddenReason *string   `json:"hiddenReason,omitempty"`
+	CreatedAt    *time.Time
+	UpdateAt     *time.Time
+}
+
+type ReviewRequestParam struct {
+	Page       int     `form:"page" validate:"required"`
+	Limit      int     `form:"limit" validate:"required"`
+	SortColumn *string `form:"sortColumn" validate:"omitempty,oneof=rating createdAt updatedAt"`
+	SortOrder  *string `form:"sortOrder" validate:"omitempty,oneof=asc desc"`
+	FieldID    *string `form:"fieldID" validate:"omitempty,uuid"`
+	IsHidden   *bool   `form:"isHidden"`
+}
+
+type RatingSummary struct {
+	FieldID     uint
+	Rating      float64
+	ReviewCount int64
+}
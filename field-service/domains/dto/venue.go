@@ -0,0 +1,51 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type VenueRequest struct {
+	Name        string  `json:"name" validate:"required"`
+	Address     string  `json:"address" validate:"required"`
+	City        string  `json:"city" validate:"required"`
+	Latitude    float64 `json:"latitude" validate:"required"`
+	Longitude   float64 `json:"longitude" validate:"required"`
+	PhoneNumber string  `json:"phoneNumber" validate:"required"`
+	Email       string  `json:"email" validate:"omitempty,email"`
+	ManagerID   *string `json:"managerID" validate:"omitempty,uuid"`
+}
+
+type UpdateVenueRequest struct {
+	Name        string  `json:"name" validate:"required"`
+	Address     string  `json:"address" validate:"required"`
+	City        string  `json:"city" validate:"required"`
+	Latitude    float64 `json:"latitude" validate:"required"`
+	Longitude   float64 `json:"longitude" validate:"required"`
+	PhoneNumber string  `json:"phoneNumber" validate:"required"`
+	Email       string  `json:"email" validate:"omitempty,email"`
+	ManagerID   *string `json:"managerID" validate:"omitempty,uuid"`
+}
+
+type VenueResponse struct {
+	UUID        uuid.UUID       `json:"uuid"`
+	Name        string          `json:"name"`
+	Address     string          `json:"address"`
+	City        string          `json:"city"`
+	Latitude    float64         `json:"latitude"`
+	Longitude   float64         `json:"longitude"`
+	PhoneNumber string          `json:"phoneNumber"`
+	Email       string          `json:"email"`
+	ManagerID   *uuid.UUID      `json:"managerID,omitempty"`
+	Fields      []FieldResponse `json:"fields,omitempty"`
+	CreatedAt   *time.Time
+	UpdateAt    *time.Time
+}
+
+type VenueRequestParam struct {
+	Page       int     `form:"page" validate:"required"`
+	Limit      int     `form:"limit" validate:"required"`
+	SortColumn *string `form:"sortColumn" validate:"omitempty,oneof=name city createdAt updatedAt"`
+	SortOrder  *string `form:"sortOrder" validate:"omitempty,oneof=asc desc"`
+}
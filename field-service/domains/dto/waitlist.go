@@ -0,0 +1,27 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/anddriii/kita-futsal/field-service/constants"
+	"github.com/google/uuid"
+)
+
+type WaitlistRequest struct {
+	FieldScheduleID string `json:"fieldScheduleID" validate:"required,uuid"`
+}
+
+type WaitlistResponse struct {
+	UUID              uuid.UUID                    `json:"uuid"`
+	FieldScheduleUUID uuid.UUID                    `json:"fieldScheduleUUID"`
+	FieldName         string                       `json:"fieldName"`
+	Date              string                       `json:"date"`
+	Time              string                       `json:"time"`
+	UserID            uuid.UUID                    `json:"userID"`
+	UserName          string                       `json:"userName"`
+	Position          int64                        `json:"position"`
+	Status            constants.WaitlistStatusName `json:"status"`
+	ClaimExpiresAt    *time.Time                   `json:"claimExpiresAt,omitempty"`
+	CreatedAt         *time.Time
+	UpdateAt          *time.Time
+}
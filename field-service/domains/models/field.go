@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/lib/pq"
+	"gorm.io/gorm"
 )
 
 type Field struct {
@@ -16,10 +17,12 @@ type Field struct {
 	// Image       datatypes.JSON `gorm:"type:json;not null"`
 	Image         pq.StringArray `gorm:"type:text[];not null"`
 	Latitude      float64        `gorm:"type:decimal(10,8);not null"`
-	Lonitude      float64        `gorm:"type:decimal(11,8);not null"`
+	Longitude     float64        `gorm:"type:decimal(11,8);not null"`
 	PricePerHour  int            `gorm:"type:int;not null"`
+	VenueID       *uint          `gorm:"type:int"`
 	CreatedAt     *time.Time
 	UpdatedAt     *time.Time
-	DeletedAt     *time.Time
+	DeletedAt     gorm.DeletedAt  `gorm:"index"`
 	FieldSchedule []FieldSchedule `gorm:"foreignKey:field_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Venue         *Venue          `gorm:"foreignKey:venue_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
 }
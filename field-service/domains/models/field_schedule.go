@@ -5,6 +5,7 @@ import (
 
 	cons "github.com/anddriii/kita-futsal/field-service/constants"
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 type FieldSchedule struct {
@@ -16,7 +17,7 @@ type FieldSchedule struct {
 	Status    cons.FieldScheduleStatus `gorm:"type:int; not null"`
 	CreatedAt *time.Time
 	UpdatedAt *time.Time
-	DeletedAt *time.Time
-	Field     Field `gorm:"foreignKey:field_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
-	Time      Time  `gorm:"foreignKey:time_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+	Field     Field          `gorm:"foreignKey:field_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Time      Time           `gorm:"foreignKey:time_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 }
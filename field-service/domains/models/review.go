@@ -0,0 +1,23 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Review struct {
+	ID           uint      `gorm:"primaryKey;autoIncrement;not null"`
+	UUID         uuid.UUID `gorm:"type:uuid;not null"`
+	FieldID      uint      `gorm:"type:int;not null;uniqueIndex:idx_review_order_field"`
+	OrderID      uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_review_order_field"`
+	UserID       uuid.UUID `gorm:"type:uuid;not null"`
+	UserName     string    `gorm:"type:varchar(100);not null"`
+	Rating       int       `gorm:"type:smallint;not null"`
+	Comment      string    `gorm:"type:text"`
+	IsHidden     bool      `gorm:"type:boolean;not null;default:false"`
+	HiddenReason *string   `gorm:"type:text"`
+	CreatedAt    *time.Time
+	UpdatedAt    *time.Time
+	Field        Field `gorm:"foreignKey:field_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
@@ -0,0 +1,23 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Venue struct {
+	ID          uint       `gorm:"primaryKey;autoIncrement;not null"`
+	UUID        uuid.UUID  `gorm:"type:uuid;not null"`
+	Name        string     `gorm:"type:varchar(100);not null"`
+	Address     string     `gorm:"type:text;not null"`
+	City        string     `gorm:"type:varchar(100);not null"`
+	Latitude    float64    `gorm:"type:decimal(10,8);not null"`
+	Longitude   float64    `gorm:"type:decimal(11,8);not null"`
+	PhoneNumber string     `gorm:"type:varchar(15);not null"`
+	Email       string     `gorm:"type:varchar(100)"`
+	ManagerID   *uuid.UUID `gorm:"type:uuid"`
+	CreatedAt   *time.Time
+	UpdatedAt   *time.Time
+	Fields      []Field `gorm:"foreignKey:venue_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+}
@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+
+	cons "github.com/anddriii/kita-futsal/field-service/constants"
+	"github.com/google/uuid"
+)
+
+type Waitlist struct {
+	ID              uint                `gorm:"primaryKey;autoIncrement;not null"`
+	UUID            uuid.UUID           `gorm:"type:uuid;not null"`
+	FieldScheduleID uint                `gorm:"type:int;not null;index"`
+	UserID          uuid.UUID           `gorm:"type:uuid;not null"`
+	UserName        string              `gorm:"type:varchar(100);not null"`
+	Email           string              `gorm:"type:varchar(100)"`
+	PhoneNumber     string              `gorm:"type:varchar(15)"`
+	Status          cons.WaitlistStatus `gorm:"type:int;not null"`
+	NotifiedAt      *time.Time
+	ClaimExpiresAt  *time.Time
+	CreatedAt       *time.Time
+	UpdatedAt       *time.Time
+	FieldSchedule   FieldSchedule `gorm:"foreignKey:field_schedule_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
@@ -2,29 +2,25 @@ package middlewares
 
 import (
 	"context"
-	"crypto/sha256"
-	"encoding/hex"
-	"fmt"
-	"log"
+	"errors"
 	"net/http"
 	"strings"
 
 	"github.com/anddriii/kita-futsal/field-service/clients"
+	"github.com/anddriii/kita-futsal/field-service/common/logger"
 	"github.com/anddriii/kita-futsal/field-service/common/response"
-	"github.com/anddriii/kita-futsal/field-service/config"
 	"github.com/anddriii/kita-futsal/field-service/constants"
 	errCons "github.com/anddriii/kita-futsal/field-service/constants/error"
 	"github.com/didip/tollbooth"
 	"github.com/didip/tollbooth/limiter"
 	"github.com/gin-gonic/gin"
-	"github.com/sirupsen/logrus"
 )
 
 func HandlePanic() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		defer func() {
 			if r := recover(); r != nil {
-				logrus.Errorf("Recovered from panic: %v", r)
+				logger.FromContext(ctx.Request.Context()).Errorf("recovered from panic: %v", r)
 				ctx.JSON(http.StatusInternalServerError, response.Response{
 					Status:  constants.Error,
 					Message: errCons.ErrInternalServerError.Error(),
@@ -59,37 +55,13 @@ func responUnauthorized(ctx *gin.Context, message string) {
 	ctx.Abort()
 }
 
-func validateApiKey(ctx *gin.Context) error {
-	// Ambil nilai API Key dan metadata dari request header
-	apiKey := ctx.GetHeader(constants.XApiKey)           // API Key yang dikirim oleh client
-	serviceName := ctx.GetHeader(constants.XServiceName) // Nama layanan yang melakukan request
-	requestAt := ctx.GetHeader(constants.XRequestAt)     // Timestamp saat request dikirim
-	log.Println("Apikey:", apiKey)
-	log.Println("servicename:", serviceName)
-	log.Println("request at:", requestAt)
-
-	// Ambil Signature Key dari konfigurasi server
-	signatureKey := config.Config.SignatureKey
-
-	// Buat string validasi dengan format "serviceName:signatureKey:requestAt"
-	validateKey := fmt.Sprintf("%s:%s:%s", serviceName, signatureKey, requestAt)
-
-	// Buat hash SHA-256 dari validateKey
-	hash := sha256.New()
-	hash.Write([]byte(validateKey))
-	resultHash := hex.EncodeToString(hash.Sum(nil)) // Konversi hash ke format string heksadesimal
-
-	// Bandingkan API Key dari request dengan hasil hash yang dihasilkan
-	if apiKey != resultHash {
-		// Jika tidak cocok, kembalikan error Unauthorized
-		log.Println("Apikey tidak sesuai dengan hash")
-		log.Println("api key: ", apiKey)
-		log.Println("resultHash: ", resultHash)
-		return errCons.ErrUnauthorized
-	}
-
-	// Jika validasi sukses, kembalikan nil (tidak ada error)
-	return nil
+// responServiceUnavailable mengirim response 503 saat user-service tidak bisa dihubungi.
+func responServiceUnavailable(ctx *gin.Context) {
+	ctx.JSON(http.StatusServiceUnavailable, response.Response{
+		Status:  constants.Error,
+		Message: errCons.ErrServiceUnavailable.Error(),
+	})
+	ctx.Abort()
 }
 
 func contains(roles []string, role string) bool {
@@ -104,19 +76,25 @@ func contains(roles []string, role string) bool {
 func CheckRole(roles []string, client clients.IClientRegistry) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		user, err := client.GetUser().GetUserByToken(ctx.Request.Context())
-		fmt.Println("user", user)
 		if err != nil {
-			fmt.Printf("error from get check role %s", err)
-			fmt.Println(err)
-			// responUnauthorized(ctx, errCons.ErrUnauthorized.Error())
+			if errors.Is(err, errCons.ErrServiceUnavailable) {
+				responServiceUnavailable(ctx)
+				return
+			}
+			responUnauthorized(ctx, errCons.ErrUnauthorized.Error())
 			return
 		}
 
 		if !contains(roles, user.Role) {
-			fmt.Printf("error from check role %s", err)
 			responUnauthorized(ctx, errCons.ErrUnauthorized.Error())
 			return
 		}
+
+		// simpan user yang login agar service bisa mengecek kepemilikan venue
+		ctx.Set(constants.UserLogin, user)
+		userCtx := context.WithValue(ctx.Request.Context(), constants.UserLogin, user)
+		userCtx = context.WithValue(userCtx, constants.UserUUID, user.UUID.String())
+		ctx.Request = ctx.Request.WithContext(userCtx)
 		ctx.Next()
 	}
 }
@@ -134,14 +112,12 @@ func Authenticate() gin.HandlerFunc {
 		var err error
 		token := c.GetHeader(constants.Authorization)
 		if token == "" {
-			log.Println("error: token kosong")
 			responUnauthorized(c, errCons.ErrUnauthorized.Error())
 			return
 		}
 
-		err = validateApiKey(c)
+		err = validateSignature(c)
 		if err != nil {
-			log.Println("error vaidate API KEY")
 			responUnauthorized(c, err.Error())
 			return
 		}
@@ -156,9 +132,8 @@ func Authenticate() gin.HandlerFunc {
 
 func AuthenticateWithoutToken() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		err := validateApiKey(c)
+		err := validateSignature(c)
 		if err != nil {
-			log.Println("error vaidate API KEY")
 			responUnauthorized(c, err.Error())
 			return
 		}
@@ -0,0 +1,59 @@
+package middlewares
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	"github.com/anddriii/kita-futsal/field-service/common/logger"
+	"github.com/anddriii/kita-futsal/field-service/common/signature"
+	"github.com/anddriii/kita-futsal/field-service/config"
+	"github.com/anddriii/kita-futsal/field-service/constants"
+	errCons "github.com/anddriii/kita-futsal/field-service/constants/error"
+	"github.com/gin-gonic/gin"
+)
+
+// nonces menyimpan nonce request antar service yang sudah diterima.
+var nonces signature.NonceStore
+
+// SetNonceStore memasang penyimpanan nonce untuk menolak request yang diputar ulang.
+// Dipanggil sekali saat service dijalankan, sebelum server menerima request.
+func SetNonceStore(store signature.NonceStore) {
+	nonces = store
+}
+
+// validateSignature memverifikasi signature HMAC request HTTP. Body dibaca
+// untuk di-hash lalu dikembalikan agar tetap bisa di-bind oleh controller.
+func validateSignature(ctx *gin.Context) error {
+	body, err := io.ReadAll(ctx.Request.Body)
+	if err != nil {
+		return errCons.ErrUnauthorized
+	}
+	ctx.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+	return checkSignature(ctx.Request.Context(), signature.Request{
+		ServiceName: ctx.GetHeader(constants.XServiceName),
+		Method:      ctx.Request.Method,
+		Path:        ctx.Request.URL.RequestURI(),
+		Body:        body,
+		Timestamp:   ctx.GetHeader(constants.XRequestAt),
+		Nonce:       ctx.GetHeader(constants.XNonce),
+	}, ctx.GetHeader(constants.XSignature))
+}
+
+// checkSignature dipakai bersama oleh middleware HTTP dan interceptor gRPC.
+// Alasan penolakan hanya dicatat di log, pemanggil selalu menerima ErrUnauthorized.
+func checkSignature(ctx context.Context, request signature.Request, sign string) error {
+	if nonces == nil {
+		logger.FromContext(ctx).Error("nonce store belum dipasang, request antar service ditolak")
+		return errCons.ErrUnauthorized
+	}
+
+	err := signature.Verify(ctx, config.Config.SignatureKey, request, sign, nonces)
+	if err != nil {
+		logger.FromContext(ctx).Warnf("signature dari %q ditolak: %v", request.ServiceName, err)
+		return errCons.ErrUnauthorized
+	}
+
+	return nil
+}
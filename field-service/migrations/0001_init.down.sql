@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS waitlists;
+DROP TABLE IF EXISTS reviews;
+DROP TABLE IF EXISTS field_schedules;
+DROP TABLE IF EXISTS times;
+DROP TABLE IF EXISTS fields;
+DROP TABLE IF EXISTS venues;
@@ -0,0 +1,92 @@
+-- Skema awal, sama dengan hasil AutoMigrate sebelumnya. IF NOT EXISTS membuat database
+-- yang sudah dibuat oleh AutoMigrate bisa langsung memakai migrasi ini.
+CREATE TABLE IF NOT EXISTS venues (
+    id bigserial PRIMARY KEY,
+    uuid uuid NOT NULL,
+    name varchar(100) NOT NULL,
+    address text NOT NULL,
+    city varchar(100) NOT NULL,
+    latitude decimal(10,8) NOT NULL,
+    longitude decimal(11,8) NOT NULL,
+    phone_number varchar(15) NOT NULL,
+    email varchar(100),
+    manager_id uuid,
+    created_at timestamptz,
+    updated_at timestamptz
+);
+
+CREATE TABLE IF NOT EXISTS fields (
+    id bigserial PRIMARY KEY,
+    uuid uuid NOT NULL,
+    code varchar(15) NOT NULL,
+    name varchar(100) NOT NULL,
+    description text NOT NULL,
+    image text[] NOT NULL,
+    latitude decimal(10,8) NOT NULL,
+    lonitude decimal(11,8) NOT NULL,
+    price_per_hour int NOT NULL,
+    venue_id int,
+    created_at timestamptz,
+    updated_at timestamptz,
+    deleted_at timestamptz,
+    CONSTRAINT fk_venues_fields FOREIGN KEY (venue_id) REFERENCES venues (id) ON UPDATE CASCADE ON DELETE SET NULL
+);
+
+CREATE TABLE IF NOT EXISTS times (
+    id bigserial PRIMARY KEY,
+    uuid uuid NOT NULL,
+    start_time time without time zone NOT NULL,
+    end_time time without time zone NOT NULL,
+    created_at timestamptz,
+    updated_at timestamptz
+);
+
+CREATE TABLE IF NOT EXISTS field_schedules (
+    id bigserial PRIMARY KEY,
+    uuid uuid NOT NULL,
+    field_id int NOT NULL,
+    time_id int NOT NULL,
+    date date NOT NULL,
+    status int NOT NULL,
+    created_at timestamptz,
+    updated_at timestamptz,
+    deleted_at timestamptz,
+    CONSTRAINT fk_fields_field_schedule FOREIGN KEY (field_id) REFERENCES fields (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT fk_field_schedules_time FOREIGN KEY (time_id) REFERENCES times (id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS reviews (
+    id bigserial PRIMARY KEY,
+    uuid uuid NOT NULL,
+    field_id int NOT NULL,
+    order_id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    user_name varchar(100) NOT NULL,
+    rating smallint NOT NULL,
+    comment text,
+    is_hidden boolean NOT NULL DEFAULT false,
+    hidden_reason text,
+    created_at timestamptz,
+    updated_at timestamptz,
+    CONSTRAINT fk_reviews_field FOREIGN KEY (field_id) REFERENCES fields (id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_review_order_field ON reviews (field_id, order_id);
+
+CREATE TABLE IF NOT EXISTS waitlists (
+    id bigserial PRIMARY KEY,
+    uuid uuid NOT NULL,
+    field_schedule_id int NOT NULL,
+    user_id uuid NOT NULL,
+    user_name varchar(100) NOT NULL,
+    email varchar(100),
+    phone_number varchar(15),
+    status int NOT NULL,
+    notified_at timestamptz,
+    claim_expires_at timestamptz,
+    created_at timestamptz,
+    updated_at timestamptz,
+    CONSTRAINT fk_waitlists_field_schedule FOREIGN KEY (field_schedule_id) REFERENCES field_schedules (id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_waitlists_field_schedule_id ON waitlists (field_schedule_id);
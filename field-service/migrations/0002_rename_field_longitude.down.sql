@@ -0,0 +1 @@
+ALTER TABLE fields RENAME COLUMN longitude TO lonitude;
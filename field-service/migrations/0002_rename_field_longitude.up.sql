@@ -0,0 +1 @@
+ALTER TABLE fields RENAME COLUMN lonitude TO longitude;
@@ -0,0 +1,4 @@
+-- Satu lapangan hanya boleh punya satu jadwal untuk jam yang sama di tanggal yang sama.
+-- Index ini juga dipakai query jadwal per lapangan dan tanggal di halaman booking.
+-- Migrasi gagal jika masih ada jadwal ganda; hapus duplikatnya lebih dulu.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_field_schedules_field_date_time ON field_schedules (field_id, date, time_id);
@@ -0,0 +1,7 @@
+-- Gagal jika ada jadwal terhapus yang slotnya sudah dipakai jadwal baru; hapus permanen
+-- jadwal terhapus tersebut lebih dulu.
+DROP INDEX IF EXISTS idx_field_schedules_field_date_time;
+CREATE UNIQUE INDEX idx_field_schedules_field_date_time ON field_schedules (field_id, date, time_id);
+
+DROP INDEX IF EXISTS idx_field_schedules_deleted_at;
+DROP INDEX IF EXISTS idx_fields_deleted_at;
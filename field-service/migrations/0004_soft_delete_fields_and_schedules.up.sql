@@ -0,0 +1,8 @@
+-- Lapangan dan jadwal sekarang dihapus dengan soft delete. Jadwal yang sudah dihapus tidak
+-- boleh menghalangi pembuatan jadwal baru di slot yang sama, jadi index unik hanya
+-- berlaku untuk baris yang belum dihapus.
+CREATE INDEX IF NOT EXISTS idx_fields_deleted_at ON fields (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_field_schedules_deleted_at ON field_schedules (deleted_at);
+
+DROP INDEX IF EXISTS idx_field_schedules_field_date_time;
+CREATE UNIQUE INDEX idx_field_schedules_field_date_time ON field_schedules (field_id, date, time_id) WHERE deleted_at IS NULL;
@@ -0,0 +1,9 @@
+// Package migrations berisi file SQL skema database field-service. Nama file mengikuti
+// pola <versi>_<nama>.up.sql dan <versi>_<nama>.down.sql; versi tidak boleh diubah
+// setelah dirilis.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
@@ -0,0 +1,109 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: field/field.proto
+
+package field
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	FieldService_UpdateFieldScheduleStatus_FullMethodName = "/field.FieldService/UpdateFieldScheduleStatus"
+)
+
+// FieldServiceClient is the client API for FieldService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FieldServiceClient interface {
+	UpdateFieldScheduleStatus(ctx context.Context, in *UpdateFieldScheduleStatusRequest, opts ...grpc.CallOption) (*UpdateFieldScheduleStatusResponse, error)
+}
+
+type fieldServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFieldServiceClient(cc grpc.ClientConnInterface) FieldServiceClient {
+	return &fieldServiceClient{cc}
+}
+
+func (c *fieldServiceClient) UpdateFieldScheduleStatus(ctx context.Context, in *UpdateFieldScheduleStatusRequest, opts ...grpc.CallOption) (*UpdateFieldScheduleStatusResponse, error) {
+	out := new(UpdateFieldScheduleStatusResponse)
+	err := c.cc.Invoke(ctx, FieldService_UpdateFieldScheduleStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FieldServiceServer is the server API for FieldService service.
+// All implementations must embed UnimplementedFieldServiceServer
+// for forward compatibility
+type FieldServiceServer interface {
+	UpdateFieldScheduleStatus(context.Context, *UpdateFieldScheduleStatusRequest) (*UpdateFieldScheduleStatusResponse, error)
+	mustEmbedUnimplementedFieldServiceServer()
+}
+
+// UnimplementedFieldServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedFieldServiceServer struct {
+}
+
+func (UnimplementedFieldServiceServer) UpdateFieldScheduleStatus(context.Context, *UpdateFieldScheduleStatusRequest) (*UpdateFieldScheduleStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFieldScheduleStatus not implemented")
+}
+func (UnimplementedFieldServiceServer) mustEmbedUnimplementedFieldServiceServer() {}
+
+// UnsafeFieldServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FieldServiceServer will
+// result in compilation errors.
+type UnsafeFieldServiceServer interface {
+	mustEmbedUnimplementedFieldServiceServer()
+}
+
+func RegisterFieldServiceServer(s grpc.ServiceRegistrar, srv FieldServiceServer) {
+	s.RegisterService(&FieldService_ServiceDesc, srv)
+}
+
+func _FieldService_UpdateFieldScheduleStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFieldScheduleStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FieldServiceServer).UpdateFieldScheduleStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FieldService_UpdateFieldScheduleStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FieldServiceServer).UpdateFieldScheduleStatus(ctx, req.(*UpdateFieldScheduleStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FieldService_ServiceDesc is the grpc.ServiceDesc for FieldService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FieldService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "field.FieldService",
+	HandlerType: (*FieldServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateFieldScheduleStatus",
+			Handler:    _FieldService_UpdateFieldScheduleStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "field/field.proto",
+}
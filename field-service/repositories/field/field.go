@@ -14,4 +14,6 @@ type IFieldRepository interface {
 	Create(ctx context.Context, req *models.Field) (*models.Field, error)
 	Update(ctx context.Context, uuid string, req *models.Field) (*models.Field, error)
 	Delete(ctx context.Context, uuid string) error
+	FindDeletedByUUID(ctx context.Context, uuid string) (*models.Field, error)
+	Restore(ctx context.Context, field *models.Field) error
 }
@@ -3,17 +3,18 @@ package repositoroes
 import (
 	"context"
 	"errors"
-	"fmt"
+	"time"
 
 	errWrap "github.com/anddriii/kita-futsal/field-service/common/error"
+	"github.com/anddriii/kita-futsal/field-service/common/logger"
+	"github.com/anddriii/kita-futsal/field-service/common/query"
 	errConst "github.com/anddriii/kita-futsal/field-service/constants/error"
 	errField "github.com/anddriii/kita-futsal/field-service/constants/error/field"
 	"github.com/anddriii/kita-futsal/field-service/domains/dto"
 	"github.com/anddriii/kita-futsal/field-service/domains/models"
-	"github.com/gofiber/fiber/v2/log"
 	"github.com/google/uuid"
-	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type FieldRepository struct {
@@ -32,51 +33,126 @@ func (f *FieldRepository) Create(ctx context.Context, req *models.Field) (*model
 		Name:         req.Name,
 		Image:        req.Image,
 		Latitude:     req.Latitude,
-		Lonitude:     req.Lonitude,
+		Longitude:    req.Longitude,
 		PricePerHour: req.PricePerHour,
+		VenueID:      req.VenueID,
 	}
 
-	fmt.Print("sudah masuk ke database")
-
 	err := f.db.WithContext(ctx).Create(&field).Error
 	if err != nil {
-		log.Errorf("error from repositories", err)
+		logger.FromContext(ctx).Errorf("gagal menyimpan lapangan: %v", err)
 		return nil, errWrap.WrapError(errConst.ErrSQLError)
 	}
 
-	fmt.Print("sudah dibuat ke database")
-
 	return &field, nil
 }
 
 // Delete implements IFieldRepository.
+// Lapangan dan semua jadwalnya di-soft delete dengan waktu yang sama, sehingga Restore
+// hanya mengembalikan jadwal yang ikut terhapus bersama lapangan. Jadwal tetap tersimpan
+// karena masih dirujuk oleh order di order-service.
 func (f *FieldRepository) Delete(ctx context.Context, uuid string) error {
-	err := f.db.WithContext(ctx).Where("uuid = ?", uuid).Delete(&models.Field{}).Error
+	now := time.Now()
+	err := f.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&models.FieldSchedule{}).
+			Where("field_id IN (?)", tx.Model(&models.Field{}).Select("id").Where("uuid = ?", uuid)).
+			Update("deleted_at", now).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Model(&models.Field{}).Where("uuid = ?", uuid).Update("deleted_at", now).Error
+	})
+	if err != nil {
+		logger.FromContext(ctx).Errorf("gagal menghapus lapangan: %v", err)
+		return errWrap.WrapError(errConst.ErrSQLError)
+	}
+
+	return nil
+}
+
+// FindDeletedByUUID implements IFieldRepository.
+func (f *FieldRepository) FindDeletedByUUID(ctx context.Context, uuid string) (*models.Field, error) {
+	var field models.Field
+	err := f.db.WithContext(ctx).Unscoped().Preload("Venue").
+		Where("uuid = ?", uuid).
+		Where("deleted_at IS NOT NULL").
+		First(&field).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errWrap.WrapError(errField.ErrFieldNotFound)
+		}
+		logger.FromContext(ctx).Errorf("gagal mencari lapangan terhapus: %v", err)
+		return nil, errWrap.WrapError(errConst.ErrSQLError)
+	}
+
+	return &field, nil
+}
+
+// Restore implements IFieldRepository.
+// Mengembalikan lapangan beserta jadwal yang terhapus bersamanya. Jadwal yang sudah
+// dihapus sendiri sebelum lapangan dihapus tetap terhapus.
+func (f *FieldRepository) Restore(ctx context.Context, field *models.Field) error {
+	err := f.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Unscoped().Model(&models.FieldSchedule{}).
+			Where("field_id = ?", field.ID).
+			Where("deleted_at = ?", field.DeletedAt.Time).
+			Update("deleted_at", nil).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Unscoped().Model(&models.Field{}).Where("id = ?", field.ID).Update("deleted_at", nil).Error
+	})
 	if err != nil {
+		logger.FromContext(ctx).Errorf("gagal memulihkan lapangan: %v", err)
 		return errWrap.WrapError(errConst.ErrSQLError)
 	}
 
 	return nil
 }
 
+// fieldQuerySpec berisi kolom yang boleh dipakai untuk sorting daftar lapangan.
+var fieldQuerySpec = query.Spec{
+	SortColumns: map[string]string{
+		"code":         "code",
+		"name":         "name",
+		"pricePerHour": "price_per_hour",
+		"createdAt":    "created_at",
+		"updatedAt":    "updated_at",
+	},
+	DefaultSort: clause.OrderByColumn{Column: clause.Column{Name: "created_at"}, Desc: true},
+}
+
 // FindALlWithPagination implements IFieldRepository.
 func (f *FieldRepository) FindALlWithPagination(ctx context.Context, param *dto.FieldRequestParam) ([]models.Field, int64, error) {
 	var (
 		fields []models.Field
-		sort   string
 		total  int64
 	)
 
-	if param.SortColumn != nil {
-		sort = fmt.Sprintf("%s %s", *param.SortColumn, *param.SortOrder)
-	} else {
-		sort = "created_at desc"
+	sort, err := fieldQuerySpec.OrderBy(param.SortColumn, param.SortOrder)
+	if err != nil {
+		return nil, 0, err
 	}
 
 	limit := param.Limit
 	offset := (param.Page - 1) * limit
 
-	err := f.db.WithContext(ctx).
+	// daftar lapangan terhapus hanya berisi lapangan yang sudah di-soft delete
+	db := f.db.WithContext(ctx)
+	if param.Deleted {
+		db = db.Unscoped().Where("deleted_at IS NOT NULL")
+	}
+
+	// filter berdasarkan venue (dipakai untuk venue manager / filter admin) dan rentang harga
+	db = query.Apply(db.Model(&models.Field{}),
+		query.In("venue_id", param.VenueIDs),
+		query.Range("price_per_hour", param.MinPrice, param.MaxPrice),
+	)
+
+	err = db.Session(&gorm.Session{}).
+		Preload("Venue").
 		Order(sort).
 		Limit(limit).
 		Offset(offset).
@@ -86,8 +162,7 @@ func (f *FieldRepository) FindALlWithPagination(ctx context.Context, param *dto.
 	}
 
 	// hitung total data TANPA limit & offset
-	err = f.db.WithContext(ctx).
-		Model(&models.Field{}).
+	err = db.Session(&gorm.Session{}).
 		Count(&total).Error
 	if err != nil {
 		return nil, 0, errWrap.WrapError(errConst.ErrSQLError)
@@ -99,9 +174,9 @@ func (f *FieldRepository) FindALlWithPagination(ctx context.Context, param *dto.
 // FindAllWithoutPagination implements IFieldRepository.
 func (f *FieldRepository) FindAllWithoutPagination(ctx context.Context) ([]models.Field, error) {
 	var fileds []models.Field
-	err := f.db.WithContext(ctx).Find(&fileds).Error
+	err := f.db.WithContext(ctx).Preload("Venue").Find(&fileds).Error
 	if err != nil {
-		logrus.Printf("Error repositories: %e", err)
+		logger.FromContext(ctx).Errorf("gagal mengambil semua lapangan: %v", err)
 		return nil, errWrap.WrapError(errConst.ErrSQLError)
 	}
 
@@ -111,12 +186,12 @@ func (f *FieldRepository) FindAllWithoutPagination(ctx context.Context) ([]model
 // FindByUUID implements IFieldRepository.
 func (f *FieldRepository) FindByUUID(ctx context.Context, uuid string) (*models.Field, error) {
 	var fields models.Field
-	err := f.db.WithContext(ctx).Where("uuid = ?", uuid).First(&fields).Error
+	err := f.db.WithContext(ctx).Preload("Venue").Where("uuid = ?", uuid).First(&fields).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, errWrap.WrapError(errField.ErrFieldNotFound)
 		}
-		logrus.Printf("Syntaq SQL Error %e", err)
+		logger.FromContext(ctx).Errorf("gagal mencari lapangan berdasarkan uuid: %v", err)
 		return nil, errWrap.WrapError(errConst.ErrSQLError)
 	}
 
@@ -130,8 +205,9 @@ func (f *FieldRepository) Update(ctx context.Context, uuid string, req *models.F
 		Name:         req.Name,
 		Image:        req.Image,
 		Latitude:     req.Latitude,
-		Lonitude:     req.Lonitude,
+		Longitude:    req.Longitude,
 		PricePerHour: req.PricePerHour,
+		VenueID:      req.VenueID,
 	}
 
 	err := f.db.WithContext(ctx).Where("uuid = ?", &uuid).Updates(&field).Error
@@ -16,5 +16,9 @@ type IFieldScheduleRepository interface {
 	Create(ctx context.Context, req []models.FieldSchedule) error
 	Update(ctx context.Context, uuid string, req *models.FieldSchedule) (*models.FieldSchedule, error)
 	UpdateStatus(ctx context.Context, status constants.FieldScheduleStatus, uuid string) error
+	UpdateStatusFrom(ctx context.Context, uuid string, from, to constants.FieldScheduleStatus) (bool, error)
 	Delete(ctx context.Context, uuid string) error
+	FindDeletedByUUID(ctx context.Context, uuid string) (*models.FieldSchedule, error)
+	CountBookedFromDate(ctx context.Context, fieldID uint, date string) (int64, error)
+	Restore(ctx context.Context, uuid string) error
 }
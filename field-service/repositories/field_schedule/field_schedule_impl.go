@@ -3,15 +3,16 @@ package repositories
 import (
 	"context"
 	"errors"
-	"fmt"
 
 	errWrap "github.com/anddriii/kita-futsal/field-service/common/error"
+	"github.com/anddriii/kita-futsal/field-service/common/query"
 	"github.com/anddriii/kita-futsal/field-service/constants"
 	errConst "github.com/anddriii/kita-futsal/field-service/constants/error"
 	errField "github.com/anddriii/kita-futsal/field-service/constants/error/field_schedule"
 	"github.com/anddriii/kita-futsal/field-service/domains/dto"
 	"github.com/anddriii/kita-futsal/field-service/domains/models"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type FieldScheduleRepository struct {
@@ -61,19 +62,29 @@ func (f *FieldScheduleRepository) FindAllByIdAndDate(ctx context.Context, FieldI
 	return fieldSchedules, nil
 }
 
+// fieldScheduleQuerySpec berisi kolom yang boleh dipakai untuk sorting daftar jadwal lapangan.
+var fieldScheduleQuerySpec = query.Spec{
+	SortColumns: map[string]string{
+		"date":      "date",
+		"status":    "status",
+		"createdAt": "created_at",
+		"updatedAt": "updated_at",
+	},
+	DefaultSort: clause.OrderByColumn{Column: clause.Column{Name: "created_at"}, Desc: true},
+}
+
 // FindAllWithPagination implements IFieldScheduleRepository.
 func (f *FieldScheduleRepository) FindAllWithPagination(ctx context.Context, param *dto.FieldScheduleRequestParam) ([]models.FieldSchedule, int64, error) {
 	var (
 		fieldSchedules []models.FieldSchedule
-		sort           string
 		total          int64
+		status         *constants.FieldScheduleStatus
 	)
 
-	// Jika user memberikan kolom sorting (SortColumn) dan arah sorting (SortOrder), maka formatnya dibuat sesuai input.
-	if param.SortColumn != nil {
-		sort = fmt.Sprintf("%s %s", *param.SortColumn, *param.SortOrder)
-	} else {
-		sort = "created_at desc"
+	// Kolom sorting (SortColumn) harus terdaftar di fieldScheduleQuerySpec, selain itu akan ditolak.
+	sort, err := fieldScheduleQuerySpec.OrderBy(param.SortColumn, param.SortOrder)
+	if err != nil {
+		return nil, 0, err
 	}
 
 	/*
@@ -83,8 +94,37 @@ func (f *FieldScheduleRepository) FindAllWithPagination(ctx context.Context, par
 	*/
 	limit := param.Limit
 	offset := (param.Page - 1) * limit
-	err := f.db.WithContext(ctx).
-		Preload("Field").
+
+	if param.Status != nil {
+		value := param.Status.GetStatusInt()
+		status = &value
+	}
+
+	// Membatasi jadwal hanya untuk lapangan milik venue tertentu (jika ada filter venue),
+	// lalu menerapkan filter lapangan, status dan rentang tanggal.
+	// Daftar jadwal terhapus juga memuat lapangan yang sudah terhapus.
+	db := f.db.WithContext(ctx)
+	fields := f.db.Model(&models.Field{})
+	preloadField := func(db *gorm.DB) *gorm.DB { return db }
+	if param.Deleted {
+		db = db.Unscoped().Where("deleted_at IS NOT NULL")
+		fields = f.db.Unscoped().Model(&models.Field{})
+		preloadField = func(db *gorm.DB) *gorm.DB { return db.Unscoped() }
+	}
+
+	db = query.Apply(db.Model(&models.FieldSchedule{}),
+		query.Equal("status", status),
+		query.DateRange("date", param.StartDate, param.EndDate),
+	)
+	if param.VenueIDs != nil {
+		db = db.Where("field_id IN (?)", fields.Session(&gorm.Session{}).Select("id").Where("venue_id IN ?", param.VenueIDs))
+	}
+	if param.FieldID != nil {
+		db = db.Where("field_id IN (?)", fields.Session(&gorm.Session{}).Select("id").Where("uuid = ?", *param.FieldID))
+	}
+
+	err = db.Session(&gorm.Session{}).
+		Preload("Field", preloadField).
 		Preload("Time").
 		Limit(limit).   // Mengatur jumlah data yang diambil dalam satu halaman.
 		Offset(offset). // Menentukan titik mulai pengambilan data berdasarkan halaman
@@ -96,7 +136,7 @@ func (f *FieldScheduleRepository) FindAllWithPagination(ctx context.Context, par
 	}
 
 	// menghitung jumlah total data yang tersedia tanpa pagination.
-	err = f.db.WithContext(ctx).Model(&models.FieldSchedule{}).Count(&total).Error
+	err = db.Session(&gorm.Session{}).Count(&total).Error
 	if err != nil {
 		return nil, 0, errWrap.WrapError(errConst.ErrSQLError)
 	}
@@ -113,16 +153,63 @@ func (f *FieldScheduleRepository) FindByDateAndTimeId(ctx context.Context, date
 		}
 		return nil, errWrap.WrapError(errConst.ErrSQLError)
 	}
-	fmt.Println("response dari repo", fieldSchedule)
 
 	return &fieldSchedule, nil
 }
 
+// FindDeletedByUUID implements IFieldScheduleRepository.
+// Lapangan ikut dimuat walaupun sudah terhapus supaya pemanggil bisa memeriksanya.
+func (f *FieldScheduleRepository) FindDeletedByUUID(ctx context.Context, uuid string) (*models.FieldSchedule, error) {
+	var fieldSchedule models.FieldSchedule
+
+	err := f.db.WithContext(ctx).Unscoped().
+		Preload("Field", func(db *gorm.DB) *gorm.DB { return db.Unscoped() }).
+		Preload("Time").
+		Where("uuid = ?", uuid).
+		Where("deleted_at IS NOT NULL").
+		First(&fieldSchedule).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errWrap.WrapError(errField.ErrFieldScheduleNotFound)
+		}
+		return nil, errWrap.WrapError(errConst.ErrSQLError)
+	}
+	return &fieldSchedule, nil
+}
+
+// CountBookedFromDate implements IFieldScheduleRepository.
+// Jadwal yang sedang ditahan order (Held) ikut dihitung karena bisa menjadi Booked.
+func (f *FieldScheduleRepository) CountBookedFromDate(ctx context.Context, fieldID uint, date string) (int64, error) {
+	var total int64
+
+	err := f.db.WithContext(ctx).Model(&models.FieldSchedule{}).
+		Where("field_id = ?", fieldID).
+		Where("status IN ?", []constants.FieldScheduleStatus{constants.Held, constants.Booked}).
+		Where("date >= ?", date).
+		Count(&total).Error
+	if err != nil {
+		return 0, errWrap.WrapError(errConst.ErrSQLError)
+	}
+	return total, nil
+}
+
+// Restore implements IFieldScheduleRepository.
+func (f *FieldScheduleRepository) Restore(ctx context.Context, uuid string) error {
+	err := f.db.WithContext(ctx).Unscoped().Model(&models.FieldSchedule{}).
+		Where("uuid = ?", uuid).
+		Update("deleted_at", nil).Error
+	if err != nil {
+		return errWrap.WrapError(errConst.ErrSQLError)
+	}
+
+	return nil
+}
+
 // FindByUUID implements IFieldScheduleRepository.
 func (f *FieldScheduleRepository) FindByUUID(ctx context.Context, uuid string) (*models.FieldSchedule, error) {
 	var fieldSchedule models.FieldSchedule
 
-	err := f.db.WithContext(ctx).Preload("Field").Preload("Time").Where("uuid = ?", uuid).First(&fieldSchedule).Error
+	err := f.db.WithContext(ctx).Preload("Field.Venue").Preload("Time").Where("uuid = ?", uuid).First(&fieldSchedule).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, errWrap.WrapError(errField.ErrFieldScheduleNotFound)
@@ -163,3 +250,17 @@ func (f *FieldScheduleRepository) UpdateStatus(ctx context.Context, status const
 
 	return nil
 }
+
+// UpdateStatusFrom implements IFieldScheduleRepository.
+// Status hanya diubah jika status saat ini sama dengan from, dicek dan diubah dalam satu
+// query. Hasilnya false jika status jadwal sudah lebih dulu diubah request lain.
+func (f *FieldScheduleRepository) UpdateStatusFrom(ctx context.Context, uuid string, from, to constants.FieldScheduleStatus) (bool, error) {
+	result := f.db.WithContext(ctx).Model(&models.FieldSchedule{}).
+		Where("uuid = ? AND status = ?", uuid, from).
+		Update("status", to)
+	if result.Error != nil {
+		return false, errWrap.WrapError(errConst.ErrSQLError)
+	}
+
+	return result.RowsAffected > 0, nil
+}
@@ -3,7 +3,11 @@ package repositories
 import (
 	fieldRepo "github.com/anddriii/kita-futsal/field-service/repositories/field"
 	fieldSchedu "github.com/anddriii/kita-futsal/field-service/repositories/field_schedule"
+	reportRepo "github.com/anddriii/kita-futsal/field-service/repositories/report"
+	reviewRepo "github.com/anddriii/kita-futsal/field-service/repositories/review"
 	fieldTime "github.com/anddriii/kita-futsal/field-service/repositories/time"
+	venueRepo "github.com/anddriii/kita-futsal/field-service/repositories/venue"
+	waitlistRepo "github.com/anddriii/kita-futsal/field-service/repositories/waitlist"
 	"gorm.io/gorm"
 )
 
@@ -26,10 +30,34 @@ func (r *Registry) GetTime() fieldTime.ITimeRepository {
 	return fieldTime.NewTimeRepository(r.db)
 }
 
+// GetVenue implements IRepoRegistry.
+func (r *Registry) GetVenue() venueRepo.IVenueRepository {
+	return venueRepo.NewVenueRepository(r.db)
+}
+
+// GetReview implements IRepoRegistry.
+func (r *Registry) GetReview() reviewRepo.IReviewRepository {
+	return reviewRepo.NewReviewRepository(r.db)
+}
+
+// GetWaitlist implements IRepoRegistry.
+func (r *Registry) GetWaitlist() waitlistRepo.IWaitlistRepository {
+	return waitlistRepo.NewWaitlistRepository(r.db)
+}
+
+// GetReport implements IRepoRegistry.
+func (r *Registry) GetReport() reportRepo.IReportRepository {
+	return reportRepo.NewReportRepository(r.db)
+}
+
 type IRepoRegistry interface {
 	GetField() fieldRepo.IFieldRepository
 	GetFieldSchedule() fieldSchedu.IFieldScheduleRepository
 	GetTime() fieldTime.ITimeRepository
+	GetVenue() venueRepo.IVenueRepository
+	GetReview() reviewRepo.IReviewRepository
+	GetWaitlist() waitlistRepo.IWaitlistRepository
+	GetReport() reportRepo.IReportRepository
 }
 
 func NewRepositoryRegistry(db *gorm.DB) IRepoRegistry {
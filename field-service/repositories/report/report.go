@@ -0,0 +1,12 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/anddriii/kita-futsal/field-service/domains/dto"
+)
+
+type IReportRepository interface {
+	FindOccupancy(ctx context.Context, startDate, endDate string, venueID *string) ([]dto.OccupancyReport, error)
+	FindPeakHours(ctx context.Context, startDate, endDate string, venueID *string) ([]dto.PeakHourReportResponse, error)
+}
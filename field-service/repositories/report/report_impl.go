@@ -0,0 +1,70 @@
+package repositories
+
+import (
+	"context"
+
+	errWrap "github.com/anddriii/kita-futsal/field-service/common/error"
+	"github.com/anddriii/kita-futsal/field-service/constants"
+	errConst "github.com/anddriii/kita-futsal/field-service/constants/error"
+	"github.com/anddriii/kita-futsal/field-service/domains/dto"
+	"github.com/anddriii/kita-futsal/field-service/domains/models"
+	"gorm.io/gorm"
+)
+
+type ReportRepository struct {
+	db *gorm.DB
+}
+
+func NewReportRepository(db *gorm.DB) IReportRepository {
+	return &ReportRepository{db: db}
+}
+
+// scheduleQuery menyiapkan query jadwal lapangan pada rentang tanggal tertentu (inklusif),
+// opsional difilter berdasarkan UUID venue pemilik lapangan.
+func (r *ReportRepository) scheduleQuery(ctx context.Context, startDate, endDate string, venueID *string) *gorm.DB {
+	query := r.db.WithContext(ctx).
+		Model(&models.FieldSchedule{}).
+		Joins("JOIN fields ON fields.id = field_schedules.field_id").
+		Where("field_schedules.date BETWEEN ? AND ?", startDate, endDate)
+	if venueID != nil && *venueID != "" {
+		query = query.
+			Joins("JOIN venues ON venues.id = fields.venue_id").
+			Where("venues.uuid = ?", *venueID)
+	}
+	return query
+}
+
+// FindOccupancy implements IReportRepository.
+// Menghitung jumlah seluruh jadwal dan jadwal yang sudah Booked untuk setiap lapangan.
+func (r *ReportRepository) FindOccupancy(ctx context.Context, startDate, endDate string, venueID *string) ([]dto.OccupancyReport, error) {
+	var results []dto.OccupancyReport
+	err := r.scheduleQuery(ctx, startDate, endDate, venueID).
+		Select("fields.uuid AS field_id, fields.name AS field_name, "+
+			"COUNT(*) AS total_slot, COUNT(*) FILTER (WHERE field_schedules.status = ?) AS booked_slot", constants.Booked).
+		Group("fields.uuid, fields.name").
+		Order("fields.name asc").
+		Scan(&results).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConst.ErrSQLError)
+	}
+
+	return results, nil
+}
+
+// FindPeakHours implements IReportRepository.
+// Menghitung jumlah jadwal Booked per slot waktu, diurutkan dari yang paling ramai.
+func (r *ReportRepository) FindPeakHours(ctx context.Context, startDate, endDate string, venueID *string) ([]dto.PeakHourReportResponse, error) {
+	var results []dto.PeakHourReportResponse
+	err := r.scheduleQuery(ctx, startDate, endDate, venueID).
+		Joins("JOIN times ON times.id = field_schedules.time_id").
+		Select("times.start_time, times.end_time, "+
+			"COUNT(*) FILTER (WHERE field_schedules.status = ?) AS booked_slot, COUNT(*) AS total_slot", constants.Booked).
+		Group("times.start_time, times.end_time").
+		Order("booked_slot desc, times.start_time asc").
+		Scan(&results).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConst.ErrSQLError)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,19 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/anddriii/kita-futsal/field-service/domains/dto"
+	"github.com/anddriii/kita-futsal/field-service/domains/models"
+)
+
+type IReviewRepository interface {
+	FindAllWithPagination(ctx context.Context, param *dto.ReviewRequestParam) ([]models.Review, int64, error)
+	FindAllVisibleByFieldID(ctx context.Context, fieldID uint) ([]models.Review, error)
+	FindByUUID(ctx context.Context, uuid string) (*models.Review, error)
+	FindByOrderIDAndFieldID(ctx context.Context, orderID string, fieldID uint) (*models.Review, error)
+	FindRatingSummaryByFieldIDs(ctx context.Context, fieldIDs []uint) (map[uint]dto.RatingSummary, error)
+	Create(ctx context.Context, req *models.Review) (*models.Review, error)
+	UpdateVisibility(ctx context.Context, uuid string, isHidden bool, reason *string) (*models.Review, error)
+	Delete(ctx context.Context, uuid string) error
+}
@@ -0,0 +1,196 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	errWrap "github.com/anddriii/kita-futsal/field-service/common/error"
+	"github.com/anddriii/kita-futsal/field-service/common/query"
+	errConst "github.com/anddriii/kita-futsal/field-service/constants/error"
+	errReview "github.com/anddriii/kita-futsal/field-service/constants/error/review"
+	"github.com/anddriii/kita-futsal/field-service/domains/dto"
+	"github.com/anddriii/kita-futsal/field-service/domains/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ReviewRepository struct {
+	db *gorm.DB
+}
+
+func NewReviewRepository(db *gorm.DB) IReviewRepository {
+	return &ReviewRepository{db: db}
+}
+
+// Create implements IReviewRepository.
+func (r *ReviewRepository) Create(ctx context.Context, req *models.Review) (*models.Review, error) {
+	review := models.Review{
+		UUID:     uuid.New(),
+		FieldID:  req.FieldID,
+		OrderID:  req.OrderID,
+		UserID:   req.UserID,
+		UserName: req.UserName,
+		Rating:   req.Rating,
+		Comment:  req.Comment,
+	}
+
+	err := r.db.WithContext(ctx).Create(&review).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConst.ErrSQLError)
+	}
+
+	return &review, nil
+}
+
+// Delete implements IReviewRepository.
+func (r *ReviewRepository) Delete(ctx context.Context, uuid string) error {
+	err := r.db.WithContext(ctx).Where("uuid = ?", uuid).Delete(&models.Review{}).Error
+	if err != nil {
+		return errWrap.WrapError(errConst.ErrSQLError)
+	}
+
+	return nil
+}
+
+// reviewQuerySpec berisi kolom yang boleh dipakai untuk sorting daftar review.
+var reviewQuerySpec = query.Spec{
+	SortColumns: map[string]string{
+		"rating":    "rating",
+		"createdAt": "created_at",
+		"updatedAt": "updated_at",
+	},
+	DefaultSort: clause.OrderByColumn{Column: clause.Column{Name: "created_at"}, Desc: true},
+}
+
+// FindAllWithPagination implements IReviewRepository.
+// Dipakai oleh admin untuk moderasi, sehingga review yang disembunyikan juga ikut ditampilkan.
+func (r *ReviewRepository) FindAllWithPagination(ctx context.Context, param *dto.ReviewRequestParam) ([]models.Review, int64, error) {
+	var (
+		reviews []models.Review
+		total   int64
+	)
+
+	sort, err := reviewQuerySpec.OrderBy(param.SortColumn, param.SortOrder)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	limit := param.Limit
+	offset := (param.Page - 1) * limit
+
+	db := query.Apply(r.db.WithContext(ctx).Model(&models.Review{}),
+		query.Equal("is_hidden", param.IsHidden),
+	)
+	if param.FieldID != nil && *param.FieldID != "" {
+		db = db.Where("field_id IN (?)", r.db.Model(&models.Field{}).Select("id").Where("uuid = ?", *param.FieldID))
+	}
+
+	err = db.Session(&gorm.Session{}).
+		Preload("Field").
+		Order(sort).
+		Limit(limit).
+		Offset(offset).
+		Find(&reviews).Error
+	if err != nil {
+		return nil, 0, errWrap.WrapError(errConst.ErrSQLError)
+	}
+
+	err = db.Session(&gorm.Session{}).Count(&total).Error
+	if err != nil {
+		return nil, 0, errWrap.WrapError(errConst.ErrSQLError)
+	}
+
+	return reviews, total, nil
+}
+
+// FindAllVisibleByFieldID implements IReviewRepository.
+func (r *ReviewRepository) FindAllVisibleByFieldID(ctx context.Context, fieldID uint) ([]models.Review, error) {
+	var reviews []models.Review
+	err := r.db.WithContext(ctx).
+		Preload("Field").
+		Where("field_id = ? AND is_hidden = ?", fieldID, false).
+		Order("created_at desc").
+		Find(&reviews).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConst.ErrSQLError)
+	}
+
+	return reviews, nil
+}
+
+// FindByUUID implements IReviewRepository.
+func (r *ReviewRepository) FindByUUID(ctx context.Context, uuid string) (*models.Review, error) {
+	var review models.Review
+	err := r.db.WithContext(ctx).Preload("Field").Where("uuid = ?", uuid).First(&review).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errWrap.WrapError(errReview.ErrReviewNotFound)
+		}
+		return nil, errWrap.WrapError(errConst.ErrSQLError)
+	}
+
+	return &review, nil
+}
+
+// FindByOrderIDAndFieldID implements IReviewRepository.
+// Mengembalikan nil jika order tersebut belum pernah mereview lapangan ini.
+func (r *ReviewRepository) FindByOrderIDAndFieldID(ctx context.Context, orderID string, fieldID uint) (*models.Review, error) {
+	var review models.Review
+	err := r.db.WithContext(ctx).Where("order_id = ? AND field_id = ?", orderID, fieldID).First(&review).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, errWrap.WrapError(errConst.ErrSQLError)
+	}
+
+	return &review, nil
+}
+
+// FindRatingSummaryByFieldIDs implements IReviewRepository.
+// Menghitung rata-rata rating dan jumlah review yang tidak disembunyikan untuk setiap lapangan.
+func (r *ReviewRepository) FindRatingSummaryByFieldIDs(ctx context.Context, fieldIDs []uint) (map[uint]dto.RatingSummary, error) {
+	summaries := make(map[uint]dto.RatingSummary, len(fieldIDs))
+	if len(fieldIDs) == 0 {
+		return summaries, nil
+	}
+
+	var results []dto.RatingSummary
+	err := r.db.WithContext(ctx).
+		Model(&models.Review{}).
+		Select("field_id, AVG(rating) AS rating, COUNT(*) AS review_count").
+		Where("field_id IN ? AND is_hidden = ?", fieldIDs, false).
+		Group("field_id").
+		Scan(&results).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConst.ErrSQLError)
+	}
+
+	for _, result := range results {
+		summaries[result.FieldID] = result
+	}
+
+	return summaries, nil
+}
+
+// UpdateVisibility implements IReviewRepository.
+func (r *ReviewRepository) UpdateVisibility(ctx context.Context, uuid string, isHidden bool, reason *string) (*models.Review, error) {
+	review, err := r.FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	review.IsHidden = isHidden
+	review.HiddenReason = reason
+	if !isHidden {
+		review.HiddenReason = nil
+	}
+
+	err = r.db.WithContext(ctx).Omit("Field").Save(review).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConst.ErrSQLError)
+	}
+
+	return review, nil
+}
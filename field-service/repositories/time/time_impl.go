@@ -3,7 +3,6 @@ package repositories
 import (
 	"context"
 	"errors"
-	"fmt"
 
 	errWrap "github.com/anddriii/kita-futsal/field-service/common/error"
 	errConst "github.com/anddriii/kita-futsal/field-service/constants/error"
@@ -19,7 +18,6 @@ type TimeRepository struct {
 // Create implements ITimeRepository.
 func (t *TimeRepository) Create(ctx context.Context, time *models.Time) (*models.Time, error) {
 	time.UUID = uuid.New()
-	fmt.Println("time", time)
 	err := t.db.WithContext(ctx).Create(time).Error
 	if err != nil {
 		return nil, errWrap.WrapError(errConst.ErrSQLError)
@@ -0,0 +1,18 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/anddriii/kita-futsal/field-service/domains/dto"
+	"github.com/anddriii/kita-futsal/field-service/domains/models"
+)
+
+type IVenueRepository interface {
+	FindAllWithPagination(ctx context.Context, param *dto.VenueRequestParam) ([]models.Venue, int64, error)
+	FindAllWithoutPagination(ctx context.Context) ([]models.Venue, error)
+	FindByUUID(ctx context.Context, uuid string) (*models.Venue, error)
+	FindByManagerID(ctx context.Context, managerID string) ([]models.Venue, error)
+	Create(ctx context.Context, req *models.Venue) (*models.Venue, error)
+	Update(ctx context.Context, uuid string, req *models.Venue) (*models.Venue, error)
+	Delete(ctx context.Context, uuid string) error
+}
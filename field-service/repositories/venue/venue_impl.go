@@ -0,0 +1,161 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	errWrap "github.com/anddriii/kita-futsal/field-service/common/error"
+	"github.com/anddriii/kita-futsal/field-service/common/query"
+	errConst "github.com/anddriii/kita-futsal/field-service/constants/error"
+	errVenue "github.com/anddriii/kita-futsal/field-service/constants/error/venue"
+	"github.com/anddriii/kita-futsal/field-service/domains/dto"
+	"github.com/anddriii/kita-futsal/field-service/domains/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type VenueRepository struct {
+	db *gorm.DB
+}
+
+func NewVenueRepository(db *gorm.DB) IVenueRepository {
+	return &VenueRepository{db: db}
+}
+
+// Create implements IVenueRepository.
+func (v *VenueRepository) Create(ctx context.Context, req *models.Venue) (*models.Venue, error) {
+	venue := models.Venue{
+		UUID:        uuid.New(),
+		Name:        req.Name,
+		Address:     req.Address,
+		City:        req.City,
+		Latitude:    req.Latitude,
+		Longitude:   req.Longitude,
+		PhoneNumber: req.PhoneNumber,
+		Email:       req.Email,
+		ManagerID:   req.ManagerID,
+	}
+
+	err := v.db.WithContext(ctx).Create(&venue).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConst.ErrSQLError)
+	}
+
+	return &venue, nil
+}
+
+// Delete implements IVenueRepository.
+func (v *VenueRepository) Delete(ctx context.Context, uuid string) error {
+	err := v.db.WithContext(ctx).Where("uuid = ?", uuid).Delete(&models.Venue{}).Error
+	if err != nil {
+		return errWrap.WrapError(errConst.ErrSQLError)
+	}
+
+	return nil
+}
+
+// venueQuerySpec berisi kolom yang boleh dipakai untuk sorting daftar venue.
+var venueQuerySpec = query.Spec{
+	SortColumns: map[string]string{
+		"name":      "name",
+		"city":      "city",
+		"createdAt": "created_at",
+		"updatedAt": "updated_at",
+	},
+	DefaultSort: clause.OrderByColumn{Column: clause.Column{Name: "created_at"}, Desc: true},
+}
+
+// FindAllWithPagination implements IVenueRepository.
+func (v *VenueRepository) FindAllWithPagination(ctx context.Context, param *dto.VenueRequestParam) ([]models.Venue, int64, error) {
+	var (
+		venues []models.Venue
+		total  int64
+	)
+
+	sort, err := venueQuerySpec.OrderBy(param.SortColumn, param.SortOrder)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	limit := param.Limit
+	offset := (param.Page - 1) * limit
+
+	err = v.db.WithContext(ctx).
+		Order(sort).
+		Limit(limit).
+		Offset(offset).
+		Find(&venues).Error
+	if err != nil {
+		return nil, 0, errWrap.WrapError(errConst.ErrSQLError)
+	}
+
+	err = v.db.WithContext(ctx).
+		Model(&models.Venue{}).
+		Count(&total).Error
+	if err != nil {
+		return nil, 0, errWrap.WrapError(errConst.ErrSQLError)
+	}
+
+	return venues, total, nil
+}
+
+// FindAllWithoutPagination implements IVenueRepository.
+func (v *VenueRepository) FindAllWithoutPagination(ctx context.Context) ([]models.Venue, error) {
+	var venues []models.Venue
+	err := v.db.WithContext(ctx).Order("name asc").Find(&venues).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConst.ErrSQLError)
+	}
+
+	return venues, nil
+}
+
+// FindByUUID implements IVenueRepository.
+func (v *VenueRepository) FindByUUID(ctx context.Context, uuid string) (*models.Venue, error) {
+	var venue models.Venue
+	err := v.db.WithContext(ctx).Preload("Fields").Where("uuid = ?", uuid).First(&venue).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errWrap.WrapError(errVenue.ErrVenueNotFound)
+		}
+		return nil, errWrap.WrapError(errConst.ErrSQLError)
+	}
+
+	return &venue, nil
+}
+
+// FindByManagerID implements IVenueRepository.
+func (v *VenueRepository) FindByManagerID(ctx context.Context, managerID string) ([]models.Venue, error) {
+	var venues []models.Venue
+	err := v.db.WithContext(ctx).Where("manager_id = ?", managerID).Find(&venues).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConst.ErrSQLError)
+	}
+
+	return venues, nil
+}
+
+// Update implements IVenueRepository.
+func (v *VenueRepository) Update(ctx context.Context, uuid string, req *models.Venue) (*models.Venue, error) {
+	venue, err := v.FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	venue.Name = req.Name
+	venue.Address = req.Address
+	venue.City = req.City
+	venue.Latitude = req.Latitude
+	venue.Longitude = req.Longitude
+	venue.PhoneNumber = req.PhoneNumber
+	venue.Email = req.Email
+	venue.ManagerID = req.ManagerID
+
+	err = v.db.WithContext(ctx).Omit("Fields").Save(venue).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConst.ErrSQLError)
+	}
+
+	return venue, nil
+}
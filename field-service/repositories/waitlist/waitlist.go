@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/anddriii/kita-futsal/field-service/domains/models"
+)
+
+type IWaitlistRepository interface {
+	FindByUUID(ctx context.Context, uuid string) (*models.Waitlist, error)
+	FindByUserID(ctx context.Context, userID string) ([]models.Waitlist, error)
+	FindActiveByScheduleID(ctx context.Context, scheduleID uint) ([]models.Waitlist, error)
+	FindActiveByScheduleIDAndUserID(ctx context.Context, scheduleID uint, userID string) (*models.Waitlist, error)
+	FindFirstWaiting(ctx context.Context, scheduleID uint) (*models.Waitlist, error)
+	FindActiveClaim(ctx context.Context, scheduleID uint) (*models.Waitlist, error)
+	FindExpiredClaims(ctx context.Context) ([]models.Waitlist, error)
+	CountAhead(ctx context.Context, waitlist *models.Waitlist) (int64, error)
+	Create(ctx context.Context, req *models.Waitlist) (*models.Waitlist, error)
+	Update(ctx context.Context, req *models.Waitlist) error
+}
@@ -0,0 +1,191 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	errWrap "github.com/anddriii/kita-futsal/field-service/common/error"
+	"github.com/anddriii/kita-futsal/field-service/constants"
+	errConst "github.com/anddriii/kita-futsal/field-service/constants/error"
+	errWaitlist "github.com/anddriii/kita-futsal/field-service/constants/error/waitlist"
+	"github.com/anddriii/kita-futsal/field-service/domains/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type WaitlistRepository struct {
+	db *gorm.DB
+}
+
+func NewWaitlistRepository(db *gorm.DB) IWaitlistRepository {
+	return &WaitlistRepository{db: db}
+}
+
+// activeStatuses adalah status waitlist yang masih berada di dalam antrean.
+var activeStatuses = []constants.WaitlistStatus{constants.Waiting, constants.Notified}
+
+// Create implements IWaitlistRepository.
+func (w *WaitlistRepository) Create(ctx context.Context, req *models.Waitlist) (*models.Waitlist, error) {
+	waitlist := models.Waitlist{
+		UUID:            uuid.New(),
+		FieldScheduleID: req.FieldScheduleID,
+		UserID:          req.UserID,
+		UserName:        req.UserName,
+		Email:           req.Email,
+		PhoneNumber:     req.PhoneNumber,
+		Status:          constants.Waiting,
+	}
+
+	err := w.db.WithContext(ctx).Create(&waitlist).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConst.ErrSQLError)
+	}
+
+	return &waitlist, nil
+}
+
+// Update implements IWaitlistRepository.
+func (w *WaitlistRepository) Update(ctx context.Context, req *models.Waitlist) error {
+	err := w.db.WithContext(ctx).Omit("FieldSchedule").Save(req).Error
+	if err != nil {
+		return errWrap.WrapError(errConst.ErrSQLError)
+	}
+
+	return nil
+}
+
+// FindByUUID implements IWaitlistRepository.
+func (w *WaitlistRepository) FindByUUID(ctx context.Context, uuid string) (*models.Waitlist, error) {
+	var waitlist models.Waitlist
+	err := w.db.WithContext(ctx).
+		Preload("FieldSchedule.Field.Venue").
+		Preload("FieldSchedule.Time").
+		Where("uuid = ?", uuid).
+		First(&waitlist).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errWrap.WrapError(errWaitlist.ErrWaitlistNotFound)
+		}
+		return nil, errWrap.WrapError(errConst.ErrSQLError)
+	}
+
+	return &waitlist, nil
+}
+
+// FindByUserID implements IWaitlistRepository.
+func (w *WaitlistRepository) FindByUserID(ctx context.Context, userID string) ([]models.Waitlist, error) {
+	var waitlists []models.Waitlist
+	err := w.db.WithContext(ctx).
+		Preload("FieldSchedule.Field").
+		Preload("FieldSchedule.Time").
+		Where("user_id = ?", userID).
+		Order("created_at desc").
+		Find(&waitlists).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConst.ErrSQLError)
+	}
+
+	return waitlists, nil
+}
+
+// FindActiveByScheduleID implements IWaitlistRepository.
+// Mengembalikan antrean sebuah jadwal sesuai urutan pendaftaran.
+func (w *WaitlistRepository) FindActiveByScheduleID(ctx context.Context, scheduleID uint) ([]models.Waitlist, error) {
+	var waitlists []models.Waitlist
+	err := w.db.WithContext(ctx).
+		Preload("FieldSchedule.Field").
+		Preload("FieldSchedule.Time").
+		Where("field_schedule_id = ? AND status IN ?", scheduleID, activeStatuses).
+		Order("created_at asc, id asc").
+		Find(&waitlists).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConst.ErrSQLError)
+	}
+
+	return waitlists, nil
+}
+
+// FindActiveByScheduleIDAndUserID implements IWaitlistRepository.
+// Mengembalikan nil jika user belum berada di antrean jadwal tersebut.
+func (w *WaitlistRepository) FindActiveByScheduleIDAndUserID(ctx context.Context, scheduleID uint, userID string) (*models.Waitlist, error) {
+	var waitlist models.Waitlist
+	err := w.db.WithContext(ctx).
+		Where("field_schedule_id = ? AND user_id = ? AND status IN ?", scheduleID, userID, activeStatuses).
+		First(&waitlist).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, errWrap.WrapError(errConst.ErrSQLError)
+	}
+
+	return &waitlist, nil
+}
+
+// FindFirstWaiting implements IWaitlistRepository.
+// Mengembalikan nil jika tidak ada lagi user yang menunggu.
+func (w *WaitlistRepository) FindFirstWaiting(ctx context.Context, scheduleID uint) (*models.Waitlist, error) {
+	var waitlist models.Waitlist
+	err := w.db.WithContext(ctx).
+		Preload("FieldSchedule.Field").
+		Preload("FieldSchedule.Time").
+		Where("field_schedule_id = ? AND status = ?", scheduleID, constants.Waiting).
+		Order("created_at asc, id asc").
+		First(&waitlist).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, errWrap.WrapError(errConst.ErrSQLError)
+	}
+
+	return &waitlist, nil
+}
+
+// FindActiveClaim implements IWaitlistRepository.
+// Mengembalikan user yang sedang memegang hak pesan (claim) yang belum kedaluwarsa, atau nil.
+func (w *WaitlistRepository) FindActiveClaim(ctx context.Context, scheduleID uint) (*models.Waitlist, error) {
+	var waitlist models.Waitlist
+	err := w.db.WithContext(ctx).
+		Where("field_schedule_id = ? AND status = ? AND claim_expires_at > ?", scheduleID, constants.Notified, time.Now()).
+		First(&waitlist).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, errWrap.WrapError(errConst.ErrSQLError)
+	}
+
+	return &waitlist, nil
+}
+
+// FindExpiredClaims implements IWaitlistRepository.
+func (w *WaitlistRepository) FindExpiredClaims(ctx context.Context) ([]models.Waitlist, error) {
+	var waitlists []models.Waitlist
+	err := w.db.WithContext(ctx).
+		Preload("FieldSchedule").
+		Where("status = ? AND claim_expires_at <= ?", constants.Notified, time.Now()).
+		Find(&waitlists).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConst.ErrSQLError)
+	}
+
+	return waitlists, nil
+}
+
+// CountAhead implements IWaitlistRepository.
+// Menghitung jumlah user aktif yang mendaftar lebih dulu pada jadwal yang sama.
+func (w *WaitlistRepository) CountAhead(ctx context.Context, waitlist *models.Waitlist) (int64, error) {
+	var total int64
+	err := w.db.WithContext(ctx).
+		Model(&models.Waitlist{}).
+		Where("field_schedule_id = ? AND status IN ?", waitlist.FieldScheduleID, activeStatuses).
+		Where("created_at < ? OR (created_at = ? AND id < ?)", waitlist.CreatedAt, waitlist.CreatedAt, waitlist.ID).
+		Count(&total).Error
+	if err != nil {
+		return 0, errWrap.WrapError(errConst.ErrSQLError)
+	}
+
+	return total, nil
+}
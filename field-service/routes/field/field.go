@@ -40,27 +40,38 @@ func (f *FieldRoute) Run() {
 
 	//endpoint must login
 
-	// Mengambil semua field dengan pagination, hanya bisa diakses oleh Admin & User
+	// Mengambil semua field dengan pagination, hanya bisa diakses oleh Admin, User & Venue Manager.
+	// Admin bisa menampilkan field yang sudah dihapus dengan query deleted=true
 	group.GET("/pagination", middlewares.CheckRole([]string{
 		constants.Admin,
 		constants.User,
+		constants.VenueManager,
 	}, f.client),
 		f.controller.GetField().GetAllWithPagination)
 
-	// Membuat field baru, hanya bisa diakses oleh Admin
+	// Membuat field baru, hanya bisa diakses oleh Admin & Venue Manager (untuk venue miliknya)
 	group.POST("", middlewares.CheckRole([]string{
 		constants.Admin,
+		constants.VenueManager,
 	}, f.client), f.controller.GetField().Create)
 
-	// Memperbarui field berdasarkan UUID, hanya bisa diakses oleh Admin
+	// Memperbarui field berdasarkan UUID, hanya bisa diakses oleh Admin & Venue Manager (untuk venue miliknya)
 	group.PUT("/:uuid", middlewares.CheckRole([]string{
 		constants.Admin,
+		constants.VenueManager,
 	}, f.client),
 		f.controller.GetField().Update)
 
-	// menghapus field beradasarkan UUID, hanya bisa diakses oleh admin
+	// menghapus field beradasarkan UUID, hanya bisa diakses oleh admin & venue manager (untuk venue miliknya)
 	group.DELETE("/:uuid", middlewares.CheckRole([]string{
 		constants.Admin,
+		constants.VenueManager,
 	}, f.client),
 		f.controller.GetField().Delete)
+
+	// memulihkan field yang sudah dihapus beserta jadwalnya, hanya bisa diakses oleh admin
+	group.PATCH("/:uuid/restore", middlewares.CheckRole([]string{
+		constants.Admin,
+	}, f.client),
+		f.controller.GetField().Restore)
 }
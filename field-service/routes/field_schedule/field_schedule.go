@@ -35,6 +35,10 @@ func (f FieldScheduleRoute) Run() {
 	group.GET("/lists/:uuid", middlewares.AuthenticateWithoutToken(),
 		f.controller.GetFieldSchedule().GetAllByFieldIdAndDate)
 
+	// Stream schedule status changes of a field and date over SSE (no authentication token required)
+	group.GET("/lists/:uuid/stream", middlewares.AuthenticateWithoutToken(),
+		f.controller.GetFieldSchedule().StreamByFieldIdAndDate)
+
 	// Update schedule status (no authentication token required)
 	group.PATCH("/status", middlewares.AuthenticateWithoutToken(),
 		f.controller.GetFieldSchedule().UpdateStatus)
@@ -42,35 +46,47 @@ func (f FieldScheduleRoute) Run() {
 	// Apply authentication middleware for routes below
 	group.Use(middlewares.Authenticate())
 
-	// Get paginated schedule list (accessible by Admin & User roles)
+	// Get paginated schedule list (accessible by Admin, User & Venue Manager roles).
+	// Admins can list deleted schedules with deleted=true
 	group.GET("/pagination", middlewares.CheckRole([]string{
 		constants.Admin,
 		constants.User,
+		constants.VenueManager,
 	}, f.client), f.controller.GetFieldSchedule().GetAllWithPagination)
 
-	// Get schedule details by UUID (accessible by Admin & User roles)
+	// Get schedule details by UUID (accessible by Admin, User & Venue Manager roles)
 	group.GET("/:uuid", middlewares.CheckRole([]string{
 		constants.Admin,
 		constants.User,
+		constants.VenueManager,
 	}, f.client), f.controller.GetFieldSchedule().GetByUUID)
 
-	// Create a new schedule (only Admin can access)
+	// Create a new schedule (Admin, or Venue Manager for their own venue)
 	group.POST("", middlewares.CheckRole([]string{
 		constants.Admin,
+		constants.VenueManager,
 	}, f.client), f.controller.GetFieldSchedule().Create)
 
-	// Generate schedule for one month (only Admin can access)
+	// Generate schedule for one month (Admin, or Venue Manager for their own venue)
 	group.POST("/one-month", middlewares.CheckRole([]string{
 		constants.Admin,
+		constants.VenueManager,
 	}, f.client), f.controller.GetFieldSchedule().GenerateScheduleForOneMonth)
 
-	// Update an existing schedule by UUID (only Admin can access)
+	// Update an existing schedule by UUID (Admin, or Venue Manager for their own venue)
 	group.PUT("/:uuid", middlewares.CheckRole([]string{
 		constants.Admin,
+		constants.VenueManager,
 	}, f.client), f.controller.GetFieldSchedule().Update)
 
-	// Delete a schedule by UUID (only Admin can access)
+	// Delete a schedule by UUID (Admin, or Venue Manager for their own venue)
 	group.DELETE("/:uuid", middlewares.CheckRole([]string{
 		constants.Admin,
+		constants.VenueManager,
 	}, f.client), f.controller.GetFieldSchedule().Delete)
+
+	// Restore a deleted schedule by UUID (Admin only)
+	group.PATCH("/:uuid/restore", middlewares.CheckRole([]string{
+		constants.Admin,
+	}, f.client), f.controller.GetFieldSchedule().Restore)
 }
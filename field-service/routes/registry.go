@@ -7,7 +7,11 @@ import (
 
 	fieldRoute "github.com/anddriii/kita-futsal/field-service/routes/field"
 	fieldScheduleRoute "github.com/anddriii/kita-futsal/field-service/routes/field_schedule"
+	reportRoute "github.com/anddriii/kita-futsal/field-service/routes/report"
+	reviewRoute "github.com/anddriii/kita-futsal/field-service/routes/review"
 	timeRoute "github.com/anddriii/kita-futsal/field-service/routes/time"
+	venueRoute "github.com/anddriii/kita-futsal/field-service/routes/venue"
+	waitlistRoute "github.com/anddriii/kita-futsal/field-service/routes/waitlist"
 )
 
 type Registry struct {
@@ -40,8 +44,28 @@ func (r *Registry) timeRoute() timeRoute.ITimeRoute {
 	return timeRoute.NewRouteTime(r.controller, r.group, r.client)
 }
 
+func (r *Registry) venueRoute() venueRoute.IVenueRoute {
+	return venueRoute.NewVenueRoute(r.controller, r.group, r.client)
+}
+
+func (r *Registry) reviewRoute() reviewRoute.IReviewRoute {
+	return reviewRoute.NewReviewRoute(r.controller, r.group, r.client)
+}
+
+func (r *Registry) waitlistRoute() waitlistRoute.IWaitlistRoute {
+	return waitlistRoute.NewWaitlistRoute(r.controller, r.group, r.client)
+}
+
+func (r *Registry) reportRoute() reportRoute.IReportRoute {
+	return reportRoute.NewReportRoute(r.controller, r.group, r.client)
+}
+
 func (r *Registry) Serve() {
 	r.fieldRoute().Run()
 	r.fieldScheduleRoute().Run()
 	r.timeRoute().Run()
+	r.venueRoute().Run()
+	r.reviewRoute().Run()
+	r.waitlistRoute().Run()
+	r.reportRoute().Run()
 }
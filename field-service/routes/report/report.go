@@ -0,0 +1,44 @@
+package routes
+
+import (
+	"github.com/anddriii/kita-futsal/field-service/clients"
+	"github.com/anddriii/kita-futsal/field-service/constants"
+	"github.com/anddriii/kita-futsal/field-service/controllers"
+	"github.com/anddriii/kita-futsal/field-service/middlewares"
+	"github.com/gin-gonic/gin"
+)
+
+type ReportRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+	client     clients.IClientRegistry
+}
+
+type IReportRoute interface {
+	Run()
+}
+
+func NewReportRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup, client clients.IClientRegistry) IReportRoute {
+	return &ReportRoute{
+		controller: controller,
+		group:      group,
+		client:     client,
+	}
+}
+
+func (r *ReportRoute) Run() {
+	group := r.group.Group("/report")
+
+	//Middleware autentikasi diterapkan ke seluruh route laporan
+	group.Use(middlewares.Authenticate())
+
+	// Tingkat okupansi per lapangan (jadwal Booked dibanding seluruh jadwal), hanya untuk Admin
+	group.GET("/occupancy", middlewares.CheckRole([]string{
+		constants.Admin,
+	}, r.client), r.controller.GetReport().GetOccupancy)
+
+	// Slot waktu yang paling banyak dipesan, hanya untuk Admin
+	group.GET("/peak-hours", middlewares.CheckRole([]string{
+		constants.Admin,
+	}, r.client), r.controller.GetReport().GetPeakHours)
+}
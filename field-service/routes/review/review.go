@@ -0,0 +1,57 @@
+package routes
+
+import (
+	"github.com/anddriii/kita-futsal/field-service/clients"
+	"github.com/anddriii/kita-futsal/field-service/constants"
+	"github.com/anddriii/kita-futsal/field-service/controllers"
+	"github.com/anddriii/kita-futsal/field-service/middlewares"
+	"github.com/gin-gonic/gin"
+)
+
+type ReviewRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+	client     clients.IClientRegistry
+}
+
+type IReviewRoute interface {
+	Run()
+}
+
+func NewReviewRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup, client clients.IClientRegistry) IReviewRoute {
+	return &ReviewRoute{
+		controller: controller,
+		group:      group,
+		client:     client,
+	}
+}
+
+func (r *ReviewRoute) Run() {
+	group := r.group.Group("/review")
+
+	//endpoint without login, hanya menampilkan review yang tidak disembunyikan
+	group.GET("/field/:uuid", middlewares.AuthenticateWithoutToken(), r.controller.GetReview().GetByFieldID)
+
+	//Middleware autentikasi diterapkan ke seluruh route berikutnya
+	group.Use(middlewares.Authenticate())
+
+	// Membuat review untuk lapangan dari order yang sudah selesai dimainkan, hanya untuk Customer
+	group.POST("", middlewares.CheckRole([]string{
+		constants.User,
+	}, r.client), r.controller.GetReview().Create)
+
+	// Mengambil semua review termasuk yang disembunyikan untuk moderasi, hanya bisa diakses oleh Admin
+	group.GET("/pagination", middlewares.CheckRole([]string{
+		constants.Admin,
+	}, r.client), r.controller.GetReview().GetAllWithPagination)
+
+	// Menyembunyikan atau menampilkan kembali review, hanya bisa diakses oleh Admin
+	group.PATCH("/:uuid/moderate", middlewares.CheckRole([]string{
+		constants.Admin,
+	}, r.client), r.controller.GetReview().Moderate)
+
+	// Menghapus review, hanya bisa diakses oleh Admin
+	group.DELETE("/:uuid", middlewares.CheckRole([]string{
+		constants.Admin,
+	}, r.client), r.controller.GetReview().Delete)
+}
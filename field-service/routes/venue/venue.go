@@ -0,0 +1,67 @@
+package routes
+
+import (
+	"github.com/anddriii/kita-futsal/field-service/clients"
+	"github.com/anddriii/kita-futsal/field-service/constants"
+	"github.com/anddriii/kita-futsal/field-service/controllers"
+	"github.com/anddriii/kita-futsal/field-service/middlewares"
+	"github.com/gin-gonic/gin"
+)
+
+type VenueRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+	client     clients.IClientRegistry
+}
+
+type IVenueRoute interface {
+	Run()
+}
+
+func NewVenueRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup, client clients.IClientRegistry) IVenueRoute {
+	return &VenueRoute{
+		controller: controller,
+		group:      group,
+		client:     client,
+	}
+}
+
+func (v *VenueRoute) Run() {
+	group := v.group.Group("/venue")
+
+	//endpoint without login
+	group.GET("", middlewares.AuthenticateWithoutToken(), v.controller.GetVenue().GetAllWithoutPagination)
+	group.GET("/:uuid", middlewares.AuthenticateWithoutToken(), v.controller.GetVenue().GetByUUID)
+
+	// daftar venue milik seorang manager, dipakai oleh service lain (order-service)
+	group.GET("/manager/:uuid", middlewares.AuthenticateWithoutToken(), v.controller.GetVenue().GetByManagerID)
+
+	//Middleware autentikasi diterapkan ke seluruh route berikutnya
+	group.Use(middlewares.Authenticate())
+
+	// Mengambil semua venue dengan pagination, hanya bisa diakses oleh Admin
+	group.GET("/pagination", middlewares.CheckRole([]string{
+		constants.Admin,
+	}, v.client), v.controller.GetVenue().GetAllWithPagination)
+
+	// Mengambil venue yang dikelola oleh venue manager yang sedang login
+	group.GET("/mine", middlewares.CheckRole([]string{
+		constants.VenueManager,
+	}, v.client), v.controller.GetVenue().GetMyVenues)
+
+	// Membuat venue baru, hanya bisa diakses oleh Admin
+	group.POST("", middlewares.CheckRole([]string{
+		constants.Admin,
+	}, v.client), v.controller.GetVenue().Create)
+
+	// Memperbarui venue, Admin atau venue manager pemilik venue
+	group.PUT("/:uuid", middlewares.CheckRole([]string{
+		constants.Admin,
+		constants.VenueManager,
+	}, v.client), v.controller.GetVenue().Update)
+
+	// Menghapus venue, hanya bisa diakses oleh Admin
+	group.DELETE("/:uuid", middlewares.CheckRole([]string{
+		constants.Admin,
+	}, v.client), v.controller.GetVenue().Delete)
+}
@@ -0,0 +1,55 @@
+package routes
+
+import (
+	"github.com/anddriii/kita-futsal/field-service/clients"
+	"github.com/anddriii/kita-futsal/field-service/constants"
+	"github.com/anddriii/kita-futsal/field-service/controllers"
+	"github.com/anddriii/kita-futsal/field-service/middlewares"
+	"github.com/gin-gonic/gin"
+)
+
+type WaitlistRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+	client     clients.IClientRegistry
+}
+
+type IWaitlistRoute interface {
+	Run()
+}
+
+func NewWaitlistRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup, client clients.IClientRegistry) IWaitlistRoute {
+	return &WaitlistRoute{
+		controller: controller,
+		group:      group,
+		client:     client,
+	}
+}
+
+func (w *WaitlistRoute) Run() {
+	group := w.group.Group("/waitlist")
+
+	//Middleware autentikasi diterapkan ke seluruh route waitlist
+	group.Use(middlewares.Authenticate())
+
+	// Masuk ke waitlist jadwal yang sudah dipesan, hanya untuk Customer
+	group.POST("", middlewares.CheckRole([]string{
+		constants.User,
+	}, w.client), w.controller.GetWaitlist().Join)
+
+	// Mengambil daftar waitlist milik user yang sedang login beserta posisi dan claim-nya
+	group.GET("/mine", middlewares.CheckRole([]string{
+		constants.User,
+	}, w.client), w.controller.GetWaitlist().GetMine)
+
+	// Melihat antrean sebuah jadwal (Admin, atau Venue Manager untuk venue miliknya)
+	group.GET("/schedule/:uuid", middlewares.CheckRole([]string{
+		constants.Admin,
+		constants.VenueManager,
+	}, w.client), w.controller.GetWaitlist().GetBySchedule)
+
+	// Keluar dari waitlist, hanya untuk Customer pemilik entri
+	group.DELETE("/:uuid", middlewares.CheckRole([]string{
+		constants.User,
+	}, w.client), w.controller.GetWaitlist().Leave)
+}
@@ -16,4 +16,5 @@ type IFieldService interface {
 	Create(ctx context.Context, req *dto.FieldRequest) (*dto.FieldResponse, error)
 	Update(ctx context.Context, uuid string, req *dto.UpdateFieldRequest) (*dto.FieldResponse, error)
 	Delete(ctx context.Context, uuid string) error
+	Restore(ctx context.Context, uuid string) error
 }
@@ -1,38 +1,39 @@
 package services
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
-	"fmt"
 	"io"
+	"math"
 	"mime/multipart"
 	"path"
 	"time"
 
+	"github.com/anddriii/kita-futsal/field-service/common/authz"
+	"github.com/anddriii/kita-futsal/field-service/common/cache"
 	"github.com/anddriii/kita-futsal/field-service/common/gcs"
+	"github.com/anddriii/kita-futsal/field-service/common/imaging"
+	"github.com/anddriii/kita-futsal/field-service/common/logger"
 	"github.com/anddriii/kita-futsal/field-service/common/util"
-	"github.com/anddriii/kita-futsal/field-service/constants"
 	errCons "github.com/anddriii/kita-futsal/field-service/constants/error"
+	errField "github.com/anddriii/kita-futsal/field-service/constants/error/field"
+	errVenue "github.com/anddriii/kita-futsal/field-service/constants/error/venue"
 	"github.com/anddriii/kita-futsal/field-service/domains/dto"
 	"github.com/anddriii/kita-futsal/field-service/domains/models"
 	"github.com/anddriii/kita-futsal/field-service/repositories"
-	"github.com/gofiber/fiber/v2/log"
 	"github.com/google/uuid"
-	"github.com/redis/go-redis/v9"
 )
 
 type FieldService struct {
 	repository repositories.IRepoRegistry
 	gcs        gcs.IGCSClient
-	redis      *redis.Client
+	cache      *cache.Cache
 }
 
-func NewFieldService(repository repositories.IRepoRegistry, gcs gcs.IGCSClient, redis *redis.Client) IFieldService {
+func NewFieldService(repository repositories.IRepoRegistry, gcs gcs.IGCSClient, cache *cache.Cache) IFieldService {
 	return &FieldService{
 		repository: repository,
 		gcs:        gcs,
-		redis:      redis,
+		cache:      cache,
 	}
 }
 
@@ -47,29 +48,34 @@ func (f *FieldService) GetNearbyFields(ctx context.Context, cordinate *dto.Nearb
 		return nil, err
 	}
 
+	summaries, err := f.ratingSummaries(ctx, allFields)
+	if err != nil {
+		return nil, err
+	}
+
 	var nearbyFields []dto.FieldResponse
 
 	for _, field := range allFields {
-		distance := util.CalculateHaversine(userLat, userLong, field.Latitude, field.Lonitude)
-		// fmt.Println("cordinate lapangan dari service", field.Latitude, field.Lonitude)
+		distance := util.CalculateHaversine(userLat, userLong, field.Latitude, field.Longitude)
+		// fmt.Println("cordinate lapangan dari service", field.Latitude, field.Longitude)
 
-		var photoRes []string
-		for _, fileName := range field.Image {
-			photoRes = append(photoRes, constants.BuildFullImagePath(fileName))
-		}
+		photoRes, photoVariants := util.BuildFieldImages(field.Image)
 
 		if distance <= 10.0 {
 			nearbyFields = append(nearbyFields, dto.FieldResponse{
-				UUID:         field.UUID,
-				Name:         field.Name,
-				Code:         field.Code,
-				PricePerHour: field.PricePerHour,
-				Latitude:     field.Latitude,
-				Lonitude:     field.Lonitude,
-				Images:       photoRes,
-				Distance:     distance,
-				CreatedAt:    field.CreatedAt,
-				UpdateAt:     field.UpdatedAt,
+				UUID:          field.UUID,
+				Name:          field.Name,
+				Code:          field.Code,
+				PricePerHour:  field.PricePerHour,
+				Latitude:      field.Latitude,
+				Lonitude:      field.Longitude,
+				Images:        photoRes,
+				ImageVariants: photoVariants,
+				Distance:      distance,
+				Rating:        summaries[field.ID].Rating,
+				ReviewCount:   summaries[field.ID].ReviewCount,
+				CreatedAt:     field.CreatedAt,
+				UpdateAt:      field.UpdatedAt,
 			})
 		}
 	}
@@ -77,67 +83,61 @@ func (f *FieldService) GetNearbyFields(ctx context.Context, cordinate *dto.Nearb
 	return nearbyFields, nil
 }
 
-// GetAllWithoutPaginationNoRedis implements [IFieldService].
+// GetAllWithoutPagination implements [IFieldService].
+// Hasilnya diambil dari cache dan dibatalkan setiap kali lapangan, venue, atau review berubah.
 func (f *FieldService) GetAllWithoutPagination(ctx context.Context) ([]dto.FieldResponse, error) {
-	cacheKey := "fields:all:nopagination"
-
-	// ambil data dari Redis
-	cachedData, err := f.redis.Get(ctx, cacheKey).Result()
-	fmt.Printf("cache data dari redis: %s\n", cachedData)
-
-	if err == nil {
-		// CACHE HIT: Data ada di Redis! Parse JSON ke Struct
-		var fieldResults []dto.FieldResponse
-		errUnmarshal := json.Unmarshal([]byte(cachedData), &fieldResults)
-		if errUnmarshal == nil {
-			return fieldResults, nil // Langsung balikin, gak usah ke DB
-		}
-		// Kalo unmarshal gagal, lanjut narik dari DB
-	} else if err != redis.Nil {
-		// Ada error koneksi Redis dll, kita log aja, tapi aplikasi tetep jalan ngambil dari DB
-		log.Errorf("Redis error: %v", err)
+	return cache.Get(ctx, f.cache, cache.Fields, cache.Key("all"), f.GetAllWithoutPaginationNoRedis)
+}
+
+func (f *FieldService) GetAllWithPagination(ctx context.Context, param *dto.FieldRequestParam) (*util.PaginationResult, error) {
+	// daftar lapangan terhapus hanya untuk admin
+	if param.Deleted && !authz.IsAdmin(ctx) {
+		return nil, errCons.ErrForbidden
 	}
 
-	// CACHE MISS: Data gak ada di Redis, ambil dari DB pake fungsi yang lama
-	fieldResults, err := f.GetAllWithoutPaginationNoRedis(ctx)
+	venueIDs, err := authz.ResolveVenueFilter(ctx, f.repository.GetVenue(), param.VenueID)
 	if err != nil {
 		return nil, err
 	}
+	param.VenueIDs = venueIDs
 
-	// 2. Simpan hasil dari DB ke Redis untuk request selanjutnya
-	dataBytes, errMarshal := json.Marshal(fieldResults)
-	if errMarshal == nil {
-		// Set TTL (Time To Live). Misalnya 5 Menit.
-		// Artinya data bakal nge-refresh dari DB setiap 5 menit.
-		f.redis.Set(ctx, cacheKey, dataBytes, 5*time.Minute)
-	}
-
-	return fieldResults, nil
+	// filter venue milik user sudah masuk ke param, jadi key per query aman dipakai bersama
+	return cache.Get(ctx, f.cache, cache.Fields, cache.QueryKey("page", param), func(ctx context.Context) (*util.PaginationResult, error) {
+		return f.getAllWithPagination(ctx, param)
+	})
 }
 
-func (f *FieldService) GetAllWithPagination(ctx context.Context, param *dto.FieldRequestParam) (*util.PaginationResult, error) {
+func (f *FieldService) getAllWithPagination(ctx context.Context, param *dto.FieldRequestParam) (*util.PaginationResult, error) {
 	fields, total, err := f.repository.GetField().FindALlWithPagination(ctx, param)
 	if err != nil {
 		return nil, err
 	}
 
+	summaries, err := f.ratingSummaries(ctx, fields)
+	if err != nil {
+		return nil, err
+	}
+
 	// Mengonversi data field ke format FieldResponse
 	fieldResults := make([]dto.FieldResponse, 0, len(fields))
 	for _, field := range fields {
-		var photoRes []string
-
-		for _, fileName := range field.Image {
-			photoRes = append(photoRes, constants.BuildFullImagePath(fileName))
-		}
+		photoRes, photoVariants := util.BuildFieldImages(field.Image)
 
+		venueUUID, venueName := f.venueInfo(&field)
 		fieldResults = append(fieldResults, dto.FieldResponse{
-			UUID:         field.UUID,
-			Code:         field.Code,
-			Name:         field.Name,
-			PricePerHour: field.PricePerHour,
-			Images:       photoRes,
-			CreatedAt:    field.CreatedAt,
-			UpdateAt:     field.UpdatedAt,
+			UUID:          field.UUID,
+			Code:          field.Code,
+			Name:          field.Name,
+			PricePerHour:  field.PricePerHour,
+			Images:        photoRes,
+			ImageVariants: photoVariants,
+			VenueUUID:     venueUUID,
+			VenueName:     venueName,
+			Rating:        summaries[field.ID].Rating,
+			ReviewCount:   summaries[field.ID].ReviewCount,
+			CreatedAt:     field.CreatedAt,
+			UpdateAt:      field.UpdatedAt,
+			DeletedAt:     util.DeletedAt(field.DeletedAt),
 		})
 	}
 
@@ -158,24 +158,31 @@ func (f *FieldService) GetAllWithoutPaginationNoRedis(ctx context.Context) ([]dt
 		return nil, err
 	}
 
+	summaries, err := f.ratingSummaries(ctx, fields)
+	if err != nil {
+		return nil, err
+	}
+
 	fieldResults := make([]dto.FieldResponse, 0, len(fields))
 	for _, field := range fields {
-		var photoRes []string
-
-		for _, fileName := range field.Image {
-			photoRes = append(photoRes, constants.BuildFullImagePath(fileName))
-		}
+		photoRes, photoVariants := util.BuildFieldImages(field.Image)
 
+		venueUUID, venueName := f.venueInfo(&field)
 		fieldResults = append(fieldResults, dto.FieldResponse{
-			Code:         field.Code,
-			UUID:         field.UUID,
-			Name:         field.Name,
-			Latitude:     field.Latitude,
-			Lonitude:     field.Lonitude,
-			PricePerHour: field.PricePerHour,
-			Images:       photoRes,
-			CreatedAt:    field.CreatedAt,
-			UpdateAt:     field.UpdatedAt,
+			Code:          field.Code,
+			UUID:          field.UUID,
+			Name:          field.Name,
+			Latitude:      field.Latitude,
+			Lonitude:      field.Longitude,
+			PricePerHour:  field.PricePerHour,
+			Images:        photoRes,
+			ImageVariants: photoVariants,
+			VenueUUID:     venueUUID,
+			VenueName:     venueName,
+			Rating:        summaries[field.ID].Rating,
+			ReviewCount:   summaries[field.ID].ReviewCount,
+			CreatedAt:     field.CreatedAt,
+			UpdateAt:      field.UpdatedAt,
 		})
 	}
 
@@ -183,39 +190,105 @@ func (f *FieldService) GetAllWithoutPaginationNoRedis(ctx context.Context) ([]dt
 }
 
 func (f *FieldService) GetByUUID(ctx context.Context, uuid string) (*dto.FieldResponse, error) {
+	return cache.Get(ctx, f.cache, cache.Fields, cache.Key("uuid", uuid), func(ctx context.Context) (*dto.FieldResponse, error) {
+		return f.getByUUID(ctx, uuid)
+	})
+}
+
+func (f *FieldService) getByUUID(ctx context.Context, uuid string) (*dto.FieldResponse, error) {
 	field, err := f.repository.GetField().FindByUUID(ctx, uuid)
 	if err != nil {
 		return nil, err
 	}
 
-	var photoRes []string
-	for _, fileName := range field.Image {
-		photoRes = append(photoRes, constants.BuildFullImagePath(fileName))
+	photoRes, photoVariants := util.BuildFieldImages(field.Image)
+
+	summaries, err := f.ratingSummaries(ctx, []models.Field{*field})
+	if err != nil {
+		return nil, err
 	}
 
 	pricePerHour := float64(field.PricePerHour)
+	venueUUID, venueName := f.venueInfo(field)
 	fieldResult := dto.FieldResponse{
-		UUID:         field.UUID,
-		Code:         field.Code,
-		Name:         field.Name,
-		PricePerHour: util.RupiahFormat(&pricePerHour),
-		Images:       photoRes,
-		Latitude:     field.Latitude,
-		Lonitude:     field.Lonitude,
-		CreatedAt:    field.CreatedAt,
-		UpdateAt:     field.UpdatedAt,
+		UUID:          field.UUID,
+		Code:          field.Code,
+		Name:          field.Name,
+		PricePerHour:  util.RupiahFormat(&pricePerHour),
+		Images:        photoRes,
+		ImageVariants: photoVariants,
+		VenueUUID:     venueUUID,
+		VenueName:     venueName,
+		Rating:        summaries[field.ID].Rating,
+		ReviewCount:   summaries[field.ID].ReviewCount,
+		Latitude:      field.Latitude,
+		Lonitude:      field.Longitude,
+		CreatedAt:     field.CreatedAt,
+		UpdateAt:      field.UpdatedAt,
 	}
 
 	return &fieldResult, nil
 }
 
+// venueInfo mengambil UUID dan nama venue dari field (jika field terhubung ke venue).
+func (f *FieldService) venueInfo(field *models.Field) (*uuid.UUID, string) {
+	if field.Venue == nil {
+		return nil, ""
+	}
+	return &field.Venue.UUID, field.Venue.Name
+}
+
+// ratingSummaries mengambil rata-rata rating dan jumlah review untuk daftar field sekaligus
+// dalam satu query. Rating dibulatkan ke satu angka di belakang koma.
+func (f *FieldService) ratingSummaries(ctx context.Context, fields []models.Field) (map[uint]dto.RatingSummary, error) {
+	fieldIDs := make([]uint, 0, len(fields))
+	for _, field := range fields {
+		fieldIDs = append(fieldIDs, field.ID)
+	}
+
+	summaries, err := f.repository.GetReview().FindRatingSummaryByFieldIDs(ctx, fieldIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for fieldID, summary := range summaries {
+		summary.Rating = math.Round(summary.Rating*10) / 10
+		summaries[fieldID] = summary
+	}
+
+	return summaries, nil
+}
+
+// resolveVenue mencari venue tujuan field dan memastikan user boleh mengelolanya.
+// Venue manager wajib menyertakan venue, sedangkan admin boleh membuat field tanpa venue.
+func (f *FieldService) resolveVenue(ctx context.Context, venueID string) (*models.Venue, error) {
+	if venueID == "" {
+		if authz.IsVenueManager(ctx) {
+			return nil, errVenue.ErrVenueRequired
+		}
+		return nil, nil
+	}
+
+	venue, err := f.repository.GetVenue().FindByUUID(ctx, venueID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = authz.CanManageVenue(ctx, venue)
+	if err != nil {
+		return nil, err
+	}
+
+	return venue, nil
+}
+
 func (f *FieldService) validateUpload(images []multipart.FileHeader) error {
 	if len(images) == 0 {
 		return errCons.ErrInvalidUploadFile
 	}
 
 	for _, image := range images {
-		if image.Size > 5*1024*1024 { // 5MB batas ukuran
+		if image.Size > imaging.MaxFileSize {
 			return errCons.ErrSizeTooBig
 		}
 	}
@@ -223,37 +296,65 @@ func (f *FieldService) validateUpload(images []multipart.FileHeader) error {
 	return nil
 }
 
-// processAndUploadImage membuka file gambar, membaca isinya ke dalam buffer,
-// dan mengunggahnya ke Google Cloud Storage.
-//
-// Parameter:
-// - ctx: Context untuk operasi asinkron
-// - image: File gambar yang akan diunggah
-//
-// Return:
-// - URL dari file yang diunggah
-// - Error jika terjadi kesalahan
-func (f *FieldService) processAndUploadImage(ctx context.Context, image multipart.FileHeader) (string, error) {
+// processImage membaca file gambar dan memprosesnya lewat imaging.Process: isi file
+// divalidasi berdasarkan magic byte, EXIF dibuang, dan varian ukuran dibuat.
+// Nama file dari client diabaikan.
+func (f *FieldService) processImage(image multipart.FileHeader) ([]imaging.File, error) {
 	file, err := image.Open()
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	defer file.Close()
 
-	// Membaca isi file ke dalam buffer
-	buffer := new(bytes.Buffer)
-	_, err = io.Copy(buffer, file)
+	// dibaca satu byte lebih dari batas supaya ukuran header yang dipalsukan tetap tertangkap
+	data, err := io.ReadAll(io.LimitReader(file, imaging.MaxFileSize+1))
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	// Membuat nama file unik berdasarkan timestamp
-	filename := fmt.Sprintf("images/%s-%s-%s", time.Now().Format("20060102150405"), image.Filename, path.Ext(image.Filename))
+	return imaging.Process(data)
+}
 
-	// Mengunggah file ke GCS
-	url, err := f.gcs.UploadFile(ctx, filename, buffer.Bytes())
+// processImages memproses semua gambar sebelum ada yang disimpan, sehingga satu file
+// yang tidak valid tidak meninggalkan file lain yang terlanjur tersimpan.
+func (f *FieldService) processImages(images []multipart.FileHeader) ([][]imaging.File, error) {
+	err := f.validateUpload(images)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+
+	processed := make([][]imaging.File, 0, len(images))
+	for _, image := range images {
+		files, err := f.processImage(image)
+		if err != nil {
+			return nil, err
+		}
+		processed = append(processed, files)
+	}
+
+	return processed, nil
+}
+
+// processAndUploadImage memproses file gambar lalu mengunggah gambar asli beserta
+// variannya ke Google Cloud Storage.
+//
+// Parameter:
+// - ctx: Context untuk operasi asinkron
+// - files: Hasil imaging.Process, gambar asli di elemen pertama
+//
+// Return:
+// - URL dari gambar asli yang diunggah
+// - Error jika terjadi kesalahan
+func (f *FieldService) processAndUploadImage(ctx context.Context, files []imaging.File) (string, error) {
+	var url string
+	for i, file := range files {
+		uploaded, err := f.gcs.UploadFile(ctx, "images/"+file.Name, file.Data)
+		if err != nil {
+			return "", err
+		}
+		if i == 0 {
+			url = uploaded
+		}
 	}
 
 	return url, nil
@@ -269,15 +370,15 @@ func (f *FieldService) processAndUploadImage(ctx context.Context, image multipar
 // - Daftar URL dari gambar yang berhasil diunggah
 // - Error jika ada kegagalan dalam proses upload
 func (f *FieldService) uploadImage(ctx context.Context, images []multipart.FileHeader) ([]string, error) {
-	err := f.validateUpload(images)
+	processed, err := f.processImages(images)
 	if err != nil {
 		return nil, err
 	}
 
 	// Menampung URL hasil upload
-	urls := make([]string, 0, len(images))
-	for _, image := range images {
-		url, err := f.processAndUploadImage(ctx, image)
+	urls := make([]string, 0, len(processed))
+	for _, files := range processed {
+		url, err := f.processAndUploadImage(ctx, files)
 		if err != nil {
 			return nil, err
 		}
@@ -287,12 +388,89 @@ func (f *FieldService) uploadImage(ctx context.Context, images []multipart.FileH
 	return urls, nil
 }
 
+// uploadImageLocal memvalidasi dan memproses gambar, lalu menyimpannya di server lokal.
+// Yang dikembalikan adalah nama file gambar asli untuk disimpan di kolom image.
+func (f *FieldService) uploadImageLocal(ctx context.Context, images []multipart.FileHeader) ([]string, error) {
+	processed, err := f.processImages(images)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(processed))
+	for _, files := range processed {
+		err = util.SaveImageLocal(files)
+		if err != nil {
+			logger.FromContext(ctx).Errorf("gagal menyimpan gambar lapangan: %v", err)
+			f.deleteImagesLocal(ctx, append(names, files[0].Name))
+			return nil, err
+		}
+		names = append(names, files[0].Name)
+	}
+
+	return names, nil
+}
+
+// deleteImagesLocal menghapus gambar beserta variannya. Kegagalan hanya dicatat karena
+// data lapangan sudah tidak lagi merujuk ke gambar tersebut.
+func (f *FieldService) deleteImagesLocal(ctx context.Context, fileNames []string) {
+	for _, fileName := range fileNames {
+		err := util.DeleteImageLocal(fileName)
+		if err != nil {
+			logger.FromContext(ctx).Errorf("gagal menghapus gambar lapangan %s: %v", fileName, err)
+		}
+	}
+}
+
+// keptImages menentukan gambar lama yang dipertahankan saat update. Jika KeepImages
+// tidak dikirim, semua gambar lama dipertahankan kecuali ada upload baru yang
+// menggantikannya. Nama file boleh dikirim dalam bentuk URL lengkap.
+func (f *FieldService) keptImages(current []string, req *dto.UpdateFieldRequest) ([]string, error) {
+	if req.KeepImages == nil {
+		if req.Images == nil {
+			return current, nil
+		}
+		return []string{}, nil
+	}
+
+	existing := make(map[string]bool, len(current))
+	for _, fileName := range current {
+		existing[fileName] = true
+	}
+
+	kept := make([]string, 0, len(req.KeepImages))
+	seen := make(map[string]bool, len(req.KeepImages))
+	for _, item := range req.KeepImages {
+		if item == "" {
+			continue
+		}
+		fileName := path.Base(item)
+		if !existing[fileName] {
+			return nil, errField.ErrFieldImageNotFound
+		}
+		if seen[fileName] {
+			continue
+		}
+		seen[fileName] = true
+		kept = append(kept, fileName)
+	}
+
+	return kept, nil
+}
+
 func (f *FieldService) Create(ctx context.Context, req *dto.FieldRequest) (*dto.FieldResponse, error) {
+	venue, err := f.resolveVenue(ctx, req.VenueID)
+	if err != nil {
+		return nil, err
+	}
+
+	var venueID *uint
+	if venue != nil {
+		venueID = &venue.ID
+	}
 
 	//upload image for local
-	photo, err := util.UploadImageLocal(req.Images)
+	photo, err := f.uploadImageLocal(ctx, req.Images)
 	if err != nil {
-		log.Errorf("error from service uploadImage", err)
 		return nil, err
 	}
 
@@ -306,22 +484,20 @@ func (f *FieldService) Create(ctx context.Context, req *dto.FieldRequest) (*dto.
 		Code:         req.Code,
 		Name:         req.Name,
 		Latitude:     req.Latitude,
-		Lonitude:     req.Lonitude,
+		Longitude:    req.Lonitude,
 		PricePerHour: req.PricePerHour,
 		Image:        photo,
+		VenueID:      venueID,
 	})
 	if err != nil {
-		log.Errorf("Error create field in service", err)
+		f.deleteImagesLocal(ctx, photo)
 		return nil, err
 	}
 
-	// response url for local
-	var photoRes []string
-	for _, fileName := range field.Image {
-		photoRes = append(photoRes, constants.BuildFullImagePath(fileName))
-	}
+	f.cache.Invalidate(ctx, cache.Fields)
 
-	fmt.Print("berhasil create di service")
+	// response url for local
+	photoRes, photoVariants := util.BuildFieldImages(field.Image)
 
 	response := dto.FieldResponse{
 		UUID:         field.UUID,
@@ -329,11 +505,16 @@ func (f *FieldService) Create(ctx context.Context, req *dto.FieldRequest) (*dto.
 		Name:         field.Name,
 		PricePerHour: field.PricePerHour,
 		// Images:       field.Image, // for GRPc
-		Images:    photoRes, // for local
-		Latitude:  field.Latitude,
-		Lonitude:  field.Lonitude,
-		CreatedAt: field.CreatedAt,
-		UpdateAt:  field.UpdatedAt,
+		Images:        photoRes, // for local
+		ImageVariants: photoVariants,
+		Latitude:      field.Latitude,
+		Lonitude:      field.Longitude,
+		CreatedAt:     field.CreatedAt,
+		UpdateAt:      field.UpdatedAt,
+	}
+	if venue != nil {
+		response.VenueUUID = &venue.UUID
+		response.VenueName = venue.Name
 	}
 
 	return &response, nil
@@ -345,15 +526,43 @@ func (f *FieldService) Update(ctx context.Context, uuidParam string, req *dto.Up
 		return nil, err
 	}
 
+	// venue manager hanya boleh mengubah field milik venue-nya
+	err = authz.CanManageVenue(ctx, field.Venue)
+	if err != nil {
+		return nil, err
+	}
+
+	venue := field.Venue
+	if req.VenueID != "" {
+		venue, err = f.resolveVenue(ctx, req.VenueID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var venueID *uint
+	if venue != nil {
+		venueID = &venue.ID
+	}
+
 	// for Local
-	var imageUrls []string
-	if req.Images == nil {
-		imageUrls = field.Image // Gunakan gambar lama jika tidak ada gambar baru
-	} else {
-		imageUrls, err = util.UploadImageLocal(req.Images) // Upload gambar baru jika tersedia
+	// gambar lama yang dipertahankan (sesuai urutan dari KeepImages) diikuti gambar baru
+	imageUrls, err := f.keptImages(field.Image, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var uploaded []string
+	if req.Images != nil {
+		uploaded, err = f.uploadImageLocal(ctx, req.Images) // Upload gambar baru jika tersedia
 		if err != nil {
 			return nil, err
 		}
+		imageUrls = append(imageUrls, uploaded...)
+	}
+
+	if len(imageUrls) == 0 {
+		return nil, errField.ErrFieldImageRequired
 	}
 
 	// for GRPCs
@@ -373,47 +582,109 @@ func (f *FieldService) Update(ctx context.Context, uuidParam string, req *dto.Up
 		Code:         req.Code,
 		Name:         req.Name,
 		Latitude:     req.Latitude,
-		Lonitude:     req.Lonitude,
+		Longitude:    req.Lonitude,
 		PricePerHour: req.PricePerHour,
 		Image:        imageUrls,
+		VenueID:      venueID,
 	})
 	if err != nil {
+		f.deleteImagesLocal(ctx, uploaded)
 		return nil, err
 	}
 
-	var imageUrlsRes []string
-	for _, fileName := range fieldResult.Image {
-		imageUrlsRes = append(imageUrlsRes, constants.BuildFullImagePath(fileName))
-	}
+	// nama dan harga lapangan ikut tampil di response jadwal
+	f.cache.Invalidate(ctx, cache.Fields, cache.Schedules)
+
+	// file gambar lama yang tidak lagi dipakai ikut dihapus
+	f.deleteImagesLocal(ctx, removedImages(field.Image, imageUrls))
+
+	imageUrlsRes, imageVariants := util.BuildFieldImages(fieldResult.Image)
 
 	uuidParsed, _ := uuid.Parse(uuidParam)
 	response := dto.FieldResponse{
-		UUID:         uuidParsed,
-		Code:         fieldResult.Code,
-		Name:         fieldResult.Name,
-		PricePerHour: fieldResult.PricePerHour,
-		Images:       imageUrlsRes,
-		Latitude:     field.Latitude,
-		Lonitude:     field.Lonitude,
-		CreatedAt:    fieldResult.CreatedAt,
-		UpdateAt:     fieldResult.UpdatedAt,
+		UUID:          uuidParsed,
+		Code:          fieldResult.Code,
+		Name:          fieldResult.Name,
+		PricePerHour:  fieldResult.PricePerHour,
+		Images:        imageUrlsRes,
+		ImageVariants: imageVariants,
+		Latitude:      field.Latitude,
+		Lonitude:      field.Longitude,
+		CreatedAt:     fieldResult.CreatedAt,
+		UpdateAt:      fieldResult.UpdatedAt,
+	}
+	if venue != nil {
+		response.VenueUUID = &venue.UUID
+		response.VenueName = venue.Name
 	}
 
 	return &response, nil
 }
 
+// removedImages mengembalikan gambar di before yang tidak ada lagi di after.
+func removedImages(before, after []string) []string {
+	kept := make(map[string]bool, len(after))
+	for _, fileName := range after {
+		kept[fileName] = true
+	}
+
+	var removed []string
+	for _, fileName := range before {
+		if !kept[fileName] {
+			removed = append(removed, fileName)
+		}
+	}
+	return removed
+}
+
 func (f *FieldService) Delete(ctx context.Context, uuid string) error {
 	// Cek apakah field dengan UUID tersebut ada
-	_, err := f.repository.GetField().FindByUUID(ctx, uuid)
+	field, err := f.repository.GetField().FindByUUID(ctx, uuid)
 	if err != nil {
 		return err
 	}
 
-	// Hapus field dari repository
+	// venue manager hanya boleh menghapus field milik venue-nya
+	err = authz.CanManageVenue(ctx, field.Venue)
+	if err != nil {
+		return err
+	}
+
+	// lapangan yang masih punya jadwal terpesan mulai hari ini tidak boleh dihapus,
+	// karena customer yang sudah membayar tidak bisa lagi melihat jadwalnya
+	booked, err := f.repository.GetFieldSchedule().CountBookedFromDate(ctx, field.ID, time.Now().Format(time.DateOnly))
+	if err != nil {
+		return err
+	}
+	if booked > 0 {
+		return errField.ErrFieldHasBookedSchedule
+	}
+
+	// Soft delete field beserta jadwalnya
 	err = f.repository.GetField().Delete(ctx, uuid)
 	if err != nil {
 		return err
 	}
 
+	f.cache.Invalidate(ctx, cache.Fields, cache.Schedules)
+
+	return nil
+}
+
+// Restore implements IFieldService.
+// Memulihkan lapangan yang sudah dihapus beserta jadwal yang ikut terhapus bersamanya.
+func (f *FieldService) Restore(ctx context.Context, uuid string) error {
+	field, err := f.repository.GetField().FindDeletedByUUID(ctx, uuid)
+	if err != nil {
+		return err
+	}
+
+	err = f.repository.GetField().Restore(ctx, field)
+	if err != nil {
+		return err
+	}
+
+	f.cache.Invalidate(ctx, cache.Fields, cache.Schedules)
+
 	return nil
 }
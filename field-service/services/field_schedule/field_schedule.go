@@ -16,4 +16,6 @@ type IFieldScheduleService interface {
 	Update(ctx context.Context, uuid string, req *dto.UpdateFieldScheduleRequest) (*dto.FieldScheduleResponse, error)
 	UpdateStatus(ctx context.Context, req *dto.UpdateStatusFieldScheduleRequest) error
 	Delete(ctx context.Context, uuid string) error
+	Restore(ctx context.Context, uuid string) error
+	SubscribeStatus(ctx context.Context, uuid string, date string) (<-chan []byte, func(), error)
 }
@@ -5,17 +5,27 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/anddriii/kita-futsal/field-service/common/authz"
+	"github.com/anddriii/kita-futsal/field-service/common/cache"
+	"github.com/anddriii/kita-futsal/field-service/common/logger"
+	"github.com/anddriii/kita-futsal/field-service/common/realtime"
 	"github.com/anddriii/kita-futsal/field-service/common/util"
 	"github.com/anddriii/kita-futsal/field-service/constants"
+	errConst "github.com/anddriii/kita-futsal/field-service/constants/error"
+	errField "github.com/anddriii/kita-futsal/field-service/constants/error/field"
 	errFieldSchedule "github.com/anddriii/kita-futsal/field-service/constants/error/field_schedule"
 	"github.com/anddriii/kita-futsal/field-service/domains/dto"
 	"github.com/anddriii/kita-futsal/field-service/domains/models"
 	"github.com/anddriii/kita-futsal/field-service/repositories"
+	waitlistService "github.com/anddriii/kita-futsal/field-service/services/waitlist"
 	"github.com/google/uuid"
 )
 
 type FieldScheduleService struct {
 	repository repositories.IRepoRegistry
+	waitlist   waitlistService.IWaitlistService
+	cache      *cache.Cache
+	hub        *realtime.Hub
 }
 
 // Create menambahkan jadwal lapangan baru berdasarkan permintaan pengguna.
@@ -26,6 +36,12 @@ func (f *FieldScheduleService) Create(ctx context.Context, req *dto.FieldSchedul
 		return err // Mengembalikan error jika lapangan tidak ditemukan
 	}
 
+	// Venue manager hanya boleh membuat jadwal untuk lapangan di venue miliknya
+	err = authz.CanManageVenue(ctx, field.Venue)
+	if err != nil {
+		return err
+	}
+
 	// Menyiapkan slice untuk menyimpan data jadwal lapangan yang akan dibuat
 	fieldSchedules := make([]models.FieldSchedule, 0, len(req.TimeIDs))
 
@@ -67,21 +83,68 @@ func (f *FieldScheduleService) Create(ctx context.Context, req *dto.FieldSchedul
 		return err // Mengembalikan error jika gagal menyimpan ke database
 	}
 
+	f.cache.Invalidate(ctx, cache.Schedules)
+
 	return nil // Mengembalikan nil jika operasi berhasil tanpa error
 }
 
 // Delete implements IFieldScheduleService.
 func (f *FieldScheduleService) Delete(ctx context.Context, uuid string) error {
-	_, err := f.repository.GetFieldSchedule().FindByUUID(ctx, uuid)
+	fieldSchedule, err := f.repository.GetFieldSchedule().FindByUUID(ctx, uuid)
 	if err != nil {
 		return err
 	}
 
+	err = authz.CanManageVenue(ctx, fieldSchedule.Field.Venue)
+	if err != nil {
+		return err
+	}
+
+	// jadwal yang sudah dibayar atau sedang ditahan order dan belum lewat tidak boleh dihapus
+	if fieldSchedule.Status != constants.Available && fieldSchedule.Date.Format(time.DateOnly) >= time.Now().Format(time.DateOnly) {
+		return errFieldSchedule.ErrFieldScheduleBooked
+	}
+
+	// soft delete, jadwal tetap tersimpan untuk order yang merujuknya
 	err = f.repository.GetFieldSchedule().Delete(ctx, uuid)
 	if err != nil {
 		return err
 	}
 
+	f.cache.Invalidate(ctx, cache.Schedules)
+
+	return nil
+}
+
+// Restore implements IFieldScheduleService.
+// Jadwal hanya bisa dipulihkan jika lapangannya masih ada dan slotnya belum dipakai
+// jadwal lain yang dibuat setelah jadwal ini dihapus.
+func (f *FieldScheduleService) Restore(ctx context.Context, uuid string) error {
+	fieldSchedule, err := f.repository.GetFieldSchedule().FindDeletedByUUID(ctx, uuid)
+	if err != nil {
+		return err
+	}
+
+	if fieldSchedule.Field.DeletedAt.Valid {
+		return errField.ErrFieldNotFound
+	}
+
+	existing, err := f.repository.GetFieldSchedule().FindByDateAndTimeId(ctx,
+		fieldSchedule.Date.Format(time.DateOnly), int(fieldSchedule.TimeId), int(fieldSchedule.FieldId))
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return errFieldSchedule.ErrFieldScheduleExist
+	}
+
+	err = f.repository.GetFieldSchedule().Restore(ctx, uuid)
+	if err != nil {
+		return err
+	}
+
+	f.cache.Invalidate(ctx, cache.Schedules)
+
 	return nil
 }
 
@@ -116,6 +179,12 @@ func (f *FieldScheduleService) convertMonthName(inputDate string) string {
 // FindAllFieldByIdAndDate retrieves all field schedules by field UUID and date.
 // It returns a list of available field schedules for booking on the given date.
 func (f *FieldScheduleService) FindAllFieldByIdAndDate(ctx context.Context, uuid string, date string) ([]dto.FieldScheduleForBookingReponse, error) {
+	return cache.Get(ctx, f.cache, cache.Schedules, cache.Key("field", uuid, date), func(ctx context.Context) ([]dto.FieldScheduleForBookingReponse, error) {
+		return f.findAllFieldByIdAndDate(ctx, uuid, date)
+	})
+}
+
+func (f *FieldScheduleService) findAllFieldByIdAndDate(ctx context.Context, uuid string, date string) ([]dto.FieldScheduleForBookingReponse, error) {
 	// Retrieve field details using UUID
 	field, err := f.repository.GetField().FindByUUID(ctx, uuid)
 	if err != nil {
@@ -131,28 +200,57 @@ func (f *FieldScheduleService) FindAllFieldByIdAndDate(ctx context.Context, uuid
 	// Prepare response slice
 	fieldScheduleResults := make([]dto.FieldScheduleForBookingReponse, 0, len(fieldSchedules))
 	for _, fieldSchedule := range fieldSchedules {
-		pricePerHour := float64(fieldSchedule.Field.PricePerHour)
-		startTime, _ := time.Parse("15:04:05", fieldSchedule.Time.StartTime)
-		endTime, _ := time.Parse("15:04:05", fieldSchedule.Time.EndTime)
-
-		// Append formatted data to response list
-		fieldScheduleResults = append(fieldScheduleResults, dto.FieldScheduleForBookingReponse{
-			UUID:         fieldSchedule.UUID,
-			PricePerHour: util.RupiahFormat(&pricePerHour),
-			Date:         f.convertMonthName(fieldSchedule.Date.Format("2006-01-02")),
-			Status:       fieldSchedule.Status.GetStatusString(),
-			Time:         fmt.Sprintf("%s - %s", startTime.Format("15:04"), endTime.Format("15:04")),
-		})
+		fieldScheduleResults = append(fieldScheduleResults, f.toBookingResponse(&fieldSchedule))
 	}
 
-	fmt.Println("data dari service : /", fieldScheduleResults)
-
 	return fieldScheduleResults, nil
 }
 
+// toBookingResponse memformat jadwal seperti yang ditampilkan di halaman booking.
+// Dipakai juga untuk event status di stream supaya client bisa langsung mengganti
+// slot dengan UUID yang sama.
+func (f *FieldScheduleService) toBookingResponse(fieldSchedule *models.FieldSchedule) dto.FieldScheduleForBookingReponse {
+	pricePerHour := float64(fieldSchedule.Field.PricePerHour)
+	startTime, _ := time.Parse("15:04:05", fieldSchedule.Time.StartTime)
+	endTime, _ := time.Parse("15:04:05", fieldSchedule.Time.EndTime)
+
+	return dto.FieldScheduleForBookingReponse{
+		UUID:         fieldSchedule.UUID,
+		PricePerHour: util.RupiahFormat(&pricePerHour),
+		Date:         f.convertMonthName(fieldSchedule.Date.Format("2006-01-02")),
+		Status:       fieldSchedule.Status.GetStatusString(),
+		Time:         fmt.Sprintf("%s - %s", startTime.Format("15:04"), endTime.Format("15:04")),
+	}
+}
+
+// SubscribeStatus implements IFieldScheduleService.
+// Topic disusun dari UUID lapangan yang tersimpan, bukan dari input, supaya sama
+// persis dengan topic yang dipakai UpdateStatus saat mengirim event.
+func (f *FieldScheduleService) SubscribeStatus(ctx context.Context, uuid string, date string) (<-chan []byte, func(), error) {
+	field, err := f.repository.GetField().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	events, unsubscribe := f.hub.Subscribe(realtime.ScheduleTopic(field.UUID.String(), date))
+	return events, unsubscribe, nil
+}
+
 // FindAllWithPagination retrieves all field schedules with pagination.
 // It returns a paginated response containing field schedules.
 func (f *FieldScheduleService) FindAllWithPagination(ctx context.Context, param *dto.FieldScheduleRequestParam) (*util.PaginationResult, error) {
+	// Only admins may list deleted schedules
+	if param.Deleted && !authz.IsAdmin(ctx) {
+		return nil, errConst.ErrForbidden
+	}
+
+	// Venue manager only sees schedules of the venues they manage
+	venueIDs, err := authz.ResolveVenueFilter(ctx, f.repository.GetVenue(), param.VenueID)
+	if err != nil {
+		return nil, err
+	}
+	param.VenueIDs = venueIDs
+
 	// Fetch paginated schedules based on request parameters
 	fieldSchedules, total, err := f.repository.GetFieldSchedule().FindAllWithPagination(ctx, param)
 	if err != nil {
@@ -164,6 +262,7 @@ func (f *FieldScheduleService) FindAllWithPagination(ctx context.Context, param
 	for _, schedule := range fieldSchedules {
 		fieldScheduleResults = append(fieldScheduleResults, dto.FieldScheduleResponse{
 			UUID:         schedule.UUID,
+			FieldUUID:    schedule.Field.UUID,
 			FieldName:    schedule.Field.Name,
 			PricePerHour: schedule.Field.PricePerHour,
 			Date:         schedule.Date.Format("2006-01-02"),
@@ -171,6 +270,7 @@ func (f *FieldScheduleService) FindAllWithPagination(ctx context.Context, param
 			Time:         fmt.Sprintf("%s - %s", schedule.Time.StartTime, schedule.Time.EndTime),
 			CreatedAt:    schedule.CreatedAt,
 			UpdateAt:     schedule.UpdatedAt,
+			DeletedAt:    util.DeletedAt(schedule.DeletedAt),
 		})
 	}
 
@@ -189,6 +289,12 @@ func (f *FieldScheduleService) FindAllWithPagination(ctx context.Context, param
 
 // FindByUUID implements IFieldScheduleService.
 func (f *FieldScheduleService) FindByUUID(ctx context.Context, uuid string) (*dto.FieldScheduleResponse, error) {
+	return cache.Get(ctx, f.cache, cache.Schedules, cache.Key("uuid", uuid), func(ctx context.Context) (*dto.FieldScheduleResponse, error) {
+		return f.findByUUID(ctx, uuid)
+	})
+}
+
+func (f *FieldScheduleService) findByUUID(ctx context.Context, uuid string) (*dto.FieldScheduleResponse, error) {
 	fieldSchedule, err := f.repository.GetFieldSchedule().FindByUUID(ctx, uuid)
 	if err != nil {
 		return nil, err
@@ -196,6 +302,7 @@ func (f *FieldScheduleService) FindByUUID(ctx context.Context, uuid string) (*dt
 
 	response := dto.FieldScheduleResponse{
 		UUID:         fieldSchedule.UUID,
+		FieldUUID:    fieldSchedule.Field.UUID,
 		FieldName:    fieldSchedule.Field.Name,
 		PricePerHour: fieldSchedule.Field.PricePerHour,
 		Date:         fieldSchedule.Date.Format("2006-01-02"),
@@ -204,6 +311,19 @@ func (f *FieldScheduleService) FindByUUID(ctx context.Context, uuid string) (*dt
 		CreatedAt:    fieldSchedule.CreatedAt,
 		UpdateAt:     fieldSchedule.UpdatedAt,
 	}
+	if fieldSchedule.Field.Venue != nil {
+		response.VenueUUID = &fieldSchedule.Field.Venue.UUID
+	}
+
+	// slot yang kembali tersedia bisa sedang dipegang oleh user terdepan di waitlist
+	claim, err := f.repository.GetWaitlist().FindActiveClaim(ctx, fieldSchedule.ID)
+	if err != nil {
+		return nil, err
+	}
+	if claim != nil {
+		response.ClaimedBy = &claim.UserID
+		response.ClaimExpires = claim.ClaimExpiresAt
+	}
 
 	return &response, nil
 }
@@ -218,6 +338,12 @@ func (f *FieldScheduleService) GenereateScheduleForOneMonth(ctx context.Context,
 		return err // Jika lapangan tidak ditemukan, return error.
 	}
 
+	// Venue manager hanya boleh generate jadwal untuk lapangan di venue miliknya.
+	err = authz.CanManageVenue(ctx, field.Venue)
+	if err != nil {
+		return err
+	}
+
 	// Mengambil semua slot waktu yang tersedia dari database.
 	times, err := f.repository.GetTime().FindAll(ctx)
 	if err != nil {
@@ -269,6 +395,8 @@ func (f *FieldScheduleService) GenereateScheduleForOneMonth(ctx context.Context,
 		return err // Jika terjadi error saat menyimpan, return error.
 	}
 
+	f.cache.Invalidate(ctx, cache.Schedules)
+
 	return nil // Jika berhasil, return nil (tidak ada error).
 }
 
@@ -279,6 +407,12 @@ func (f *FieldScheduleService) Update(ctx context.Context, uuid string, req *dto
 		return nil, err // Jika tidak ditemukan, kembalikan error
 	}
 
+	// Venue manager hanya boleh mengubah jadwal lapangan di venue miliknya
+	err = authz.CanManageVenue(ctx, fieldSchedule.Field.Venue)
+	if err != nil {
+		return nil, err
+	}
+
 	// Mencari data waktu berdasarkan TimeID yang diberikan dalam request
 	scheduleTime, err := f.repository.GetTime().FindByUUID(ctx, req.TimeID)
 	if err != nil {
@@ -316,9 +450,12 @@ func (f *FieldScheduleService) Update(ctx context.Context, uuid string, req *dto
 		return nil, err
 	}
 
+	f.cache.Invalidate(ctx, cache.Schedules)
+
 	// Membentuk response DTO untuk dikirimkan ke client
 	response := dto.FieldScheduleResponse{
 		UUID:         fieldResult.UUID,
+		FieldUUID:    fieldResult.Field.UUID,
 		FieldName:    fieldResult.Field.Name,
 		Date:         fieldResult.Date.Format(time.DateOnly),
 		PricePerHour: fieldResult.Field.PricePerHour,
@@ -332,22 +469,105 @@ func (f *FieldScheduleService) Update(ctx context.Context, uuid string, req *dto
 }
 
 // UpdateStatus implements IFieldScheduleService.
+// Status default adalah Booked (dipanggil order-service setelah pembayaran berhasil).
+// Held dipakai saat order dibuat dan hanya berhasil jika semua jadwal masih Available.
+// Status Available dipakai untuk melepas slot, misalnya karena pembayaran kedaluwarsa
+// atau order dibatalkan. Setiap perubahan status diteruskan ke waitlist dan ke client
+// yang sedang memantau jadwal lapangan tersebut.
 func (f *FieldScheduleService) UpdateStatus(ctx context.Context, req *dto.UpdateStatusFieldScheduleRequest) error {
+	// dijalankan juga saat gagal di tengah, karena jadwal sebelumnya sudah terlanjur berubah.
+	// Event dikirim setelah cache dibatalkan supaya client yang memuat ulang tidak
+	// mendapat data lama.
+	var changed []*models.FieldSchedule
+	defer func() {
+		f.cache.Invalidate(ctx, cache.Schedules)
+		f.publishStatus(ctx, changed)
+	}()
+
+	status := constants.Booked
+	if req.Status != "" {
+		status = req.Status.GetStatusInt()
+	}
+
+	// menahan beberapa jadwal harus berhasil semua atau tidak sama sekali, jadi setiap
+	// kegagalan di tengah melepas lagi jadwal yang sudah ditahan oleh request ini
+	var held []string
+	fail := func(err error) error {
+		if status == constants.Held {
+			f.releaseHeld(ctx, held)
+			changed = nil
+		}
+		return err
+	}
+
 	for _, item := range req.FieldScheduleIDs {
-		_, err := f.repository.GetFieldSchedule().FindByUUID(ctx, item)
+		fieldSchedule, err := f.repository.GetFieldSchedule().FindByUUID(ctx, item)
 		if err != nil {
-			return err
+			return fail(err)
 		}
 
-		err = f.repository.GetFieldSchedule().UpdateStatus(ctx, constants.Booked, item)
-		if err != nil {
-			return err
+		if status == constants.Held {
+			// dua order yang dibuat bersamaan tidak boleh menahan jadwal yang sama
+			ok, err := f.repository.GetFieldSchedule().UpdateStatusFrom(ctx, item, constants.Available, constants.Held)
+			if err != nil {
+				return fail(err)
+			}
+			if !ok {
+				return fail(errFieldSchedule.ErrFieldScheduleNotAvailable)
+			}
+			held = append(held, item)
+			// status sebelum ditahan pasti Available walaupun data yang dibaca sudah basi
+			fieldSchedule.Status = constants.Available
+		} else {
+			err = f.repository.GetFieldSchedule().UpdateStatus(ctx, status, item)
+			if err != nil {
+				return err
+			}
 		}
 
+		previous := fieldSchedule.Status
+		if previous != status {
+			fieldSchedule.Status = status
+			changed = append(changed, fieldSchedule)
+		}
+
+		err = f.waitlist.OnStatusChanged(ctx, fieldSchedule, previous, status)
+		if err != nil {
+			return fail(err)
+		}
 	}
 	return nil
 }
 
-func NewFieldScheduleService(repository repositories.IRepoRegistry) IFieldScheduleService {
-	return &FieldScheduleService{repository: repository}
+// releaseHeld mengembalikan jadwal yang sudah ditahan oleh request yang gagal, supaya
+// menahan beberapa jadwal sekaligus berhasil semua atau tidak sama sekali.
+func (f *FieldScheduleService) releaseHeld(ctx context.Context, uuids []string) {
+	for _, item := range uuids {
+		_, err := f.repository.GetFieldSchedule().UpdateStatusFrom(ctx, item, constants.Held, constants.Available)
+		if err != nil {
+			logger.FromContext(ctx).Errorf("failed to release held field schedule %s: %v", item, err)
+		}
+	}
+}
+
+// publishStatus mengirim status terbaru setiap jadwal ke topic lapangan dan tanggalnya.
+// Kegagalan hanya dicatat karena status sudah tersimpan; client tetap mendapat data
+// terbaru saat memuat ulang jadwal.
+func (f *FieldScheduleService) publishStatus(ctx context.Context, fieldSchedules []*models.FieldSchedule) {
+	for _, fieldSchedule := range fieldSchedules {
+		topic := realtime.ScheduleTopic(fieldSchedule.Field.UUID.String(), fieldSchedule.Date.Format(time.DateOnly))
+		err := f.hub.Publish(ctx, topic, f.toBookingResponse(fieldSchedule))
+		if err != nil {
+			logger.FromContext(ctx).Errorf("failed to publish status of field schedule %s: %v", fieldSchedule.UUID, err)
+		}
+	}
+}
+
+func NewFieldScheduleService(repository repositories.IRepoRegistry, waitlist waitlistService.IWaitlistService, cache *cache.Cache, hub *realtime.Hub) IFieldScheduleService {
+	return &FieldScheduleService{
+		repository: repository,
+		waitlist:   waitlist,
+		cache:      cache,
+		hub:        hub,
+	}
 }
@@ -1,28 +1,37 @@
 package services
 
 import (
+	"github.com/anddriii/kita-futsal/field-service/clients"
+	"github.com/anddriii/kita-futsal/field-service/common/cache"
 	"github.com/anddriii/kita-futsal/field-service/common/gcs"
+	"github.com/anddriii/kita-futsal/field-service/common/notifier"
+	"github.com/anddriii/kita-futsal/field-service/common/realtime"
 	"github.com/anddriii/kita-futsal/field-service/repositories"
 	fieldService "github.com/anddriii/kita-futsal/field-service/services/field"
 	fieldScheduleService "github.com/anddriii/kita-futsal/field-service/services/field_schedule"
+	reportService "github.com/anddriii/kita-futsal/field-service/services/report"
+	reviewService "github.com/anddriii/kita-futsal/field-service/services/review"
 	timeService "github.com/anddriii/kita-futsal/field-service/services/time"
-	"github.com/redis/go-redis/v9"
+	venueService "github.com/anddriii/kita-futsal/field-service/services/venue"
+	waitlistService "github.com/anddriii/kita-futsal/field-service/services/waitlist"
 )
 
 type Registry struct {
 	repository repositories.IRepoRegistry
 	gcs        gcs.IGCSClient
-	redis      *redis.Client
+	cache      *cache.Cache
+	hub        *realtime.Hub
+	client     clients.IClientRegistry
 }
 
 // GetField implements IServiceRegistry.
 func (r *Registry) GetField() fieldService.IFieldService {
-	return fieldService.NewFieldService(r.repository, r.gcs, r.redis)
+	return fieldService.NewFieldService(r.repository, r.gcs, r.cache)
 }
 
 // GetFieldSchedule implements IServiceRegistry.
 func (r *Registry) GetFieldSchedule() fieldScheduleService.IFieldScheduleService {
-	return fieldScheduleService.NewFieldScheduleService(r.repository)
+	return fieldScheduleService.NewFieldScheduleService(r.repository, r.GetWaitlist(), r.cache, r.hub)
 }
 
 // GetTime implements IServiceRegistry.
@@ -30,16 +39,42 @@ func (r *Registry) GetTime() timeService.ITimeService {
 	return timeService.NewTimeService(r.repository)
 }
 
+// GetVenue implements IServiceRegistry.
+func (r *Registry) GetVenue() venueService.IVenueService {
+	return venueService.NewVenueService(r.repository, r.cache)
+}
+
+// GetReview implements IServiceRegistry.
+func (r *Registry) GetReview() reviewService.IReviewService {
+	return reviewService.NewReviewService(r.repository, r.client, r.cache)
+}
+
+// GetWaitlist implements IServiceRegistry.
+func (r *Registry) GetWaitlist() waitlistService.IWaitlistService {
+	return waitlistService.NewWaitlistService(r.repository, notifier.NewLogNotifier(), r.cache)
+}
+
+// GetReport implements IServiceRegistry.
+func (r *Registry) GetReport() reportService.IReportService {
+	return reportService.NewReportService(r.repository)
+}
+
 type IServiceRegistry interface {
 	GetField() fieldService.IFieldService
 	GetFieldSchedule() fieldScheduleService.IFieldScheduleService
 	GetTime() timeService.ITimeService
+	GetVenue() venueService.IVenueService
+	GetReview() reviewService.IReviewService
+	GetWaitlist() waitlistService.IWaitlistService
+	GetReport() reportService.IReportService
 }
 
-func NewServiceRegistry(repository repositories.IRepoRegistry, gcs gcs.IGCSClient, redis *redis.Client) IServiceRegistry {
+func NewServiceRegistry(repository repositories.IRepoRegistry, gcs gcs.IGCSClient, cache *cache.Cache, hub *realtime.Hub, client clients.IClientRegistry) IServiceRegistry {
 	return &Registry{
 		repository: repository,
 		gcs:        gcs,
-		redis:      redis,
+		cache:      cache,
+		hub:        hub,
+		client:     client,
 	}
 }
@@ -0,0 +1,12 @@
+package services
+
+import (
+	"context"
+
+	"github.com/anddriii/kita-futsal/field-service/domains/dto"
+)
+
+type IReportService interface {
+	GetOccupancy(ctx context.Context, param *dto.ReportRequestParam) ([]dto.OccupancyReportResponse, error)
+	GetPeakHours(ctx context.Context, param *dto.ReportRequestParam) ([]dto.PeakHourReportResponse, error)
+}
@@ -0,0 +1,87 @@
+package services
+
+import (
+	"context"
+	"math"
+	"time"
+
+	errReport "github.com/anddriii/kita-futsal/field-service/constants/error/report"
+	"github.com/anddriii/kita-futsal/field-service/domains/dto"
+	"github.com/anddriii/kita-futsal/field-service/repositories"
+)
+
+type ReportService struct {
+	repository repositories.IRepoRegistry
+}
+
+func NewReportService(repository repositories.IRepoRegistry) IReportService {
+	return &ReportService{repository: repository}
+}
+
+// validateDateRange memastikan startDate tidak lebih besar dari endDate.
+// Format tanggal sudah divalidasi di controller.
+func (r *ReportService) validateDateRange(param *dto.ReportRequestParam) error {
+	startDate, err := time.Parse(time.DateOnly, param.StartDate)
+	if err != nil {
+		return errReport.ErrInvalidDateRange
+	}
+
+	endDate, err := time.Parse(time.DateOnly, param.EndDate)
+	if err != nil {
+		return errReport.ErrInvalidDateRange
+	}
+
+	if startDate.After(endDate) {
+		return errReport.ErrInvalidDateRange
+	}
+
+	return nil
+}
+
+// GetOccupancy implements IReportService.
+// Tingkat okupansi dihitung dari jadwal Booked dibandingkan seluruh jadwal lapangan, dalam persen.
+func (r *ReportService) GetOccupancy(ctx context.Context, param *dto.ReportRequestParam) ([]dto.OccupancyReportResponse, error) {
+	err := r.validateDateRange(param)
+	if err != nil {
+		return nil, err
+	}
+
+	reports, err := r.repository.GetReport().FindOccupancy(ctx, param.StartDate, param.EndDate, param.VenueID)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]dto.OccupancyReportResponse, 0, len(reports))
+	for _, report := range reports {
+		var rate float64
+		if report.TotalSlot > 0 {
+			rate = math.Round(float64(report.BookedSlot)/float64(report.TotalSlot)*10000) / 100
+		}
+
+		results = append(results, dto.OccupancyReportResponse{
+			FieldID:       report.FieldID,
+			FieldName:     report.FieldName,
+			TotalSlot:     report.TotalSlot,
+			BookedSlot:    report.BookedSlot,
+			AvailableSlot: report.TotalSlot - report.BookedSlot,
+			OccupancyRate: rate,
+		})
+	}
+
+	return results, nil
+}
+
+// GetPeakHours implements IReportService.
+func (r *ReportService) GetPeakHours(ctx context.Context, param *dto.ReportRequestParam) ([]dto.PeakHourReportResponse, error) {
+	err := r.validateDateRange(param)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := r.repository.GetReport().FindPeakHours(ctx, param.StartDate, param.EndDate, param.VenueID)
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
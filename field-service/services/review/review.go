@@ -0,0 +1,16 @@
+package services
+
+import (
+	"context"
+
+	"github.com/anddriii/kita-futsal/field-service/common/util"
+	"github.com/anddriii/kita-futsal/field-service/domains/dto"
+)
+
+type IReviewService interface {
+	GetAllWithPagination(ctx context.Context, param *dto.ReviewRequestParam) (*util.PaginationResult, error)
+	GetByFieldID(ctx context.Context, fieldID string) ([]dto.ReviewResponse, error)
+	Create(ctx context.Context, req *dto.ReviewRequest) (*dto.ReviewResponse, error)
+	Moderate(ctx context.Context, uuid string, req *dto.ModerateReviewRequest) (*dto.ReviewResponse, error)
+	Delete(ctx context.Context, uuid string) error
+}
@@ -0,0 +1,176 @@
+package services
+
+import (
+	"context"
+
+	"github.com/anddriii/kita-futsal/field-service/clients"
+	"github.com/anddriii/kita-futsal/field-service/common/authz"
+	"github.com/anddriii/kita-futsal/field-service/common/cache"
+	"github.com/anddriii/kita-futsal/field-service/common/util"
+	errConst "github.com/anddriii/kita-futsal/field-service/constants/error"
+	errReview "github.com/anddriii/kita-futsal/field-service/constants/error/review"
+	"github.com/anddriii/kita-futsal/field-service/domains/dto"
+	"github.com/anddriii/kita-futsal/field-service/domains/models"
+	"github.com/anddriii/kita-futsal/field-service/repositories"
+	"github.com/google/uuid"
+)
+
+type ReviewService struct {
+	repository repositories.IRepoRegistry
+	client     clients.IClientRegistry
+	cache      *cache.Cache
+}
+
+func NewReviewService(repository repositories.IRepoRegistry, client clients.IClientRegistry, cache *cache.Cache) IReviewService {
+	return &ReviewService{
+		repository: repository,
+		client:     client,
+		cache:      cache,
+	}
+}
+
+// toReviewResponse mengubah model Review menjadi response.
+func (r *ReviewService) toReviewResponse(review *models.Review) dto.ReviewResponse {
+	return dto.ReviewResponse{
+		UUID:         review.UUID,
+		FieldUUID:    review.Field.UUID,
+		FieldName:    review.Field.Name,
+		OrderID:      review.OrderID,
+		UserID:       review.UserID,
+		UserName:     review.UserName,
+		Rating:       review.Rating,
+		Comment:      review.Comment,
+		IsHidden:     review.IsHidden,
+		HiddenReason: review.HiddenReason,
+		CreatedAt:    review.CreatedAt,
+		UpdateAt:     review.UpdatedAt,
+	}
+}
+
+// GetAllWithPagination implements IReviewService.
+func (r *ReviewService) GetAllWithPagination(ctx context.Context, param *dto.ReviewRequestParam) (*util.PaginationResult, error) {
+	reviews, total, err := r.repository.GetReview().FindAllWithPagination(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+
+	reviewResults := make([]dto.ReviewResponse, 0, len(reviews))
+	for _, review := range reviews {
+		reviewResults = append(reviewResults, r.toReviewResponse(&review))
+	}
+
+	pagination := &util.PaginationParam{
+		Count: total,
+		Page:  param.Page,
+		Limit: param.Limit,
+		Data:  reviewResults,
+	}
+
+	response := util.GeneratePagination(*pagination)
+	return &response, nil
+}
+
+// GetByFieldID implements IReviewService.
+// Hanya review yang tidak disembunyikan oleh admin yang ditampilkan ke publik.
+func (r *ReviewService) GetByFieldID(ctx context.Context, fieldID string) ([]dto.ReviewResponse, error) {
+	field, err := r.repository.GetField().FindByUUID(ctx, fieldID)
+	if err != nil {
+		return nil, err
+	}
+
+	reviews, err := r.repository.GetReview().FindAllVisibleByFieldID(ctx, field.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	reviewResults := make([]dto.ReviewResponse, 0, len(reviews))
+	for _, review := range reviews {
+		reviewResults = append(reviewResults, r.toReviewResponse(&review))
+	}
+
+	return reviewResults, nil
+}
+
+// Create implements IReviewService.
+// Review hanya boleh dibuat jika order-service menyatakan order tersebut milik user,
+// sudah dibayar (payment success), dan jadwalnya di lapangan ini sudah selesai dimainkan.
+// Satu order hanya bisa memberikan satu review untuk setiap lapangan.
+func (r *ReviewService) Create(ctx context.Context, req *dto.ReviewRequest) (*dto.ReviewResponse, error) {
+	user := authz.GetUserLogin(ctx)
+	if user == nil {
+		return nil, errConst.ErrUnauthorized
+	}
+
+	field, err := r.repository.GetField().FindByUUID(ctx, req.FieldID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := r.repository.GetReview().FindByOrderIDAndFieldID(ctx, req.OrderID, field.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing != nil {
+		return nil, errReview.ErrReviewExist
+	}
+
+	eligibility, err := r.client.GetOrder().CheckReviewEligibility(ctx, req.OrderID, req.FieldID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !eligibility.Eligible || eligibility.UserID != user.UUID {
+		return nil, errReview.ErrReviewNotEligible
+	}
+
+	review, err := r.repository.GetReview().Create(ctx, &models.Review{
+		FieldID:  field.ID,
+		OrderID:  uuid.MustParse(req.OrderID),
+		UserID:   user.UUID,
+		UserName: user.Name,
+		Rating:   req.Rating,
+		Comment:  req.Comment,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// rating lapangan dihitung dari review yang tampil
+	r.cache.Invalidate(ctx, cache.Fields)
+
+	review.Field = *field
+	response := r.toReviewResponse(review)
+	return &response, nil
+}
+
+// Moderate implements IReviewService.
+// Admin dapat menyembunyikan review (misalnya karena spam atau kata kasar) atau menampilkannya kembali.
+func (r *ReviewService) Moderate(ctx context.Context, uuid string, req *dto.ModerateReviewRequest) (*dto.ReviewResponse, error) {
+	review, err := r.repository.GetReview().UpdateVisibility(ctx, uuid, *req.IsHidden, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Invalidate(ctx, cache.Fields)
+
+	response := r.toReviewResponse(review)
+	return &response, nil
+}
+
+// Delete implements IReviewService.
+func (r *ReviewService) Delete(ctx context.Context, uuid string) error {
+	_, err := r.repository.GetReview().FindByUUID(ctx, uuid)
+	if err != nil {
+		return err
+	}
+
+	err = r.repository.GetReview().Delete(ctx, uuid)
+	if err != nil {
+		return err
+	}
+
+	r.cache.Invalidate(ctx, cache.Fields)
+
+	return nil
+}
@@ -0,0 +1,19 @@
+package services
+
+import (
+	"context"
+
+	"github.com/anddriii/kita-futsal/field-service/common/util"
+	"github.com/anddriii/kita-futsal/field-service/domains/dto"
+)
+
+type IVenueService interface {
+	GetAllWithPagination(ctx context.Context, param *dto.VenueRequestParam) (*util.PaginationResult, error)
+	GetAllWithoutPagination(ctx context.Context) ([]dto.VenueResponse, error)
+	GetByUUID(ctx context.Context, uuid string) (*dto.VenueResponse, error)
+	GetByManagerID(ctx context.Context, managerID string) ([]dto.VenueResponse, error)
+	GetMyVenues(ctx context.Context) ([]dto.VenueResponse, error)
+	Create(ctx context.Context, req *dto.VenueRequest) (*dto.VenueResponse, error)
+	Update(ctx context.Context, uuid string, req *dto.UpdateVenueRequest) (*dto.VenueResponse, error)
+	Delete(ctx context.Context, uuid string) error
+}
@@ -0,0 +1,217 @@
+package services
+
+import (
+	"context"
+
+	"github.com/anddriii/kita-futsal/field-service/common/authz"
+	"github.com/anddriii/kita-futsal/field-service/common/cache"
+	"github.com/anddriii/kita-futsal/field-service/common/util"
+	"github.com/anddriii/kita-futsal/field-service/domains/dto"
+	"github.com/anddriii/kita-futsal/field-service/domains/models"
+	"github.com/anddriii/kita-futsal/field-service/repositories"
+	"github.com/google/uuid"
+)
+
+type VenueService struct {
+	repository repositories.IRepoRegistry
+	cache      *cache.Cache
+}
+
+func NewVenueService(repository repositories.IRepoRegistry, cache *cache.Cache) IVenueService {
+	return &VenueService{repository: repository, cache: cache}
+}
+
+// toVenueResponse mengubah model Venue menjadi response, termasuk daftar lapangannya (jika di-preload).
+func (v *VenueService) toVenueResponse(venue *models.Venue) dto.VenueResponse {
+	fields := make([]dto.FieldResponse, 0, len(venue.Fields))
+	for _, field := range venue.Fields {
+		photoRes, photoVariants := util.BuildFieldImages(field.Image)
+
+		fields = append(fields, dto.FieldResponse{
+			UUID:          field.UUID,
+			Code:          field.Code,
+			Name:          field.Name,
+			Latitude:      field.Latitude,
+			Lonitude:      field.Longitude,
+			PricePerHour:  field.PricePerHour,
+			Images:        photoRes,
+			ImageVariants: photoVariants,
+			VenueUUID:     &venue.UUID,
+			VenueName:     venue.Name,
+			CreatedAt:     field.CreatedAt,
+			UpdateAt:      field.UpdatedAt,
+		})
+	}
+
+	return dto.VenueResponse{
+		UUID:        venue.UUID,
+		Name:        venue.Name,
+		Address:     venue.Address,
+		City:        venue.City,
+		Latitude:    venue.Latitude,
+		Longitude:   venue.Longitude,
+		PhoneNumber: venue.PhoneNumber,
+		Email:       venue.Email,
+		ManagerID:   venue.ManagerID,
+		Fields:      fields,
+		CreatedAt:   venue.CreatedAt,
+		UpdateAt:    venue.UpdatedAt,
+	}
+}
+
+// parseManagerID mengubah managerID dari request (string) menjadi UUID.
+func (v *VenueService) parseManagerID(managerID *string) *uuid.UUID {
+	if managerID == nil || *managerID == "" {
+		return nil
+	}
+
+	parsed := uuid.MustParse(*managerID)
+	return &parsed
+}
+
+// GetAllWithPagination implements IVenueService.
+func (v *VenueService) GetAllWithPagination(ctx context.Context, param *dto.VenueRequestParam) (*util.PaginationResult, error) {
+	venues, total, err := v.repository.GetVenue().FindAllWithPagination(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+
+	venueResults := make([]dto.VenueResponse, 0, len(venues))
+	for _, venue := range venues {
+		venueResults = append(venueResults, v.toVenueResponse(&venue))
+	}
+
+	pagination := &util.PaginationParam{
+		Count: total,
+		Page:  param.Page,
+		Limit: param.Limit,
+		Data:  venueResults,
+	}
+
+	response := util.GeneratePagination(*pagination)
+	return &response, nil
+}
+
+// GetAllWithoutPagination implements IVenueService.
+func (v *VenueService) GetAllWithoutPagination(ctx context.Context) ([]dto.VenueResponse, error) {
+	venues, err := v.repository.GetVenue().FindAllWithoutPagination(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	venueResults := make([]dto.VenueResponse, 0, len(venues))
+	for _, venue := range venues {
+		venueResults = append(venueResults, v.toVenueResponse(&venue))
+	}
+
+	return venueResults, nil
+}
+
+// GetByUUID implements IVenueService.
+func (v *VenueService) GetByUUID(ctx context.Context, uuid string) (*dto.VenueResponse, error) {
+	venue, err := v.repository.GetVenue().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	response := v.toVenueResponse(venue)
+	return &response, nil
+}
+
+// GetByManagerID implements IVenueService.
+// Dipakai oleh service lain (order-service) untuk mengetahui venue yang dikelola seorang user.
+func (v *VenueService) GetByManagerID(ctx context.Context, managerID string) ([]dto.VenueResponse, error) {
+	venues, err := v.repository.GetVenue().FindByManagerID(ctx, managerID)
+	if err != nil {
+		return nil, err
+	}
+
+	venueResults := make([]dto.VenueResponse, 0, len(venues))
+	for _, venue := range venues {
+		venueResults = append(venueResults, v.toVenueResponse(&venue))
+	}
+
+	return venueResults, nil
+}
+
+// GetMyVenues implements IVenueService.
+func (v *VenueService) GetMyVenues(ctx context.Context) ([]dto.VenueResponse, error) {
+	user := authz.GetUserLogin(ctx)
+	return v.GetByManagerID(ctx, user.UUID.String())
+}
+
+// Create implements IVenueService.
+func (v *VenueService) Create(ctx context.Context, req *dto.VenueRequest) (*dto.VenueResponse, error) {
+	venue, err := v.repository.GetVenue().Create(ctx, &models.Venue{
+		Name:        req.Name,
+		Address:     req.Address,
+		City:        req.City,
+		Latitude:    req.Latitude,
+		Longitude:   req.Longitude,
+		PhoneNumber: req.PhoneNumber,
+		Email:       req.Email,
+		ManagerID:   v.parseManagerID(req.ManagerID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := v.toVenueResponse(venue)
+	return &response, nil
+}
+
+// Update implements IVenueService.
+func (v *VenueService) Update(ctx context.Context, uuid string, req *dto.UpdateVenueRequest) (*dto.VenueResponse, error) {
+	venue, err := v.repository.GetVenue().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	err = authz.CanManageVenue(ctx, venue)
+	if err != nil {
+		return nil, err
+	}
+
+	// venue manager tidak boleh memindahkan venue ke manager lain
+	managerID := v.parseManagerID(req.ManagerID)
+	if authz.IsVenueManager(ctx) {
+		managerID = venue.ManagerID
+	}
+
+	venueResult, err := v.repository.GetVenue().Update(ctx, uuid, &models.Venue{
+		Name:        req.Name,
+		Address:     req.Address,
+		City:        req.City,
+		Latitude:    req.Latitude,
+		Longitude:   req.Longitude,
+		PhoneNumber: req.PhoneNumber,
+		Email:       req.Email,
+		ManagerID:   managerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// nama venue ikut tampil di response lapangan dan jadwal
+	v.cache.Invalidate(ctx, cache.Fields, cache.Schedules)
+
+	response := v.toVenueResponse(venueResult)
+	return &response, nil
+}
+
+// Delete implements IVenueService.
+func (v *VenueService) Delete(ctx context.Context, uuid string) error {
+	_, err := v.repository.GetVenue().FindByUUID(ctx, uuid)
+	if err != nil {
+		return err
+	}
+
+	err = v.repository.GetVenue().Delete(ctx, uuid)
+	if err != nil {
+		return err
+	}
+
+	v.cache.Invalidate(ctx, cache.Fields, cache.Schedules)
+
+	return nil
+}
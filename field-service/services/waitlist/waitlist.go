@@ -0,0 +1,18 @@
+package services
+
+import (
+	"context"
+
+	"github.com/anddriii/kita-futsal/field-service/constants"
+	"github.com/anddriii/kita-futsal/field-service/domains/dto"
+	"github.com/anddriii/kita-futsal/field-service/domains/models"
+)
+
+type IWaitlistService interface {
+	Join(ctx context.Context, req *dto.WaitlistRequest) (*dto.WaitlistResponse, error)
+	GetMine(ctx context.Context) ([]dto.WaitlistResponse, error)
+	GetBySchedule(ctx context.Context, scheduleID string) ([]dto.WaitlistResponse, error)
+	Leave(ctx context.Context, uuid string) error
+	OnStatusChanged(ctx context.Context, schedule *models.FieldSchedule, from, to constants.FieldScheduleStatus) error
+	ExpireClaims(ctx context.Context) error
+}
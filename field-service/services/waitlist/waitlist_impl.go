@@ -0,0 +1,324 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/anddriii/kita-futsal/field-service/common/authz"
+	"github.com/anddriii/kita-futsal/field-service/common/cache"
+	"github.com/anddriii/kita-futsal/field-service/common/logger"
+	"github.com/anddriii/kita-futsal/field-service/common/notifier"
+	"github.com/anddriii/kita-futsal/field-service/constants"
+	errConst "github.com/anddriii/kita-futsal/field-service/constants/error"
+	errWaitlist "github.com/anddriii/kita-futsal/field-service/constants/error/waitlist"
+	"github.com/anddriii/kita-futsal/field-service/domains/dto"
+	"github.com/anddriii/kita-futsal/field-service/domains/models"
+	"github.com/anddriii/kita-futsal/field-service/repositories"
+)
+
+type WaitlistService struct {
+	repository repositories.IRepoRegistry
+	notifier   notifier.INotifier
+	cache      *cache.Cache
+}
+
+func NewWaitlistService(repository repositories.IRepoRegistry, notifier notifier.INotifier, cache *cache.Cache) IWaitlistService {
+	return &WaitlistService{
+		repository: repository,
+		notifier:   notifier,
+		cache:      cache,
+	}
+}
+
+// toWaitlistResponse mengubah model Waitlist menjadi response beserta posisi antreannya.
+// Posisi hanya dihitung untuk entri yang masih aktif (Waiting atau Notified).
+func (w *WaitlistService) toWaitlistResponse(ctx context.Context, waitlist *models.Waitlist) (dto.WaitlistResponse, error) {
+	var position int64
+	if waitlist.Status == constants.Waiting || waitlist.Status == constants.Notified {
+		ahead, err := w.repository.GetWaitlist().CountAhead(ctx, waitlist)
+		if err != nil {
+			return dto.WaitlistResponse{}, err
+		}
+		position = ahead + 1
+	}
+
+	schedule := waitlist.FieldSchedule
+	return dto.WaitlistResponse{
+		UUID:              waitlist.UUID,
+		FieldScheduleUUID: schedule.UUID,
+		FieldName:         schedule.Field.Name,
+		Date:              schedule.Date.Format(time.DateOnly),
+		Time:              fmt.Sprintf("%s - %s", schedule.Time.StartTime, schedule.Time.EndTime),
+		UserID:            waitlist.UserID,
+		UserName:          waitlist.UserName,
+		Position:          position,
+		Status:            waitlist.Status.GetStatusString(),
+		ClaimExpiresAt:    waitlist.ClaimExpiresAt,
+		CreatedAt:         waitlist.CreatedAt,
+		UpdateAt:          waitlist.UpdatedAt,
+	}, nil
+}
+
+// Join implements IWaitlistService.
+// User hanya bisa masuk waitlist untuk jadwal yang sudah dipesan atau ditahan order
+// orang lain, atau sedang dipegang oleh user lain di waitlist.
+func (w *WaitlistService) Join(ctx context.Context, req *dto.WaitlistRequest) (*dto.WaitlistResponse, error) {
+	user := authz.GetUserLogin(ctx)
+	if user == nil {
+		return nil, errConst.ErrUnauthorized
+	}
+
+	schedule, err := w.repository.GetFieldSchedule().FindByUUID(ctx, req.FieldScheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if schedule.Status == constants.Available {
+		claim, err := w.repository.GetWaitlist().FindActiveClaim(ctx, schedule.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if claim == nil {
+			return nil, errWaitlist.ErrScheduleStillAvailable
+		}
+	}
+
+	existing, err := w.repository.GetWaitlist().FindActiveByScheduleIDAndUserID(ctx, schedule.ID, user.UUID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if existing != nil {
+		return nil, errWaitlist.ErrAlreadyInWaitlist
+	}
+
+	waitlist, err := w.repository.GetWaitlist().Create(ctx, &models.Waitlist{
+		FieldScheduleID: schedule.ID,
+		UserID:          user.UUID,
+		UserName:        user.Name,
+		Email:           user.Email,
+		PhoneNumber:     user.PhoneNumber,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	waitlist.FieldSchedule = *schedule
+	response, err := w.toWaitlistResponse(ctx, waitlist)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// GetMine implements IWaitlistService.
+func (w *WaitlistService) GetMine(ctx context.Context) ([]dto.WaitlistResponse, error) {
+	user := authz.GetUserLogin(ctx)
+	if user == nil {
+		return nil, errConst.ErrUnauthorized
+	}
+
+	waitlists, err := w.repository.GetWaitlist().FindByUserID(ctx, user.UUID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	waitlistResults := make([]dto.WaitlistResponse, 0, len(waitlists))
+	for _, waitlist := range waitlists {
+		response, err := w.toWaitlistResponse(ctx, &waitlist)
+		if err != nil {
+			return nil, err
+		}
+		waitlistResults = append(waitlistResults, response)
+	}
+
+	return waitlistResults, nil
+}
+
+// GetBySchedule implements IWaitlistService.
+// Venue manager hanya boleh melihat antrean jadwal di venue miliknya.
+func (w *WaitlistService) GetBySchedule(ctx context.Context, scheduleID string) ([]dto.WaitlistResponse, error) {
+	schedule, err := w.repository.GetFieldSchedule().FindByUUID(ctx, scheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = authz.CanManageVenue(ctx, schedule.Field.Venue)
+	if err != nil {
+		return nil, err
+	}
+
+	waitlists, err := w.repository.GetWaitlist().FindActiveByScheduleID(ctx, schedule.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	waitlistResults := make([]dto.WaitlistResponse, 0, len(waitlists))
+	for i, waitlist := range waitlists {
+		response, err := w.toWaitlistResponse(ctx, &waitlist)
+		if err != nil {
+			return nil, err
+		}
+		response.Position = int64(i + 1)
+		waitlistResults = append(waitlistResults, response)
+	}
+
+	return waitlistResults, nil
+}
+
+// Leave implements IWaitlistService.
+// Jika user yang keluar sedang memegang claim, giliran langsung berpindah ke user berikutnya.
+func (w *WaitlistService) Leave(ctx context.Context, uuid string) error {
+	user := authz.GetUserLogin(ctx)
+	if user == nil {
+		return errConst.ErrUnauthorized
+	}
+
+	waitlist, err := w.repository.GetWaitlist().FindByUUID(ctx, uuid)
+	if err != nil {
+		return err
+	}
+
+	if waitlist.UserID != user.UUID {
+		return errConst.ErrForbidden
+	}
+
+	if waitlist.Status != constants.Waiting && waitlist.Status != constants.Notified {
+		return errWaitlist.ErrWaitlistNotFound
+	}
+
+	wasNotified := waitlist.Status == constants.Notified
+	waitlist.Status = constants.Cancelled
+	err = w.repository.GetWaitlist().Update(ctx, waitlist)
+	if err != nil {
+		return err
+	}
+
+	if wasNotified {
+		// claim yang dilepas ikut tampil di detail jadwal
+		w.cache.Invalidate(ctx, cache.Schedules)
+		if waitlist.FieldSchedule.Status == constants.Available {
+			return w.promoteNext(ctx, waitlist.FieldScheduleID)
+		}
+	}
+
+	return nil
+}
+
+// OnStatusChanged implements IWaitlistService.
+// Dipanggil setiap kali status jadwal berubah. Saat slot kembali Available, user terdepan
+// di waitlist diberi notifikasi dan claim berbatas waktu. Saat slot Booked, claim yang
+// sedang berjalan dianggap sudah terpakai. Slot yang Held belum mengubah apa pun karena
+// ordernya masih bisa kedaluwarsa.
+func (w *WaitlistService) OnStatusChanged(ctx context.Context, schedule *models.FieldSchedule, from, to constants.FieldScheduleStatus) error {
+	if from == to {
+		return nil
+	}
+
+	switch to {
+	case constants.Available:
+		return w.promoteNext(ctx, schedule.ID)
+	case constants.Booked:
+		claim, err := w.repository.GetWaitlist().FindActiveClaim(ctx, schedule.ID)
+		if err != nil {
+			return err
+		}
+
+		if claim != nil {
+			claim.Status = constants.Fulfilled
+			err = w.repository.GetWaitlist().Update(ctx, claim)
+			if err != nil {
+				return err
+			}
+			w.cache.Invalidate(ctx, cache.Schedules)
+		}
+	}
+
+	return nil
+}
+
+// ExpireClaims implements IWaitlistService.
+// Menandai claim yang sudah lewat batas waktunya sebagai Lapsed, lalu memberikan giliran
+// ke user berikutnya jika slot masih Available. Dijalankan secara berkala dari cmd.
+func (w *WaitlistService) ExpireClaims(ctx context.Context) error {
+	waitlists, err := w.repository.GetWaitlist().FindExpiredClaims(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, waitlist := range waitlists {
+		waitlist.Status = constants.Lapsed
+		err = w.repository.GetWaitlist().Update(ctx, &waitlist)
+		if err != nil {
+			return err
+		}
+		w.cache.Invalidate(ctx, cache.Schedules)
+
+		if waitlist.FieldSchedule.Status == constants.Available {
+			err = w.promoteNext(ctx, waitlist.FieldScheduleID)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// promoteNext memberikan claim ke user terdepan yang masih menunggu, selama belum ada
+// user lain yang memegang claim aktif untuk jadwal tersebut.
+func (w *WaitlistService) promoteNext(ctx context.Context, scheduleID uint) error {
+	claim, err := w.repository.GetWaitlist().FindActiveClaim(ctx, scheduleID)
+	if err != nil {
+		return err
+	}
+
+	if claim != nil {
+		return nil
+	}
+
+	next, err := w.repository.GetWaitlist().FindFirstWaiting(ctx, scheduleID)
+	if err != nil {
+		return err
+	}
+
+	if next == nil {
+		return nil
+	}
+
+	now := time.Now()
+	claimExpiresAt := now.Add(constants.WaitlistClaimDuration)
+	next.Status = constants.Notified
+	next.NotifiedAt = &now
+	next.ClaimExpiresAt = &claimExpiresAt
+	err = w.repository.GetWaitlist().Update(ctx, next)
+	if err != nil {
+		return err
+	}
+	w.cache.Invalidate(ctx, cache.Schedules)
+
+	schedule := next.FieldSchedule
+	err = w.notifier.Notify(ctx, notifier.Notification{
+		UserID:      next.UserID,
+		Name:        next.UserName,
+		Email:       next.Email,
+		PhoneNumber: next.PhoneNumber,
+		Subject:     "Slot lapangan kembali tersedia",
+		Message: fmt.Sprintf("Jadwal %s pada %s pukul %s - %s kembali tersedia. Segera pesan sebelum %s.",
+			schedule.Field.Name,
+			schedule.Date.Format(time.DateOnly),
+			schedule.Time.StartTime,
+			schedule.Time.EndTime,
+			claimExpiresAt.Format(time.DateTime),
+		),
+	})
+	if err != nil {
+		// kegagalan notifikasi tidak membatalkan claim, user tetap bisa melihatnya di waitlist miliknya
+		logger.FromContext(ctx).Errorf("failed to notify waitlist %s: %v", next.UUID, err)
+	}
+
+	return nil
+}
@@ -1,35 +1,62 @@
 package config
 
-import "github.com/parnurzeal/gorequest"
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+const (
+	defaultTimeout    = 5 * time.Second
+	defaultMaxRetries = 2
+	defaultBackoff    = 100 * time.Millisecond
+)
+
+// httpClient is shared by every downstream so connections are pooled. Unlike the
+// gorequest agent it replaces, http.Client is safe for concurrent use.
+var httpClient = &http.Client{}
 
 type ClientConfig struct {
-	client       *gorequest.SuperAgent
+	name         string
 	baseURL      string
+	grpcTarget   string
 	signatureKey string
+	timeout      time.Duration
+	maxRetries   int
+	backoff      time.Duration
 }
 
 type IClientConfig interface {
-	Client() *gorequest.SuperAgent
+	Name() string
 	BaseURL() string
 	SignatureKey() string
+	Do(ctx context.Context, request Request) (*Response, error)
+	GrpcConn() (*grpc.ClientConn, error)
+	Invoke(ctx context.Context, idempotent bool, call func(context.Context) error) error
+	Ping(ctx context.Context) error
 }
 
 type Option func(*ClientConfig)
 
 func NewClientConfig(options ...Option) IClientConfig {
 	clientConfig := &ClientConfig{
-		client: gorequest.New().
-			Set("Content-Type", "application/json").
-			Set("Accept", "application/json"),
+		timeout:    defaultTimeout,
+		maxRetries: defaultMaxRetries,
+		backoff:    defaultBackoff,
 	}
 	for _, option := range options {
 		option(clientConfig)
 	}
+	if clientConfig.name == "" {
+		clientConfig.name = clientConfig.baseURL
+	}
 	return clientConfig
 }
 
-func (c *ClientConfig) Client() *gorequest.SuperAgent {
-	return c.client
+func (c *ClientConfig) Name() string {
+	return c.name
 }
 
 func (c *ClientConfig) BaseURL() string {
@@ -40,14 +67,44 @@ func (c *ClientConfig) SignatureKey() string {
 	return c.signatureKey
 }
 
+// WithName names the downstream; clients sharing a name share a circuit breaker.
+func WithName(name string) Option {
+	return func(c *ClientConfig) {
+		c.name = name
+	}
+}
+
 func WithBaseURL(baseURL string) Option {
 	return func(c *ClientConfig) {
 		c.baseURL = baseURL
 	}
 }
 
+// WithGrpcTarget sets the host:port of the downstream's gRPC server.
+func WithGrpcTarget(target string) Option {
+	return func(c *ClientConfig) {
+		c.grpcTarget = target
+	}
+}
+
 func WithSignatureKey(signatureKey string) Option {
 	return func(c *ClientConfig) {
 		c.signatureKey = signatureKey
 	}
 }
+
+// WithTimeout bounds every single attempt, on top of the caller's own deadline.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *ClientConfig) {
+		c.timeout = timeout
+	}
+}
+
+// WithRetry sets how many times an idempotent call is retried and the base
+// delay of the jittered exponential backoff between attempts.
+func WithRetry(maxRetries int, backoff time.Duration) Option {
+	return func(c *ClientConfig) {
+		c.maxRetries = maxRetries
+		c.backoff = backoff
+	}
+}
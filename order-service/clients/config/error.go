@@ -0,0 +1,50 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	errConstant "github.com/anddriii/kita-futsal/order-service/constants/error"
+)
+
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// DownstreamError reports a downstream that could not serve the call: its
+// breaker is open, it timed out, was unreachable or kept answering 5xx. It
+// matches errConstant.ErrServiceUnavailable so handlers can answer 503.
+type DownstreamError struct {
+	Service    string
+	StatusCode int
+	Err        error
+}
+
+func (e *DownstreamError) Error() string {
+	return fmt.Sprintf("%s service unavailable: %v", e.Service, e.Err)
+}
+
+func (e *DownstreamError) Unwrap() error {
+	return e.Err
+}
+
+func (e *DownstreamError) Is(target error) bool {
+	return target == errConstant.ErrServiceUnavailable
+}
+
+// errorReason names why a call failed for the client metrics, or "" when it
+// did not. rejected covers errors the downstream answered with on purpose.
+func errorReason(err error) string {
+	var downstreamErr *DownstreamError
+	switch {
+	case err == nil:
+		return ""
+	case errors.Is(err, ErrCircuitOpen):
+		return "circuit_open"
+	case errors.As(err, &downstreamErr):
+		return "unavailable"
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return "canceled"
+	default:
+		return "rejected"
+	}
+}
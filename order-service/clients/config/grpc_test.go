@@ -0,0 +1,145 @@
+package config
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/anddriii/kita-futsal/order-service/common/signature"
+	configApp "github.com/anddriii/kita-futsal/order-service/config"
+	"github.com/anddriii/kita-futsal/order-service/constants"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	testSignatureKey = "kita-futsal-signature-key"
+	testFullMethod   = "/field.FieldService/UpdateFieldScheduleStatus"
+)
+
+type memoryNonceStore struct {
+	mu     sync.Mutex
+	nonces map[string]bool
+}
+
+func (s *memoryNonceStore) Claim(_ context.Context, nonce string, _ time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.nonces[nonce] {
+		return false, nil
+	}
+	s.nonces[nonce] = true
+	return true, nil
+}
+
+// capture runs signUnary and returns the outgoing metadata the invoker saw.
+func capture(t *testing.T, c *ClientConfig, ctx context.Context, req any) metadata.MD {
+	t.Helper()
+
+	var md metadata.MD
+	invoker := func(ctx context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		md, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+	err := c.signUnary(ctx, testFullMethod, req, nil, nil, invoker)
+	if err != nil {
+		t.Fatalf("signUnary: %v", err)
+	}
+	return md
+}
+
+func value(md metadata.MD, key string) string {
+	values := md.Get(strings.ToLower(key))
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// TestSignUnaryMatchesServerVerification re-encodes the message the way the
+// servers' GrpcAuthenticate interceptor does and verifies the metadata with the
+// same signature package, so both sides agree on the canonical string.
+func TestSignUnaryMatchesServerVerification(t *testing.T) {
+	configApp.Config.AppName = "order-service"
+	c := &ClientConfig{signatureKey: testSignatureKey}
+
+	// map fields are where a non-deterministic encoding would diverge
+	message, err := structpb.NewStruct(map[string]any{
+		"fieldScheduleIds": []any{"a", "b", "c"},
+		"status":           "Held",
+		"orderId":          "6f1c2a52-3b0e-4c1e-9c55-2f4a8e7d9b10",
+		"amount":           150000,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.WithValue(context.Background(), constants.Token, "user-token")
+	md := capture(t, c, ctx, message)
+
+	wire, err := proto.Marshal(message)
+	if err != nil {
+		t.Fatal(err)
+	}
+	received := &structpb.Struct{}
+	err = proto.Unmarshal(wire, received)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := proto.MarshalOptions{Deterministic: true}.Marshal(received)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	request := signature.Request{
+		ServiceName: value(md, constants.XServiceName),
+		Method:      "POST",
+		Path:        testFullMethod,
+		Body:        body,
+		Timestamp:   value(md, constants.XRequestAt),
+		Nonce:       value(md, constants.XNonce),
+	}
+	nonces := &memoryNonceStore{nonces: map[string]bool{}}
+	err = signature.Verify(context.Background(), testSignatureKey, request, value(md, constants.XSignature), nonces)
+	if err != nil {
+		t.Fatalf("server verification: %v", err)
+	}
+
+	if request.ServiceName != "order-service" {
+		t.Fatalf("x-service-name = %q, want order-service", request.ServiceName)
+	}
+	if got := value(md, constants.Authorization); got != "Bearer user-token" {
+		t.Fatalf("authorization = %q, want Bearer user-token", got)
+	}
+}
+
+func TestSignUnaryUsesFreshNoncePerAttempt(t *testing.T) {
+	c := &ClientConfig{signatureKey: testSignatureKey}
+	message := &structpb.Struct{}
+
+	first := capture(t, c, context.Background(), message)
+	second := capture(t, c, context.Background(), message)
+	if value(first, constants.XNonce) == value(second, constants.XNonce) {
+		t.Fatal("retried attempt reused the nonce")
+	}
+	if value(first, constants.Authorization) != "" {
+		t.Fatal("authorization sent without a token in context")
+	}
+}
+
+func TestSignUnaryRejectsNonProtoRequest(t *testing.T) {
+	c := &ClientConfig{signatureKey: testSignatureKey}
+	invoker := func(context.Context, string, any, any, *grpc.ClientConn, ...grpc.CallOption) error {
+		t.Fatal("invoker called for a non-proto request")
+		return nil
+	}
+
+	err := c.signUnary(context.Background(), testFullMethod, "not a message", nil, nil, invoker)
+	if err == nil {
+		t.Fatal("signUnary accepted a non-proto request")
+	}
+}
@@ -0,0 +1,240 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/anddriii/kita-futsal/order-service/common/metrics"
+	"github.com/anddriii/kita-futsal/order-service/common/signature"
+	"github.com/anddriii/kita-futsal/order-service/common/tracing"
+	configApp "github.com/anddriii/kita-futsal/order-service/config"
+	"github.com/anddriii/kita-futsal/order-service/constants"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type Request struct {
+	Method string
+	URL    string
+	Header map[string]string
+	Query  url.Values
+	Body   any
+	// Idempotent marks a non-GET call as safe to retry, e.g. a batch lookup sent as POST.
+	Idempotent bool
+}
+
+type Response struct {
+	StatusCode int
+	Body       []byte
+}
+
+func (r *Response) Decode(out any) error {
+	if len(r.Body) == 0 {
+		return nil
+	}
+	return json.Unmarshal(r.Body, out)
+}
+
+func (r *Request) retryable() bool {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return r.Idempotent
+}
+
+// Do sends the request through the downstream's circuit breaker. Transport
+// errors, timeouts and 5xx responses are retried for idempotent calls and end
+// in a *DownstreamError once attempts run out; any other response is returned
+// as is for the caller to interpret.
+func (c *ClientConfig) Do(ctx context.Context, request Request) (*Response, error) {
+	var payload []byte
+	if request.Body != nil {
+		var err error
+		payload, err = json.Marshal(request.Body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		resp       *Response
+		statusCode int
+	)
+	err := c.call(ctx, request.retryable(), func(ctx context.Context) (bool, error) {
+		var err error
+		resp, err = c.send(ctx, &request, payload)
+		if err != nil {
+			statusCode = 0
+			return true, err
+		}
+		if resp.StatusCode >= http.StatusInternalServerError {
+			statusCode = resp.StatusCode
+			return true, errors.New(http.StatusText(resp.StatusCode))
+		}
+		return false, nil
+	})
+	if err != nil {
+		var downstreamErr *DownstreamError
+		if errors.As(err, &downstreamErr) {
+			downstreamErr.StatusCode = statusCode
+		}
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// call sends through the breaker with retries and records the latency and
+// outcome of the whole call, retries included.
+func (c *ClientConfig) call(ctx context.Context, retryable bool, send func(context.Context) (bool, error)) error {
+	start := time.Now()
+	err := c.retry(ctx, retryable, send)
+	metrics.ObserveClientCall(c.name, time.Since(start), errorReason(err))
+	return err
+}
+
+// retry runs send through the downstream's circuit breaker, giving every attempt
+// its own deadline. send reports whether the downstream itself failed; only
+// those failures trip the breaker and are retried when retryable is set.
+func (c *ClientConfig) retry(ctx context.Context, retryable bool, send func(context.Context) (bool, error)) error {
+	attempts := 1
+	if retryable {
+		attempts += c.maxRetries
+	}
+
+	breaker := breakerFor(c.name)
+	downstreamErr := &DownstreamError{Service: c.name}
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 {
+			err := sleep(ctx, jitter(c.backoff, attempt))
+			if err != nil {
+				return err
+			}
+		}
+
+		if !breaker.Allow() {
+			downstreamErr.Err = ErrCircuitOpen
+			return downstreamErr
+		}
+
+		attemptCtx, cancel := context.WithTimeout(ctx, c.timeout)
+		failed, err := send(attemptCtx)
+		cancel()
+		if err != nil && ctx.Err() != nil {
+			// the caller gave up, which says nothing about the downstream
+			breaker.Cancel()
+			return ctx.Err()
+		}
+		if !failed {
+			breaker.Success()
+			return err
+		}
+
+		breaker.Failure()
+		downstreamErr.Err = err
+	}
+
+	return downstreamErr
+}
+
+// send makes one attempt inside its own client span, so retries show up side by
+// side in the trace.
+func (c *ClientConfig) send(ctx context.Context, request *Request, payload []byte) (*Response, error) {
+	ctx, span := tracing.Tracer().Start(ctx, fmt.Sprintf("%s %s", request.Method, c.name),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.HTTPRequestMethodKey.String(request.Method),
+			semconv.URLFull(request.URL),
+			semconv.PeerService(c.name),
+		),
+	)
+
+	resp, err := c.roundTrip(ctx, request, payload)
+	if err != nil {
+		tracing.End(span, err)
+		return nil, err
+	}
+	span.SetAttributes(semconv.HTTPResponseStatusCode(resp.StatusCode))
+	if resp.StatusCode >= http.StatusInternalServerError {
+		span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+	}
+	span.End()
+	return resp, nil
+}
+
+func (c *ClientConfig) roundTrip(ctx context.Context, request *Request, payload []byte) (*Response, error) {
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, request.Method, request.URL, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	for key, value := range request.Header {
+		req.Header.Set(key, value)
+	}
+	if len(request.Query) > 0 {
+		req.URL.RawQuery = request.Query.Encode()
+	}
+
+	// re-signed on every attempt since the receiver accepts each nonce only once
+	signed := signature.New(configApp.Config.AppName, req.Method, req.URL.RequestURI(), payload)
+	req.Header.Set(constants.XServiceName, signed.ServiceName)
+	req.Header.Set(constants.XRequestAt, signed.Timestamp)
+	req.Header.Set(constants.XNonce, signed.Nonce)
+	req.Header.Set(constants.XSignature, signed.Sign(c.signatureKey))
+	if requestID, ok := ctx.Value(constants.RequestID).(string); ok && requestID != "" {
+		req.Header.Set(constants.XRequestID, requestID)
+	}
+	tracing.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Response{StatusCode: resp.StatusCode, Body: respBody}, nil
+}
+
+// jitter returns a random delay in [0, base*2^attempt) ("full jitter") so
+// retrying callers do not hit a recovering downstream in lockstep.
+func jitter(base time.Duration, attempt int) time.Duration {
+	ceiling := base << attempt
+	if ceiling <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(ceiling)))
+}
+
+func sleep(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
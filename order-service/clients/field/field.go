@@ -2,17 +2,17 @@ package clients
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/http"
-	"time"
 
 	"github.com/anddriii/kita-futsal/order-service/clients/config"
-	"github.com/anddriii/kita-futsal/order-service/common/util"
-	configApp "github.com/anddriii/kita-futsal/order-service/config"
 	"github.com/anddriii/kita-futsal/order-service/constants"
+	errOrder "github.com/anddriii/kita-futsal/order-service/constants/error/order"
 	"github.com/anddriii/kita-futsal/order-service/domain/dto"
+	pb "github.com/anddriii/kita-futsal/order-service/proto/field"
 	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type FieldClient struct {
@@ -21,7 +21,8 @@ type FieldClient struct {
 
 type IFieldClient interface {
 	GetFieldByUUID(context.Context, uuid.UUID) (*FieldData, error)
-	UpdateStatus(request *dto.UpdateFieldScheduleStatusRequest) error
+	UpdateStatus(context.Context, *dto.UpdateFieldScheduleStatusRequest) error
+	GetVenuesByManager(context.Context, uuid.UUID) ([]VenueData, error)
 }
 
 func NewFieldClient(client config.IClientConfig) IFieldClient {
@@ -29,27 +30,24 @@ func NewFieldClient(client config.IClientConfig) IFieldClient {
 }
 
 func (f *FieldClient) GetFieldByUUID(ctx context.Context, uuid uuid.UUID) (*FieldData, error) {
-	unixTime := time.Now().Unix()
-	generateAPIKey := fmt.Sprintf("%s:%s:%d",
-		configApp.Config.AppName,
-		f.client.SignatureKey(),
-		unixTime,
-	)
-	apiKey := util.GenerateSHA256(generateAPIKey)
 	token := ctx.Value(constants.Token).(string)
 	bearerToken := fmt.Sprintf("Bearer %s", token)
 
+	resp, err := f.client.Do(ctx, config.Request{
+		Method: http.MethodGet,
+		URL:    fmt.Sprintf("%s/api/v1/field/schedule/%s", f.client.BaseURL(), uuid),
+		Header: map[string]string{
+			constants.Authorization: bearerToken,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	var response FieldResponse
-	request := f.client.Client().Clone().
-		Set(constants.Authorization, bearerToken).
-		Set(constants.XServiceName, configApp.Config.AppName).
-		Set(constants.XApiKey, apiKey).
-		Set(constants.XRequestAt, fmt.Sprintf("%d", unixTime)).
-		Get(fmt.Sprintf("%s/api/v1/field/schedule/%s", f.client.BaseURL(), uuid))
-
-	resp, _, errs := request.EndStruct(&response)
-	if len(errs) > 0 {
-		return nil, errs[0]
+	err = resp.Decode(&response)
+	if err != nil {
+		return nil, err
 	}
 
 	if resp.StatusCode != http.StatusOK {
@@ -59,46 +57,54 @@ func (f *FieldClient) GetFieldByUUID(ctx context.Context, uuid uuid.UUID) (*Fiel
 	return &response.Data, nil
 }
 
-func (f *FieldClient) UpdateStatus(request *dto.UpdateFieldScheduleStatusRequest) error {
-	unixTime := time.Now().Unix()
-	generateAPIKey := fmt.Sprintf("%s:%s:%d",
-		configApp.Config.AppName,
-		f.client.SignatureKey(),
-		unixTime,
-	)
-	apiKey := util.GenerateSHA256(generateAPIKey)
-
-	body, err := json.Marshal(request)
+func (f *FieldClient) UpdateStatus(ctx context.Context, request *dto.UpdateFieldScheduleStatusRequest) error {
+	conn, err := f.client.GrpcConn()
 	if err != nil {
 		return err
 	}
 
-	resp, bodyResp, errs := f.client.Client().Clone().
-		Patch(fmt.Sprintf("%s/api/v1/field/schedule/status", f.client.BaseURL())).
-		Set(constants.XServiceName, configApp.Config.AppName).
-		Set(constants.XApiKey, apiKey).
-		Set(constants.XRequestAt, fmt.Sprintf("%d", unixTime)).
-		Send(string(body)).
-		End()
-
-	if len(errs) > 0 {
-		return errs[0]
-	}
-
-	var response FieldResponse
-	if resp.StatusCode != http.StatusOK {
-		err = json.Unmarshal([]byte(bodyResp), &response)
-		if err != nil {
+	// setting the same status twice is harmless, so the call may be retried; holding is
+	// not, because a retried hold fails on the schedules the first attempt already held
+	err = f.client.Invoke(ctx, request.Status != constants.FieldScheduleHeld, func(ctx context.Context) error {
+		_, err := pb.NewFieldServiceClient(conn).UpdateFieldScheduleStatus(ctx, &pb.UpdateFieldScheduleStatusRequest{
+			FieldScheduleIds: request.FieldScheduleIDs,
+			Status:           request.Status,
+		})
+		return err
+	})
+	if err != nil {
+		st, ok := status.FromError(err)
+		if !ok {
 			return err
 		}
-		fieldError := fmt.Errorf("field response: %s", response.Message)
-		return fieldError
+		if st.Code() == codes.FailedPrecondition {
+			return errOrder.ErrFieldAlreadyBooked
+		}
+		return fmt.Errorf("field response: %s", st.Message())
 	}
 
-	err = json.Unmarshal([]byte(bodyResp), &response)
+	return nil
+}
+
+func (f *FieldClient) GetVenuesByManager(ctx context.Context, managerID uuid.UUID) ([]VenueData, error) {
+
+	resp, err := f.client.Do(ctx, config.Request{
+		Method: http.MethodGet,
+		URL:    fmt.Sprintf("%s/api/v1/venue/manager/%s", f.client.BaseURL(), managerID),
+	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	var response VenueResponse
+	err = resp.Decode(&response)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("field response: %s", response.Message)
+	}
+
+	return response.Data, nil
 }
@@ -15,12 +15,32 @@ type FieldResponse struct {
 
 type FieldData struct {
 	UUID         uuid.UUID  `json:"uuid"`
+	FieldUUID    uuid.UUID  `json:"fieldUUID"`
 	FieldName    string     `json:"fieldName"`
+	VenueUUID    *uuid.UUID `json:"venueUUID"`
 	PricePerHour float64    `json:"pricePerHour"`
 	Date         string     `json:"date"`
+	Time         string     `json:"time"`
 	StartTime    string     `json:"startTime"`
 	EndTime      string     `json:"endTime"`
 	Status       string     `json:"status"`
+	ClaimedBy    *uuid.UUID `json:"claimedBy"`
+	ClaimExpires *time.Time `json:"claimExpiresAt"`
 	CreatedAt    *time.Time `json:"createdAt"`
 	UpdatedAt    *time.Time `json:"updatedAt"`
 }
+
+type VenueResponse struct {
+	Code    int         `json:"code"`
+	Status  string      `json:"status"`
+	Message string      `json:"message"`
+	Data    []VenueData `json:"data"`
+}
+
+type VenueData struct {
+	UUID      uuid.UUID  `json:"uuid"`
+	Name      string     `json:"name"`
+	Address   string     `json:"address"`
+	City      string     `json:"city"`
+	ManagerID *uuid.UUID `json:"managerID"`
+}
@@ -2,17 +2,20 @@ package clients
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/anddriii/kita-futsal/order-service/clients/config"
 	"github.com/anddriii/kita-futsal/order-service/common/util"
-	configApp "github.com/anddriii/kita-futsal/order-service/config"
 	"github.com/anddriii/kita-futsal/order-service/constants"
+	errOrder "github.com/anddriii/kita-futsal/order-service/constants/error/order"
 	"github.com/anddriii/kita-futsal/order-service/domain/dto"
+	pb "github.com/anddriii/kita-futsal/order-service/proto/payment"
 	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type PaymentClient struct {
@@ -21,7 +24,9 @@ type PaymentClient struct {
 
 type IPaymentClient interface {
 	GetPaymentByUUID(context.Context, uuid.UUID) (*PaymentData, error)
+	GetPaymentsByUUIDs(context.Context, []uuid.UUID) ([]PaymentData, error)
 	CreatePaymentLink(context.Context, *dto.PaymentRequest) (*PaymentData, error)
+	ExpirePayment(context.Context, uuid.UUID) error
 }
 
 func NewPaymentClient(client config.IClientConfig) IPaymentClient {
@@ -29,27 +34,24 @@ func NewPaymentClient(client config.IClientConfig) IPaymentClient {
 }
 
 func (p *PaymentClient) GetPaymentByUUID(ctx context.Context, uuid uuid.UUID) (*PaymentData, error) {
-	unixTime := time.Now().Unix()
-	generateAPIKey := fmt.Sprintf("%s:%s:%d",
-		configApp.Config.AppName,
-		p.client.SignatureKey(),
-		unixTime,
-	)
-	apiKey := util.GenerateSHA256(generateAPIKey)
 	token := ctx.Value(constants.Token).(string)
 	bearerToken := fmt.Sprintf("Bearer %s", token)
 
-	var response PaymentResponse
-	request := p.client.Client().Clone().
-		Set(constants.Authorization, bearerToken).
-		Set(constants.XServiceName, configApp.Config.AppName).
-		Set(constants.XApiKey, apiKey).
-		Set(constants.XRequestAt, fmt.Sprintf("%d", unixTime)).
-		Get(fmt.Sprintf("%s/api/v1/payment/%s", p.client.BaseURL(), uuid))
+	resp, err := p.client.Do(ctx, config.Request{
+		Method: http.MethodGet,
+		URL:    fmt.Sprintf("%s/api/v1/payment/%s", p.client.BaseURL(), uuid),
+		Header: map[string]string{
+			constants.Authorization: bearerToken,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	resp, _, errs := request.EndStruct(&response)
-	if len(errs) > 0 {
-		return nil, errs[0]
+	var response PaymentResponse
+	err = resp.Decode(&response)
+	if err != nil {
+		return nil, err
 	}
 
 	if resp.StatusCode != http.StatusOK {
@@ -59,49 +61,161 @@ func (p *PaymentClient) GetPaymentByUUID(ctx context.Context, uuid uuid.UUID) (*
 	return &response.Data, nil
 }
 
-func (p *PaymentClient) CreatePaymentLink(ctx context.Context, req *dto.PaymentRequest) (*PaymentData, error) {
-	unixTime := time.Now().Unix()
-	generateAPIKey := fmt.Sprintf("%s:%s:%d",
-		configApp.Config.AppName,
-		p.client.SignatureKey(),
-		unixTime,
-	)
-	apiKey := util.GenerateSHA256(generateAPIKey)
-	token := ctx.Value(constants.Token).(string)
-	bearerToken := fmt.Sprintf("Bearer %s", token)
+// GetPaymentsByUUIDs resolves many payments with as few requests as possible;
+// unknown UUIDs are simply absent from the result.
+func (p *PaymentClient) GetPaymentsByUUIDs(ctx context.Context, uuids []uuid.UUID) ([]PaymentData, error) {
+	payments := make([]PaymentData, 0, len(uuids))
+	for _, chunk := range util.Chunk(uuids, constants.MaxBatchSize) {
+		result, err := p.getPaymentsByUUIDs(ctx, chunk)
+		if err != nil {
+			return nil, err
+		}
+		payments = append(payments, result...)
+	}
 
-	body, err := json.Marshal(req)
+	return payments, nil
+}
+
+func (p *PaymentClient) getPaymentsByUUIDs(ctx context.Context, uuids []uuid.UUID) ([]PaymentData, error) {
+	conn, err := p.client.GrpcConn()
 	if err != nil {
 		return nil, err
 	}
 
-	resp, bodyResp, errs := p.client.Client().Clone().
-		Post(fmt.Sprintf("%s/api/v1/payment", p.client.BaseURL())).
-		Set(constants.Authorization, bearerToken).
-		Set(constants.XServiceName, configApp.Config.AppName).
-		Set(constants.XApiKey, apiKey).
-		Set(constants.XRequestAt, fmt.Sprintf("%d", unixTime)).
-		Send(string(body)).
-		End()
+	request := &pb.GetPaymentsByUUIDsRequest{Uuids: make([]string, 0, len(uuids))}
+	for _, id := range uuids {
+		request.Uuids = append(request.Uuids, id.String())
+	}
 
-	if len(errs) > 0 {
-		return nil, errs[0]
+	var response *pb.GetPaymentsByUUIDsResponse
+	err = p.client.Invoke(ctx, true, func(ctx context.Context) error {
+		var err error
+		response, err = pb.NewPaymentServiceClient(conn).GetPaymentsByUUIDs(ctx, request)
+		return err
+	})
+	if err != nil {
+		st, ok := status.FromError(err)
+		if !ok {
+			return nil, err
+		}
+		return nil, fmt.Errorf("payment response: %s", st.Message())
 	}
 
-	var response PaymentResponse
-	if resp.StatusCode != http.StatusCreated {
-		err = json.Unmarshal([]byte(bodyResp), &response)
+	payments := make([]PaymentData, 0, len(response.GetPayments()))
+	for _, payment := range response.GetPayments() {
+		data, err := toPaymentData(payment)
 		if err != nil {
 			return nil, err
 		}
-		paymentError := fmt.Errorf("payment response: %s", response.Message)
-		return nil, paymentError
+		payments = append(payments, *data)
 	}
 
-	err = json.Unmarshal([]byte(bodyResp), &response)
+	return payments, nil
+}
+
+func (p *PaymentClient) CreatePaymentLink(ctx context.Context, req *dto.PaymentRequest) (*PaymentData, error) {
+	conn, err := p.client.GrpcConn()
 	if err != nil {
 		return nil, err
 	}
 
-	return &response.Data, nil
+	request := &pb.CreatePaymentLinkRequest{
+		OrderId:     req.OrderID.String(),
+		ExpiredAt:   timestamppb.New(req.ExpiredAt),
+		Amount:      req.Amount,
+		Description: req.Description,
+		CustomerDetail: &pb.CustomerDetail{
+			Name:  req.CustomerDetail.Name,
+			Email: req.CustomerDetail.Email,
+			Phone: req.CustomerDetail.Phone,
+		},
+		ItemDetails: make([]*pb.ItemDetail, 0, len(req.ItemDetails)),
+	}
+	if req.VenueID != nil {
+		request.VenueId = req.VenueID.String()
+	}
+	for _, item := range req.ItemDetails {
+		request.ItemDetails = append(request.ItemDetails, &pb.ItemDetail{
+			Id:       item.ID.String(),
+			Name:     item.Name,
+			Amount:   item.Amount,
+			Quantity: int32(item.Quantity),
+		})
+	}
+
+	// creating a payment link is not idempotent, so this call is never retried
+	var payment *pb.Payment
+	err = p.client.Invoke(ctx, false, func(ctx context.Context) error {
+		var err error
+		payment, err = pb.NewPaymentServiceClient(conn).CreatePaymentLink(ctx, request)
+		return err
+	})
+	if err != nil {
+		st, ok := status.FromError(err)
+		if !ok {
+			return nil, err
+		}
+		return nil, fmt.Errorf("payment response: %s", st.Message())
+	}
+
+	return toPaymentData(payment)
+}
+
+func toPaymentData(payment *pb.Payment) (*PaymentData, error) {
+	id, err := uuid.Parse(payment.GetUuid())
+	if err != nil {
+		return nil, err
+	}
+
+	data := &PaymentData{
+		UUID:        id,
+		OrderID:     payment.GetOrderId(),
+		Amount:      payment.GetAmount(),
+		Status:      payment.GetStatus(),
+		PaymentLink: payment.GetPaymentLink(),
+		ExpiredAt:   payment.GetExpiredAt().AsTime().Format(time.RFC3339),
+	}
+	if payment.GetDescription() != "" {
+		description := payment.GetDescription()
+		data.Description = &description
+	}
+	if payment.GetInvoiceLink() != "" {
+		invoiceLink := payment.GetInvoiceLink()
+		data.InvoiceLink = &invoiceLink
+	}
+
+	return data, nil
+}
+
+// ExpirePayment closes the payment link of an order so it can no longer be paid. An
+// order without a payment has nothing to close; a settled payment means the order
+// can no longer be cancelled.
+func (p *PaymentClient) ExpirePayment(ctx context.Context, orderID uuid.UUID) error {
+	conn, err := p.client.GrpcConn()
+	if err != nil {
+		return err
+	}
+
+	// expiring an already expired payment returns it unchanged, so the call may be retried
+	err = p.client.Invoke(ctx, true, func(ctx context.Context) error {
+		_, err := pb.NewPaymentServiceClient(conn).ExpirePayment(ctx, &pb.ExpirePaymentRequest{
+			OrderId: orderID.String(),
+		})
+		return err
+	})
+	if err != nil {
+		st, ok := status.FromError(err)
+		if !ok {
+			return err
+		}
+		switch st.Code() {
+		case codes.NotFound:
+			return nil
+		case codes.FailedPrecondition:
+			return errOrder.ErrOrderNotCancelable
+		}
+		return fmt.Errorf("payment response: %s", st.Message())
+	}
+
+	return nil
 }
@@ -14,6 +14,7 @@ type IClientRegistry interface {
 	GetUser() userClient.IUserClient
 	GetPayment() paymentClient.IPaymentClient
 	GetField() fieldClient.IFieldClient
+	Downstreams() []config.IClientConfig
 }
 
 func NewClientRegistry() IClientRegistry {
@@ -21,25 +22,45 @@ func NewClientRegistry() IClientRegistry {
 }
 
 func (c *ClientRegistry) GetUser() userClient.IUserClient {
-	return userClient.NewUserClient(
-		config.NewClientConfig(
-			config.WithBaseURL(configApp.Config.InternalService.User.Host),
-			config.WithSignatureKey(configApp.Config.InternalService.User.SignatureKey),
-		))
+	return userClient.NewUserClient(userConfig())
 }
 
 func (c *ClientRegistry) GetPayment() paymentClient.IPaymentClient {
-	return paymentClient.NewPaymentClient(
-		config.NewClientConfig(
-			config.WithBaseURL(configApp.Config.InternalService.Payment.Host),
-			config.WithSignatureKey(configApp.Config.InternalService.Payment.SignatureKey),
-		))
+	return paymentClient.NewPaymentClient(paymentConfig())
 }
 
 func (c *ClientRegistry) GetField() fieldClient.IFieldClient {
-	return fieldClient.NewFieldClient(
-		config.NewClientConfig(
-			config.WithBaseURL(configApp.Config.InternalService.Field.Host),
-			config.WithSignatureKey(configApp.Config.InternalService.Field.SignatureKey),
-		))
+	return fieldClient.NewFieldClient(fieldConfig())
+}
+
+// Downstreams lists every service this one calls, for the readiness checks.
+func (c *ClientRegistry) Downstreams() []config.IClientConfig {
+	return []config.IClientConfig{userConfig(), fieldConfig(), paymentConfig()}
+}
+
+func userConfig() config.IClientConfig {
+	return config.NewClientConfig(
+		config.WithName("user-service"),
+		config.WithBaseURL(configApp.Config.InternalService.User.Host),
+		config.WithGrpcTarget(configApp.Config.InternalService.User.GrpcHost),
+		config.WithSignatureKey(configApp.Config.InternalService.User.SignatureKey),
+	)
+}
+
+func fieldConfig() config.IClientConfig {
+	return config.NewClientConfig(
+		config.WithName("field-service"),
+		config.WithBaseURL(configApp.Config.InternalService.Field.Host),
+		config.WithGrpcTarget(configApp.Config.InternalService.Field.GrpcHost),
+		config.WithSignatureKey(configApp.Config.InternalService.Field.SignatureKey),
+	)
+}
+
+func paymentConfig() config.IClientConfig {
+	return config.NewClientConfig(
+		config.WithName("payment-service"),
+		config.WithBaseURL(configApp.Config.InternalService.Payment.Host),
+		config.WithGrpcTarget(configApp.Config.InternalService.Payment.GrpcHost),
+		config.WithSignatureKey(configApp.Config.InternalService.Payment.SignatureKey),
+	)
 }
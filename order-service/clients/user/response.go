@@ -9,6 +9,13 @@ type UserResponse struct {
 	Data    UserData `json:"data"`
 }
 
+type UsersResponse struct {
+	Code    int        `json:"code"`
+	Status  string     `json:"status"`
+	Message string     `json:"message"`
+	Data    []UserData `json:"data"`
+}
+
 type UserData struct {
 	UUID        uuid.UUID `json:"uuid"`
 	Name        string    `json:"name"`
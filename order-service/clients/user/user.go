@@ -3,14 +3,13 @@ package clients
 import (
 	"context"
 	"fmt"
-	"net/http"
-	"time"
 
 	"github.com/anddriii/kita-futsal/order-service/clients/config"
 	"github.com/anddriii/kita-futsal/order-service/common/util"
-	config2 "github.com/anddriii/kita-futsal/order-service/config"
 	"github.com/anddriii/kita-futsal/order-service/constants"
+	pb "github.com/anddriii/kita-futsal/order-service/proto/user"
 	"github.com/google/uuid"
+	"google.golang.org/grpc/status"
 )
 
 type UserClient struct {
@@ -20,6 +19,7 @@ type UserClient struct {
 type IUserClient interface {
 	GetUserByToken(context.Context) (*UserData, error)
 	GetUserByUUID(context.Context, uuid.UUID) (*UserData, error)
+	GetUsersByUUIDs(context.Context, []uuid.UUID) ([]UserData, error)
 }
 
 func NewUserClient(client config.IClientConfig) IUserClient {
@@ -27,63 +27,113 @@ func NewUserClient(client config.IClientConfig) IUserClient {
 }
 
 func (u *UserClient) GetUserByToken(ctx context.Context) (*UserData, error) {
-	unixTime := time.Now().Unix()
-	generateAPIKey := fmt.Sprintf("%s:%s:%d",
-		config2.Config.AppName,
-		u.client.SignatureKey(),
-		unixTime,
-	)
-	apiKey := util.GenerateSHA256(generateAPIKey)
-	token := ctx.Value(constants.Token).(string)
-	bearerToken := fmt.Sprintf("Bearer %s", token)
-
-	var response UserResponse
-	request := u.client.Client().Clone().
-		Set(constants.Authorization, bearerToken).
-		Set(constants.XServiceName, config2.Config.AppName).
-		Set(constants.XApiKey, apiKey).
-		Set(constants.XRequestAt, fmt.Sprintf("%d", unixTime)).
-		Get(fmt.Sprintf("%s/api/v1/auth/user", u.client.BaseURL()))
-
-	resp, _, errs := request.EndStruct(&response)
-	if len(errs) > 0 {
-		return nil, errs[0]
+	conn, err := u.client.GrpcConn()
+	if err != nil {
+		return nil, err
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("user response: %s", response.Message)
+	var user *pb.User
+	err = u.client.Invoke(ctx, true, func(ctx context.Context) error {
+		var err error
+		user, err = pb.NewUserServiceClient(conn).GetUserByToken(ctx, &pb.GetUserByTokenRequest{})
+		return err
+	})
+	if err != nil {
+		return nil, toError(err)
 	}
 
-	return &response.Data, nil
+	return toUserData(user)
 }
 
 func (u *UserClient) GetUserByUUID(ctx context.Context, uuid uuid.UUID) (*UserData, error) {
-	unixTime := time.Now().Unix()
-	generateAPIKey := fmt.Sprintf("%s:%s:%d",
-		config2.Config.AppName,
-		u.client.SignatureKey(),
-		unixTime,
-	)
-	apiKey := util.GenerateSHA256(generateAPIKey)
-	token := ctx.Value(constants.Token).(string)
-	bearerToken := fmt.Sprintf("Bearer %s", token)
-
-	var response UserResponse
-	request := u.client.Client().Clone().
-		Set(constants.Authorization, bearerToken).
-		Set(constants.XServiceName, config2.Config.AppName).
-		Set(constants.XApiKey, apiKey).
-		Set(constants.XRequestAt, fmt.Sprintf("%d", unixTime)).
-		Get(fmt.Sprintf("%s/api/v1/auth/%s", u.client.BaseURL(), uuid))
-
-	resp, _, errs := request.EndStruct(&response)
-	if len(errs) > 0 {
-		return nil, errs[0]
+	conn, err := u.client.GrpcConn()
+	if err != nil {
+		return nil, err
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("user response: %s", response.Message)
+	var user *pb.User
+	err = u.client.Invoke(ctx, true, func(ctx context.Context) error {
+		var err error
+		user, err = pb.NewUserServiceClient(conn).GetUserByUUID(ctx, &pb.GetUserByUUIDRequest{Uuid: uuid.String()})
+		return err
+	})
+	if err != nil {
+		return nil, toError(err)
 	}
 
-	return &response.Data, nil
+	return toUserData(user)
+}
+
+// GetUsersByUUIDs resolves many users with as few requests as possible; unknown
+// UUIDs are simply absent from the result.
+func (u *UserClient) GetUsersByUUIDs(ctx context.Context, uuids []uuid.UUID) ([]UserData, error) {
+	users := make([]UserData, 0, len(uuids))
+	for _, chunk := range util.Chunk(uuids, constants.MaxBatchSize) {
+		result, err := u.getUsersByUUIDs(ctx, chunk)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, result...)
+	}
+
+	return users, nil
+}
+
+func (u *UserClient) getUsersByUUIDs(ctx context.Context, uuids []uuid.UUID) ([]UserData, error) {
+	conn, err := u.client.GrpcConn()
+	if err != nil {
+		return nil, err
+	}
+
+	request := &pb.GetUsersByUUIDsRequest{Uuids: make([]string, 0, len(uuids))}
+	for _, id := range uuids {
+		request.Uuids = append(request.Uuids, id.String())
+	}
+
+	var response *pb.GetUsersByUUIDsResponse
+	err = u.client.Invoke(ctx, true, func(ctx context.Context) error {
+		var err error
+		response, err = pb.NewUserServiceClient(conn).GetUsersByUUIDs(ctx, request)
+		return err
+	})
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	users := make([]UserData, 0, len(response.GetUsers()))
+	for _, user := range response.GetUsers() {
+		data, err := toUserData(user)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, *data)
+	}
+
+	return users, nil
+}
+
+func toUserData(user *pb.User) (*UserData, error) {
+	id, err := uuid.Parse(user.GetUuid())
+	if err != nil {
+		return nil, err
+	}
+
+	return &UserData{
+		UUID:        id,
+		Name:        user.GetName(),
+		Username:    user.GetUsername(),
+		Email:       user.GetEmail(),
+		Role:        user.GetRole(),
+		PhoneNumber: user.GetPhoneNumber(),
+	}, nil
+}
+
+// toError keeps breaker and transport errors as they are and turns any other
+// gRPC status into the same kind of error the HTTP clients return.
+func toError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	return fmt.Errorf("user response: %s", st.Message())
 }
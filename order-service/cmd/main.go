@@ -5,19 +5,22 @@ import (
 	"fmt"
 	"net/http"
 	"os"
-	"os/signal"
-	"syscall"
 	"time"
 
 	"github.com/IBM/sarama"
 	"github.com/anddriii/kita-futsal/order-service/clients"
+	"github.com/anddriii/kita-futsal/order-service/common/health"
+	"github.com/anddriii/kita-futsal/order-service/common/lifecycle"
+	"github.com/anddriii/kita-futsal/order-service/common/logger"
+	"github.com/anddriii/kita-futsal/order-service/common/metrics"
 	"github.com/anddriii/kita-futsal/order-service/common/response"
+	"github.com/anddriii/kita-futsal/order-service/common/signature"
+	"github.com/anddriii/kita-futsal/order-service/common/tracing"
 	"github.com/anddriii/kita-futsal/order-service/config"
 	"github.com/anddriii/kita-futsal/order-service/constants"
 	controllers "github.com/anddriii/kita-futsal/order-service/controllers/http"
 	kafka2 "github.com/anddriii/kita-futsal/order-service/controllers/kafka"
 	kafka "github.com/anddriii/kita-futsal/order-service/controllers/kafka/config"
-	"github.com/anddriii/kita-futsal/order-service/domain/models"
 	"github.com/anddriii/kita-futsal/order-service/middlewares"
 	"github.com/anddriii/kita-futsal/order-service/repositories"
 	"github.com/anddriii/kita-futsal/order-service/routes"
@@ -29,15 +32,41 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// shutdownTimeout bounds how long in-flight requests, the Kafka message being
+// handled and a running job get to finish after SIGTERM.
+const shutdownTimeout = 30 * time.Second
+
+// probeTimeout bounds each dependency check of the readiness endpoint.
+const probeTimeout = 2 * time.Second
+
 var command = &cobra.Command{
 	Use:   "serve",
 	Short: "Start the server",
 	Run: func(c *cobra.Command, args []string) {
 		config.Init()
+		logger.Init(config.Config.AppName)
+		shutdownTracing, err := tracing.Init(
+			config.Config.AppName,
+			config.Config.Tracing.Exporter,
+			config.Config.Tracing.Endpoint,
+			config.Config.Tracing.Insecure,
+		)
+		if err != nil {
+			panic(err)
+		}
+
 		db, err := config.InitDatabase()
 		if err != nil {
 			panic(err)
 		}
+		err = db.Use(metrics.GormPlugin{})
+		if err != nil {
+			panic(err)
+		}
+		err = db.Use(tracing.GormPlugin{})
+		if err != nil {
+			panic(err)
+		}
 
 		loc, err := time.LoadLocation("Asia/Jakarta")
 		if err != nil {
@@ -45,19 +74,58 @@ var command = &cobra.Command{
 		}
 		time.Local = loc
 
-		err = db.AutoMigrate(
-			&models.Order{},
-			&models.OrderHistory{},
-			&models.OrderField{},
-		)
+		// replicas starting together wait on an advisory lock, so each migration runs once
+		err = runMigrations(context.Background(), loadMigrator(db))
+		if err != nil {
+			panic(err)
+		}
+
+		sqlDB, err := db.DB()
+		if err != nil {
+			panic(err)
+		}
+		rdb := config.NewRedisClient()
+
+		middlewares.SetNonceStore(signature.NewRedisNonceStore(rdb))
 
 		client := clients.NewClientRegistry()
 		repository := repositories.NewRepositoryRegistry(db)
-		service := services.NewServiceRegistry(repository, client)
+		producer := kafka2.NewKafkaProducer(config.Config.Kafka.Brokers)
+		service := services.NewServiceRegistry(repository, client, producer)
 		controller := controllers.NewControllerRegistry(service)
 
-		serveHttp(controller, client)
-		serveKafkaConsumer(service)
+		checker := health.NewChecker(probeTimeout,
+			health.Check{Name: "postgres", Probe: sqlDB.PingContext},
+			health.Check{Name: "redis", Probe: func(ctx context.Context) error {
+				return rdb.Ping(ctx).Err()
+			}},
+			health.Check{Name: "kafka", Probe: func(ctx context.Context) error {
+				return kafka2.Ping(ctx, config.Config.Kafka.Brokers)
+			}},
+		)
+		for _, downstream := range client.Downstreams() {
+			checker.Add(health.Check{Name: downstream.Name(), Optional: true, Probe: downstream.Ping})
+		}
+
+		app := lifecycle.New(shutdownTimeout)
+		app.AddCloser("tracer", shutdownTracing)
+		app.AddCloser("database", sqlDB.Close)
+		app.AddCloser("redis", rdb.Close)
+		app.Add(lifecycle.Ticker("notification worker", time.Minute, func(ctx context.Context) {
+			err := service.GetNotification().SendDue(ctx)
+			if err != nil {
+				logrus.Errorf("failed to send due notifications: %v", err)
+			}
+		}))
+		app.Add(kafkaConsumer(service))
+		app.Add(lifecycle.HTTPServer(httpServer(controller, client, checker)))
+
+		err = app.Run()
+		if err != nil {
+			logrus.Errorf("shutdown: %v", err)
+			os.Exit(1)
+		}
+		logrus.Info("server stopped")
 	},
 }
 
@@ -67,8 +135,12 @@ func Run() {
 	}
 }
 
-func serveHttp(controller controllers.IControllerRegistry, client clients.IClientRegistry) {
-	router := gin.Default()
+func httpServer(controller controllers.IControllerRegistry, client clients.IClientRegistry, checker *health.Checker) *http.Server {
+	router := gin.New()
+	router.Use(middlewares.RequestID())
+	router.Use(tracing.Middleware())
+	router.Use(middlewares.Logger())
+	router.Use(middlewares.Metrics())
 	router.Use(middlewares.HandlePanic())
 	router.NoRoute(func(c *gin.Context) {
 		c.JSON(http.StatusNotFound, response.Response{
@@ -82,10 +154,14 @@ func serveHttp(controller controllers.IControllerRegistry, client clients.IClien
 			Message: "Welcome to Order Service",
 		})
 	})
+	router.GET("/healthz", health.Liveness)
+	router.GET("/readyz", checker.Readiness)
+	router.GET("/metrics", gin.WrapH(metrics.Handler()))
 	router.Use(func(c *gin.Context) {
 		c.Writer.Header().Set("Access-Control-Allow-Origin", "*")
 		c.Writer.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, PATCH")
-		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, x-service-name, x-request-at, x-api-key")
+		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, x-service-name, x-request-at, x-nonce, x-signature, x-request-id, traceparent, tracestate, baggage")
+		c.Writer.Header().Set("Access-Control-Expose-Headers", "X-Request-ID")
 		if c.Request.Method == "OPTIONS" {
 			c.AbortWithStatus(204)
 			return
@@ -104,13 +180,16 @@ func serveHttp(controller controllers.IControllerRegistry, client clients.IClien
 	route := routes.NewRouteRegistry(group, controller, client)
 	route.Serve()
 
-	go func() {
-		port := fmt.Sprintf(":%d", config.Config.Port)
-		router.Run(port)
-	}()
+	return &http.Server{
+		Addr:    fmt.Sprintf(":%d", config.Config.Port),
+		Handler: router,
+	}
 }
 
-func serveKafkaConsumer(service services.IServiceRegistry) {
+// kafkaConsumer consumes until stopped. Stopping cancels the session, which
+// lets the message being handled finish and commits its offset before the
+// group leaves.
+func kafkaConsumer(service services.IServiceRegistry) lifecycle.Component {
 	kafkaConsumerConfig := sarama.NewConfig()
 	kafkaConsumerConfig.Consumer.MaxWaitTime = time.Duration(config.Config.Kafka.MaxWaitTimeInMs) * time.Millisecond
 	kafkaConsumerConfig.Consumer.MaxProcessingTime = time.Duration(config.Config.Kafka.MaxProcessingTimeInMs) * time.Millisecond
@@ -122,41 +201,42 @@ func serveKafkaConsumer(service services.IServiceRegistry) {
 		sarama.NewBalanceStrategyRoundRobin(),
 	}
 
-	brokers := config.Config.Kafka.Brokers
-	groupID := config.Config.Kafka.GroupID
-	topics := config.Config.Kafka.Topics
-	consumerGroup, err := sarama.NewConsumerGroup(brokers, groupID, kafkaConsumerConfig)
-	if err != nil {
-		logrus.Errorf("failed to create consumer group: %v", err)
-		return
-	}
-	defer consumerGroup.Close()
-
 	consumer := kafka.NewConsumerGroup()
 	kafkaRegistry := kafka2.NewKafkaRegistry(service)
 	kafkaConsumer := kafka.NewKafkaConsumer(consumer, kafkaRegistry)
 	kafkaConsumer.Register()
 
-	signals := make(chan os.Signal, 1)
-	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
 	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+	done := make(chan struct{})
+
+	return lifecycle.Component{
+		Name: "kafka consumer",
+		Start: func() error {
+			defer close(done)
 
-	go func() {
-		for {
-			err = consumerGroup.Consume(ctx, topics, consumer)
+			brokers := config.Config.Kafka.Brokers
+			groupID := config.Config.Kafka.GroupID
+			topics := config.Config.Kafka.Topics
+			consumerGroup, err := sarama.NewConsumerGroup(brokers, groupID, kafkaConsumerConfig)
 			if err != nil {
-				logrus.Errorf("failed to consume: %v", err)
-				panic(err)
+				return fmt.Errorf("failed to create consumer group: %w", err)
 			}
-
-			if ctx.Err() != nil {
-				return
+			defer consumerGroup.Close()
+
+			for {
+				// Consume returns on every rebalance, so it is called again until stopped
+				err = consumerGroup.Consume(ctx, topics, consumer)
+				if ctx.Err() != nil {
+					return nil
+				}
+				if err != nil {
+					return fmt.Errorf("failed to consume: %w", err)
+				}
 			}
-		}
-	}()
-
-	logrus.Infof("kafka consumer started")
-	<-signals
-	logrus.Infof("kafka consumer stopped")
+		},
+		Stop: func(stopCtx context.Context) error {
+			cancel()
+			return lifecycle.Wait(stopCtx, done)
+		},
+	}
 }
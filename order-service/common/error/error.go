@@ -13,7 +13,11 @@ type ValidationResponse struct {
 	Message string `json:"message,omitempty"`
 }
 
-var ErrValidator = map[string]string{}
+var ErrValidator = map[string]string{
+	"uuid":     "%s must be a valid UUID",
+	"datetime": "%s must match the format %s",
+	"gte":      "%s must be greater than or equal to %s",
+}
 
 func ErrValidationResponse(err error) (validationResponse []ValidationResponse) {
 	var fieldErrors validator.ValidationErrors
@@ -0,0 +1,8 @@
+package event
+
+import "context"
+
+// IProducer is implemented by the Kafka producer in controllers/kafka.
+type IProducer interface {
+	ProduceMessage(ctx context.Context, topic string, data []byte) error
+}
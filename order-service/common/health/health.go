@@ -0,0 +1,130 @@
+package health
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/anddriii/kita-futsal/order-service/common/response"
+	"github.com/anddriii/kita-futsal/order-service/constants"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	StatusUp       = "up"
+	StatusDegraded = "degraded"
+	StatusDown     = "down"
+)
+
+// Check is a single dependency probed by the readiness endpoint.
+type Check struct {
+	Name string
+	// Optional checks are reported but do not make the service unready, so an
+	// outage downstream does not take this service out of rotation with it.
+	Optional bool
+	Probe    func(ctx context.Context) error
+}
+
+type Dependency struct {
+	Status   string `json:"status"`
+	Latency  string `json:"latency"`
+	Optional bool   `json:"optional,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+type Report struct {
+	Status       string                `json:"status"`
+	Dependencies map[string]Dependency `json:"dependencies"`
+}
+
+type Checker struct {
+	timeout time.Duration
+	checks  []Check
+}
+
+// NewChecker bounds every probe by timeout so one hanging dependency cannot
+// outlast the probe deadline of the orchestrator.
+func NewChecker(timeout time.Duration, checks ...Check) *Checker {
+	return &Checker{timeout: timeout, checks: checks}
+}
+
+func (c *Checker) Add(check Check) {
+	c.checks = append(c.checks, check)
+}
+
+// Run probes every dependency concurrently. The report is down when a required
+// dependency is down and degraded when only optional ones are.
+func (c *Checker) Run(ctx context.Context) Report {
+	dependencies := make([]Dependency, len(c.checks))
+	var wg sync.WaitGroup
+	for i, check := range c.checks {
+		wg.Add(1)
+		go func(i int, check Check) {
+			defer wg.Done()
+			dependencies[i] = c.probe(ctx, check)
+		}(i, check)
+	}
+	wg.Wait()
+
+	report := Report{Status: StatusUp, Dependencies: make(map[string]Dependency, len(c.checks))}
+	for i, check := range c.checks {
+		dependency := dependencies[i]
+		report.Dependencies[check.Name] = dependency
+		if dependency.Status == StatusUp {
+			continue
+		}
+		if !check.Optional {
+			report.Status = StatusDown
+		} else if report.Status == StatusUp {
+			report.Status = StatusDegraded
+		}
+	}
+	return report
+}
+
+func (c *Checker) probe(ctx context.Context, check Check) Dependency {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := check.Probe(ctx)
+	dependency := Dependency{
+		Status:   StatusUp,
+		Latency:  time.Since(start).String(),
+		Optional: check.Optional,
+	}
+	if err != nil {
+		dependency.Status = StatusDown
+		dependency.Error = err.Error()
+	}
+	return dependency
+}
+
+// Liveness only tells that the process still serves HTTP. It checks no
+// dependency, so a database outage does not get every pod restarted.
+func Liveness(c *gin.Context) {
+	c.JSON(http.StatusOK, response.Response{
+		Status:  constants.Success,
+		Message: http.StatusText(http.StatusOK),
+	})
+}
+
+// Readiness answers 503 while a required dependency is down so the pod is
+// taken out of the load balancer until it recovers.
+func (c *Checker) Readiness(ctx *gin.Context) {
+	report := c.Run(ctx.Request.Context())
+
+	code := http.StatusOK
+	status := constants.Success
+	if report.Status == StatusDown {
+		code = http.StatusServiceUnavailable
+		status = constants.Error
+	}
+
+	ctx.JSON(code, response.Response{
+		Status:  status,
+		Message: http.StatusText(code),
+		Data:    report,
+	})
+}
@@ -0,0 +1,64 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// HTTPServer serves until stopped, then stops accepting connections and waits
+// for in-flight requests to finish.
+func HTTPServer(server *http.Server) Component {
+	return Component{
+		Name: "http server",
+		Start: func() error {
+			err := server.ListenAndServe()
+			if errors.Is(err, http.ErrServerClosed) {
+				return nil
+			}
+			return err
+		},
+		Stop: server.Shutdown,
+	}
+}
+
+// Ticker calls run every interval until stopped. A run in progress when the
+// service shuts down is allowed to finish; its context is never cancelled.
+func Ticker(name string, interval time.Duration, run func(ctx context.Context)) Component {
+	quit := make(chan struct{})
+	done := make(chan struct{})
+
+	return Component{
+		Name: name,
+		Start: func() error {
+			defer close(done)
+
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-quit:
+					return nil
+				case <-ticker.C:
+					run(context.Background())
+				}
+			}
+		},
+		Stop: func(ctx context.Context) error {
+			close(quit)
+			return Wait(ctx, done)
+		},
+	}
+}
+
+// Wait blocks until done is closed or ctx is done.
+func Wait(ctx context.Context, done <-chan struct{}) error {
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,125 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Component is a long-running part of the service such as a server, a
+// consumer or a background job.
+type Component struct {
+	Name string
+	// Start blocks until the component has stopped. An error returned before
+	// shutdown has begun stops the whole service.
+	Start func() error
+	// Stop asks the component to finish the work in hand and return from
+	// Start. It must give up once ctx is done.
+	Stop func(ctx context.Context) error
+}
+
+type closer struct {
+	name  string
+	close func() error
+}
+
+// Manager starts components, waits for SIGINT or SIGTERM, and shuts them down
+// in reverse order of registration before closing shared resources.
+type Manager struct {
+	timeout    time.Duration
+	components []Component
+	closers    []closer
+}
+
+// New returns a manager that gives shutdown at most timeout to complete.
+func New(timeout time.Duration) *Manager {
+	return &Manager{timeout: timeout}
+}
+
+// Add registers a component. Register the ones that feed work to others last
+// (the HTTP server after the jobs it triggers) so they are stopped first.
+func (m *Manager) Add(component Component) {
+	m.components = append(m.components, component)
+}
+
+// AddCloser registers a resource such as a database or Redis connection. It is
+// closed after every component has stopped, in reverse order of registration.
+func (m *Manager) AddCloser(name string, close func() error) {
+	m.closers = append(m.closers, closer{name: name, close: close})
+}
+
+// Run starts every component and blocks until a shutdown signal arrives or a
+// component fails, then shuts everything down.
+func (m *Manager) Run() error {
+	signals, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	var wg sync.WaitGroup
+	failures := make(chan error, len(m.components))
+	for _, component := range m.components {
+		wg.Add(1)
+		go func(component Component) {
+			defer wg.Done()
+			logrus.Infof("starting %s", component.Name)
+			err := component.Start()
+			if err != nil {
+				failures <- fmt.Errorf("%s: %w", component.Name, err)
+			}
+		}(component)
+	}
+
+	var runErr error
+	select {
+	case <-signals.Done():
+		logrus.Info("shutdown signal received")
+	case runErr = <-failures:
+		logrus.Errorf("%v, shutting down", runErr)
+	}
+
+	return errors.Join(runErr, m.shutdown(&wg))
+}
+
+func (m *Manager) shutdown(wg *sync.WaitGroup) error {
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+
+	var errs []error
+	for i := len(m.components) - 1; i >= 0; i-- {
+		component := m.components[i]
+		logrus.Infof("stopping %s", component.Name)
+		err := component.Stop(ctx)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("stop %s: %w", component.Name, err))
+		}
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		errs = append(errs, fmt.Errorf("components still running after %s", m.timeout))
+	}
+
+	// resources are closed even after a timeout so connections are not leaked
+	for i := len(m.closers) - 1; i >= 0; i-- {
+		closer := m.closers[i]
+		logrus.Infof("closing %s", closer.name)
+		err := closer.close()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("close %s: %w", closer.name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,60 @@
+package logger
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/anddriii/kita-futsal/order-service/constants"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Init switches the standard logrus logger to JSON, stamps every entry with the
+// service name and scrubs secrets before the entry is written.
+func Init(serviceName string) {
+	logrus.SetFormatter(&logrus.JSONFormatter{TimestampFormat: time.RFC3339Nano})
+	logrus.AddHook(serviceHook{name: serviceName})
+	logrus.AddHook(redactHook{})
+}
+
+// FromContext returns an entry carrying the request ID, the logged in user and
+// the trace ID found in ctx, so every line of one request can be found together.
+func FromContext(ctx context.Context) *logrus.Entry {
+	fields := logrus.Fields{}
+	if requestID, ok := ctx.Value(constants.RequestID).(string); ok && requestID != "" {
+		fields["request_id"] = requestID
+	}
+	if userUUID, ok := ctx.Value(constants.UserUUID).(string); ok && userUUID != "" {
+		fields["user_uuid"] = userUUID
+	}
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.HasTraceID() {
+		fields["trace_id"] = spanContext.TraceID().String()
+	}
+	return logrus.WithContext(ctx).WithFields(fields)
+}
+
+type serviceHook struct {
+	name string
+}
+
+func (serviceHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (h serviceHook) Fire(entry *logrus.Entry) error {
+	entry.Data["service"] = h.name
+	return nil
+}
+
+var requestIDPattern = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+// RequestID returns received when it is usable as a request ID and a new one
+// otherwise, so a caller cannot inject arbitrary text into the logs.
+func RequestID(received string) string {
+	if requestIDPattern.MatchString(received) {
+		return received
+	}
+	return uuid.NewString()
+}
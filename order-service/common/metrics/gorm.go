@@ -0,0 +1,67 @@
+package metrics
+
+import (
+	"errors"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"gorm.io/gorm"
+)
+
+const queryStartKey = "metrics:query_start"
+
+var gormQueryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "gorm_query_duration_seconds",
+	Help:    "Duration of database queries run through GORM by operation, table and status.",
+	Buckets: []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5},
+}, []string{"operation", "table", "status"})
+
+// GormPlugin times every statement GORM executes. Register it with db.Use.
+type GormPlugin struct{}
+
+func (GormPlugin) Name() string {
+	return "metrics"
+}
+
+func (GormPlugin) Initialize(db *gorm.DB) error {
+	callback := db.Callback()
+	return errors.Join(
+		callback.Create().Before("gorm:create").Register("metrics:before_create", startQuery),
+		callback.Create().After("gorm:create").Register("metrics:after_create", observeQuery("create")),
+		callback.Query().Before("gorm:query").Register("metrics:before_query", startQuery),
+		callback.Query().After("gorm:query").Register("metrics:after_query", observeQuery("query")),
+		callback.Update().Before("gorm:update").Register("metrics:before_update", startQuery),
+		callback.Update().After("gorm:update").Register("metrics:after_update", observeQuery("update")),
+		callback.Delete().Before("gorm:delete").Register("metrics:before_delete", startQuery),
+		callback.Delete().After("gorm:delete").Register("metrics:after_delete", observeQuery("delete")),
+		callback.Row().Before("gorm:row").Register("metrics:before_row", startQuery),
+		callback.Row().After("gorm:row").Register("metrics:after_row", observeQuery("row")),
+		callback.Raw().Before("gorm:raw").Register("metrics:before_raw", startQuery),
+		callback.Raw().After("gorm:raw").Register("metrics:after_raw", observeQuery("raw")),
+	)
+}
+
+func startQuery(db *gorm.DB) {
+	db.InstanceSet(queryStartKey, time.Now())
+}
+
+func observeQuery(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		value, ok := db.InstanceGet(queryStartKey)
+		if !ok {
+			return
+		}
+		start, ok := value.(time.Time)
+		if !ok {
+			return
+		}
+
+		// a lookup that finds nothing is an answer, not a failing query
+		status := "ok"
+		if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+			status = "error"
+		}
+		gormQueryDuration.WithLabelValues(operation, db.Statement.Table, status).Observe(time.Since(start).Seconds())
+	}
+}
@@ -0,0 +1,44 @@
+package metrics
+
+import (
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	kafkaMessagesProduced = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "kafka_messages_produced_total",
+		Help: "Messages produced to Kafka by topic and status.",
+	}, []string{"topic", "status"})
+
+	kafkaMessagesConsumed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "kafka_messages_consumed_total",
+		Help: "Messages consumed from Kafka by topic and handling status.",
+	}, []string{"topic", "status"})
+
+	kafkaConsumerLag = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "kafka_consumer_lag",
+		Help: "Messages left behind the latest received one, by topic and partition.",
+	}, []string{"topic", "partition"})
+)
+
+func ObserveKafkaProduce(topic string, err error) {
+	kafkaMessagesProduced.WithLabelValues(topic, status(err)).Inc()
+}
+
+func ObserveKafkaConsume(topic string, err error) {
+	kafkaMessagesConsumed.WithLabelValues(topic, status(err)).Inc()
+}
+
+func SetKafkaConsumerLag(topic string, partition int32, lag int64) {
+	kafkaConsumerLag.WithLabelValues(topic, strconv.Itoa(int(partition))).Set(float64(lag))
+}
+
+func status(err error) string {
+	if err != nil {
+		return "error"
+	}
+	return "ok"
+}
@@ -0,0 +1,27 @@
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var httpRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "http_request_duration_seconds",
+	Help:    "Duration of HTTP requests by method, route and status code.",
+	Buckets: prometheus.DefBuckets,
+}, []string{"method", "route", "status"})
+
+// Handler serves every collector in the Prometheus text format.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// ObserveHTTPRequest records a served request. route is the route template,
+// not the raw path, so path parameters do not blow up the label cardinality.
+func ObserveHTTPRequest(method, route, status string, duration time.Duration) {
+	httpRequestDuration.WithLabelValues(method, route, status).Observe(duration.Seconds())
+}
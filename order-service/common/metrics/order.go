@@ -0,0 +1,11 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var OrdersCreated = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "orders_created_total",
+	Help: "Orders created, counted once their transaction commits.",
+})
@@ -0,0 +1,226 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// lockID is the Postgres advisory lock held while a migration runs, so replicas
+// starting at the same time never apply the same migration twice.
+const lockID = 7_341_226_001
+
+// filePattern matches file names such as 0002_add_order_user_id_index.up.sql.
+var filePattern = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+// Migration is one schema change together with the SQL that reverts it.
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Status is a migration with the time it was applied. AppliedAt is nil while the
+// migration is pending.
+type Status struct {
+	Migration
+	AppliedAt *time.Time
+}
+
+type schemaMigration struct {
+	Version   int64  `gorm:"primaryKey"`
+	Name      string `gorm:"type:varchar(255);not null"`
+	AppliedAt time.Time
+}
+
+func (schemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+// Migrator applies versioned SQL files in order and records the applied versions
+// in the schema_migrations table.
+type Migrator struct {
+	db         *gorm.DB
+	migrations []Migration
+}
+
+// New reads every migration file in fsys. Each version needs both an up and a down file.
+func New(db *gorm.DB, fsys fs.FS) (*Migrator, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[int64]*Migration{}
+	for _, entry := range entries {
+		match := filePattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		content, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		}
+		if migration.Name != match[2] {
+			return nil, fmt.Errorf("migration %d has different names: %s and %s", version, migration.Name, match[2])
+		}
+		if match[3] == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("migration %d_%s must have both up and down files", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+// Up applies every pending migration and returns the ones it ran. Each migration
+// runs in its own transaction, so a failing one leaves no partial change behind.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	err := m.ensureTable(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var applied []Migration
+	for _, migration := range m.migrations {
+		ran, err := m.apply(ctx, migration, true)
+		if err != nil {
+			return applied, fmt.Errorf("migration %d_%s up: %w", migration.Version, migration.Name, err)
+		}
+		if ran {
+			applied = append(applied, migration)
+		}
+	}
+	return applied, nil
+}
+
+// Down reverts the latest steps applied migrations, highest version first.
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var reverted []Migration
+	for i := len(statuses) - 1; i >= 0 && len(reverted) < steps; i-- {
+		if statuses[i].AppliedAt == nil {
+			continue
+		}
+
+		migration := statuses[i].Migration
+		ran, err := m.apply(ctx, migration, false)
+		if err != nil {
+			return reverted, fmt.Errorf("migration %d_%s down: %w", migration.Version, migration.Name, err)
+		}
+		if ran {
+			reverted = append(reverted, migration)
+		}
+	}
+	return reverted, nil
+}
+
+// Status returns every known migration with the time it was applied.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	err := m.ensureTable(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []schemaMigration
+	err = m.db.WithContext(ctx).Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	appliedAt := make(map[int64]time.Time, len(rows))
+	for _, row := range rows {
+		appliedAt[row.Version] = row.AppliedAt
+	}
+
+	statuses := make([]Status, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		status := Status{Migration: migration}
+		if at, ok := appliedAt[migration.Version]; ok {
+			status.AppliedAt = &at
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+func (m *Migrator) ensureTable(ctx context.Context) error {
+	return m.db.WithContext(ctx).Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+		version bigint PRIMARY KEY,
+		name varchar(255) NOT NULL,
+		applied_at timestamptz NOT NULL DEFAULT now()
+	)`).Error
+}
+
+// apply runs the up or down SQL in one transaction. The version is checked again
+// once the lock is held, since another replica may have run it in the meantime.
+func (m *Migrator) apply(ctx context.Context, migration Migration, up bool) (bool, error) {
+	ran := false
+	err := m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec("SELECT pg_advisory_xact_lock(?)", lockID).Error
+		if err != nil {
+			return err
+		}
+
+		var count int64
+		err = tx.Model(&schemaMigration{}).Where("version = ?", migration.Version).Count(&count).Error
+		if err != nil {
+			return err
+		}
+		if (count > 0) == up {
+			return nil
+		}
+
+		if up {
+			err = tx.Exec(migration.Up).Error
+			if err == nil {
+				err = tx.Create(&schemaMigration{Version: migration.Version, Name: migration.Name, AppliedAt: time.Now()}).Error
+			}
+		} else {
+			err = tx.Exec(migration.Down).Error
+			if err == nil {
+				err = tx.Where("version = ?", migration.Version).Delete(&schemaMigration{}).Error
+			}
+		}
+		if err != nil {
+			return err
+		}
+
+		ran = true
+		return nil
+	})
+	return ran, err
+}
@@ -0,0 +1,46 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"net/smtp"
+	"strings"
+
+	"github.com/anddriii/kita-futsal/order-service/config"
+	"github.com/anddriii/kita-futsal/order-service/constants"
+)
+
+type EmailChannel struct {
+	config config.Email
+}
+
+func NewEmailChannel(cfg config.Email) IChannel {
+	return &EmailChannel{config: cfg}
+}
+
+func (e *EmailChannel) Name() string {
+	return constants.EmailChannel
+}
+
+func (e *EmailChannel) CanSend(message *Message) bool {
+	return message.Email != ""
+}
+
+func (e *EmailChannel) Send(_ context.Context, message *Message) error {
+	headers := []string{
+		fmt.Sprintf("From: %s", e.config.From),
+		fmt.Sprintf("To: %s", message.Email),
+		fmt.Sprintf("Subject: %s", message.Subject),
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=\"UTF-8\"",
+	}
+	body := fmt.Sprintf("%s\r\n\r\n%s", strings.Join(headers, "\r\n"), message.Body)
+
+	var auth smtp.Auth
+	if e.config.Username != "" {
+		auth = smtp.PlainAuth("", e.config.Username, e.config.Password, e.config.Host)
+	}
+
+	address := fmt.Sprintf("%s:%d", e.config.Host, e.config.Port)
+	return smtp.SendMail(address, auth, e.config.From, []string{message.Email}, []byte(body))
+}
@@ -0,0 +1,31 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/anddriii/kita-futsal/order-service/constants"
+	"github.com/sirupsen/logrus"
+)
+
+type LogChannel struct{}
+
+func NewLogChannel() IChannel {
+	return &LogChannel{}
+}
+
+func (l *LogChannel) Name() string {
+	return constants.LogChannel
+}
+
+func (l *LogChannel) CanSend(*Message) bool {
+	return true
+}
+
+func (l *LogChannel) Send(_ context.Context, message *Message) error {
+	logrus.WithFields(logrus.Fields{
+		"name":  message.Name,
+		"email": message.Email,
+		"phone": message.PhoneNumber,
+	}).Infof("[notification] %s\n%s", message.Subject, message.Body)
+	return nil
+}
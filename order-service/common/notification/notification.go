@@ -0,0 +1,46 @@
+package notification
+
+import (
+	"context"
+	"strings"
+
+	"github.com/anddriii/kita-futsal/order-service/config"
+	"github.com/anddriii/kita-futsal/order-service/constants"
+	"github.com/sirupsen/logrus"
+)
+
+type Message struct {
+	Name        string
+	Email       string
+	PhoneNumber string
+	Subject     string
+	Body        string
+}
+
+type IChannel interface {
+	Name() string
+	CanSend(*Message) bool
+	Send(context.Context, *Message) error
+}
+
+func NewChannels(cfg config.Notification) map[string]IChannel {
+	names := cfg.Channels
+	if len(names) == 0 {
+		names = []string{constants.LogChannel}
+	}
+
+	channels := make(map[string]IChannel, len(names))
+	for _, name := range names {
+		switch strings.ToLower(name) {
+		case constants.LogChannel:
+			channels[constants.LogChannel] = NewLogChannel()
+		case constants.EmailChannel:
+			channels[constants.EmailChannel] = NewEmailChannel(cfg.Email)
+		case constants.WhatsAppChannel:
+			channels[constants.WhatsAppChannel] = NewWhatsAppChannel(cfg.WhatsApp)
+		default:
+			logrus.Warnf("unknown notification channel %s", name)
+		}
+	}
+	return channels
+}
@@ -0,0 +1,85 @@
+package notification
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+
+	"github.com/anddriii/kita-futsal/order-service/constants"
+)
+
+type TemplateData struct {
+	Name        string
+	OrderCode   string
+	FieldName   string
+	Amount      string
+	PaymentLink string
+	InvoiceLink string
+	ExpiredAt   string
+	MatchAt     string
+}
+
+type messageTemplate struct {
+	subject string
+	body    string
+}
+
+var templates = map[constants.NotificationType]messageTemplate{
+	constants.OrderCreatedNotification: {
+		subject: "Pesanan {{.OrderCode}} berhasil dibuat",
+		body: "Halo {{.Name}},\n\nPesanan {{.OrderCode}} untuk {{.FieldName}} sebesar {{.Amount}} berhasil dibuat." +
+			"\nSelesaikan pembayaran sebelum {{.ExpiredAt}} melalui link berikut:\n{{.PaymentLink}}",
+	},
+	constants.PaymentReminderNotification: {
+		subject: "Segera selesaikan pembayaran pesanan {{.OrderCode}}",
+		body: "Halo {{.Name}},\n\nPembayaran pesanan {{.OrderCode}} sebesar {{.Amount}} akan kedaluwarsa pada {{.ExpiredAt}}." +
+			"\nBayar sekarang melalui link berikut:\n{{.PaymentLink}}",
+	},
+	constants.PaymentSuccessNotification: {
+		subject: "Pembayaran pesanan {{.OrderCode}} berhasil",
+		body: "Halo {{.Name}},\n\nPembayaran pesanan {{.OrderCode}} sebesar {{.Amount}} sudah kami terima." +
+			"{{if .MatchAt}}\nJadwal main kamu di {{.FieldName}} pada {{.MatchAt}}.{{end}}" +
+			"{{if .InvoiceLink}}\nInvoice dapat diunduh di:\n{{.InvoiceLink}}{{end}}",
+	},
+	constants.PaymentExpiredNotification: {
+		subject: "Pesanan {{.OrderCode}} kedaluwarsa",
+		body: "Halo {{.Name}},\n\nPembayaran pesanan {{.OrderCode}} tidak diterima hingga {{.ExpiredAt}} sehingga pesanan dibatalkan." +
+			"\nSilakan lakukan pemesanan ulang jika masih ingin bermain.",
+	},
+	constants.MatchReminderNotification: {
+		subject: "Pengingat jadwal main di {{.FieldName}}",
+		body:    "Halo {{.Name}},\n\nJangan lupa, jadwal main kamu di {{.FieldName}} untuk pesanan {{.OrderCode}} dimulai pada {{.MatchAt}}.",
+	},
+}
+
+func Render(notificationType constants.NotificationType, data TemplateData) (string, string, error) {
+	messageTemplate, ok := templates[notificationType]
+	if !ok {
+		return "", "", fmt.Errorf("notification template %s not found", notificationType)
+	}
+
+	subject, err := execute(messageTemplate.subject, data)
+	if err != nil {
+		return "", "", err
+	}
+
+	body, err := execute(messageTemplate.body, data)
+	if err != nil {
+		return "", "", err
+	}
+	return subject, body, nil
+}
+
+func execute(text string, data TemplateData) (string, error) {
+	tmpl, err := template.New("notification").Parse(text)
+	if err != nil {
+		return "", err
+	}
+
+	var buffer bytes.Buffer
+	err = tmpl.Execute(&buffer, data)
+	if err != nil {
+		return "", err
+	}
+	return buffer.String(), nil
+}
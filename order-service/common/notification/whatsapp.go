@@ -0,0 +1,52 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/anddriii/kita-futsal/order-service/config"
+	"github.com/anddriii/kita-futsal/order-service/constants"
+	"github.com/parnurzeal/gorequest"
+)
+
+type WhatsAppChannel struct {
+	config config.WhatsApp
+}
+
+type whatsAppRequest struct {
+	Target  string `json:"target"`
+	Message string `json:"message"`
+}
+
+func NewWhatsAppChannel(cfg config.WhatsApp) IChannel {
+	return &WhatsAppChannel{config: cfg}
+}
+
+func (w *WhatsAppChannel) Name() string {
+	return constants.WhatsAppChannel
+}
+
+func (w *WhatsAppChannel) CanSend(message *Message) bool {
+	return message.PhoneNumber != ""
+}
+
+func (w *WhatsAppChannel) Send(_ context.Context, message *Message) error {
+	resp, body, errs := gorequest.New().
+		Post(w.config.URL).
+		Set(constants.Authorization, w.config.Token).
+		Set("Content-Type", "application/json").
+		Send(whatsAppRequest{
+			Target:  message.PhoneNumber,
+			Message: fmt.Sprintf("*%s*\n\n%s", message.Subject, message.Body),
+		}).
+		End()
+	if len(errs) > 0 {
+		return errs[0]
+	}
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("whatsapp response: %d %s", resp.StatusCode, body)
+	}
+	return nil
+}
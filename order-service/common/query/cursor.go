@@ -0,0 +1,121 @@
+package query
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	errWrap "github.com/anddriii/kita-futsal/order-service/common/error"
+	errConstant "github.com/anddriii/kita-futsal/order-service/constants/error"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	OffsetPagination = "offset"
+	CursorPagination = "cursor"
+
+	cursorSortColumn = "createdAt"
+)
+
+// Cursor is the keyset position of a row. Backward cursors walk towards the
+// previous page.
+type Cursor struct {
+	CreatedAt time.Time `json:"createdAt"`
+	ID        uint      `json:"id"`
+	Backward  bool      `json:"backward,omitempty"`
+}
+
+// EncodeCursor turns a cursor into an opaque token for clients.
+func EncodeCursor(cursor Cursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeCursor(token string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrInvalidCursor)
+	}
+
+	var cursor Cursor
+	err = json.Unmarshal(data, &cursor)
+	if err != nil || cursor.ID == 0 || cursor.CreatedAt.IsZero() {
+		return nil, errWrap.WrapError(errConstant.ErrInvalidCursor)
+	}
+	return &cursor, nil
+}
+
+// CursorDesc reports the keyset direction. Cursor pagination is ordered by
+// created_at and id, so other sort columns are rejected.
+func CursorDesc(column, order *string) (bool, error) {
+	if column != nil && *column != "" && *column != cursorSortColumn {
+		return false, errWrap.WrapError(errConstant.ErrCursorSortColumn)
+	}
+	if order == nil || *order == "" {
+		return true, nil
+	}
+
+	switch strings.ToLower(*order) {
+	case SortAsc:
+		return false, nil
+	case SortDesc:
+		return true, nil
+	default:
+		return false, errWrap.WrapError(errConstant.ErrInvalidSortOrder)
+	}
+}
+
+// Keyset orders by (created_at, id) and continues after the cursor, or before
+// it for backward cursors.
+func Keyset(db *gorm.DB, cursor *Cursor, desc bool) *gorm.DB {
+	if cursor != nil && cursor.Backward {
+		desc = !desc
+	}
+
+	if cursor != nil {
+		operator := ">"
+		if desc {
+			operator = "<"
+		}
+		db = db.Where(fmt.Sprintf("(created_at, id) %s (?, ?)", operator), cursor.CreatedAt, cursor.ID)
+	}
+
+	return db.Order(clause.OrderBy{Columns: []clause.OrderByColumn{
+		{Column: clause.Column{Name: "created_at"}, Desc: desc},
+		{Column: clause.Column{Name: "id"}, Desc: desc},
+	}})
+}
+
+// KeysetPage trims the extra row fetched to detect more data, restores the
+// display order for backward pages and builds the next and previous cursors.
+func KeysetPage[T any](rows []T, limit int, cursor *Cursor, key func(T) Cursor) ([]T, *string, *string) {
+	hasMore := len(rows) > limit
+	if hasMore {
+		rows = rows[:limit]
+	}
+
+	backward := cursor != nil && cursor.Backward
+	if backward {
+		slices.Reverse(rows)
+	}
+	if len(rows) == 0 {
+		return rows, nil, nil
+	}
+
+	var next, prev *string
+	if backward || hasMore {
+		token := EncodeCursor(key(rows[len(rows)-1]))
+		next = &token
+	}
+	if (cursor != nil && !backward) || (backward && hasMore) {
+		first := key(rows[0])
+		first.Backward = true
+		token := EncodeCursor(first)
+		prev = &token
+	}
+	return rows, next, prev
+}
@@ -0,0 +1,114 @@
+package query
+
+import (
+	"strings"
+	"time"
+
+	errWrap "github.com/anddriii/kita-futsal/order-service/common/error"
+	errConstant "github.com/anddriii/kita-futsal/order-service/constants/error"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	SortAsc  = "asc"
+	SortDesc = "desc"
+)
+
+// Spec maps the sortColumn values a resource accepts to their database columns.
+type Spec struct {
+	SortColumns map[string]string
+	DefaultSort clause.OrderByColumn
+}
+
+// OrderBy resolves sortColumn and sortOrder against the whitelist, so request
+// input is never interpolated into the ORDER BY clause.
+func (s Spec) OrderBy(column, order *string) (clause.OrderByColumn, error) {
+	if column == nil || *column == "" {
+		return s.DefaultSort, nil
+	}
+
+	name, ok := s.SortColumns[*column]
+	if !ok {
+		return clause.OrderByColumn{}, errWrap.WrapError(errConstant.ErrInvalidSortColumn)
+	}
+
+	desc := false
+	if order != nil && *order != "" {
+		switch strings.ToLower(*order) {
+		case SortAsc:
+		case SortDesc:
+			desc = true
+		default:
+			return clause.OrderByColumn{}, errWrap.WrapError(errConstant.ErrInvalidSortOrder)
+		}
+	}
+
+	return clause.OrderByColumn{Column: clause.Column{Name: name}, Desc: desc}, nil
+}
+
+// Filter adds a single WHERE condition. Column names always come from the
+// repository and values are passed as query parameters.
+type Filter func(db *gorm.DB) *gorm.DB
+
+func Apply(db *gorm.DB, filters ...Filter) *gorm.DB {
+	for _, filter := range filters {
+		db = filter(db)
+	}
+	return db
+}
+
+func Equal[T any](column string, value *T) Filter {
+	return func(db *gorm.DB) *gorm.DB {
+		if value == nil {
+			return db
+		}
+		return db.Where(clause.Eq{Column: clause.Column{Name: column}, Value: *value})
+	}
+}
+
+// In skips a nil slice but applies an empty one, which matches nothing.
+func In[T any](column string, values []T) Filter {
+	return func(db *gorm.DB) *gorm.DB {
+		if values == nil {
+			return db
+		}
+		items := make([]any, 0, len(values))
+		for _, value := range values {
+			items = append(items, value)
+		}
+		return db.Where(clause.IN{Column: clause.Column{Name: column}, Values: items})
+	}
+}
+
+func Range[T int | int64 | float64](column string, min, max *T) Filter {
+	return func(db *gorm.DB) *gorm.DB {
+		if min != nil {
+			db = db.Where(clause.Gte{Column: clause.Column{Name: column}, Value: *min})
+		}
+		if max != nil {
+			db = db.Where(clause.Lte{Column: clause.Column{Name: column}, Value: *max})
+		}
+		return db
+	}
+}
+
+// DateRange filters on inclusive YYYY-MM-DD bounds. The format is validated by
+// the controller, so unparsable dates are skipped.
+func DateRange(column string, startDate, endDate *string) Filter {
+	return func(db *gorm.DB) *gorm.DB {
+		if startDate != nil && *startDate != "" {
+			start, err := time.ParseInLocation(time.DateOnly, *startDate, time.Local)
+			if err == nil {
+				db = db.Where(clause.Gte{Column: clause.Column{Name: column}, Value: start})
+			}
+		}
+		if endDate != nil && *endDate != "" {
+			end, err := time.ParseInLocation(time.DateOnly, *endDate, time.Local)
+			if err == nil {
+				db = db.Where(clause.Lt{Column: clause.Column{Name: column}, Value: end.AddDate(0, 0, 1)})
+			}
+		}
+		return db
+	}
+}
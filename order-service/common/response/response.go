@@ -1,6 +1,7 @@
 package response
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/anddriii/kita-futsal/order-service/constants"
@@ -35,6 +36,11 @@ func HttpResponse(param ParamHTTPResp) {
 		return
 	}
 
+	if errors.Is(param.Err, errConst.ErrServiceUnavailable) {
+		param.Code = http.StatusServiceUnavailable
+		param.Err = errConst.ErrServiceUnavailable
+	}
+
 	message := errConst.ErrInternalServerError.Error()
 	if param.Message != nil {
 		message = *param.Message
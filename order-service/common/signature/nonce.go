@@ -0,0 +1,28 @@
+package signature
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// NonceStore remembers nonces that have already been accepted.
+type NonceStore interface {
+	// Claim records nonce for ttl and reports whether it was unused.
+	Claim(ctx context.Context, nonce string, ttl time.Duration) (bool, error)
+}
+
+type redisNonceStore struct {
+	client *redis.Client
+}
+
+// NewRedisNonceStore keeps nonces in Redis so every replica of a service sees
+// the same set.
+func NewRedisNonceStore(client *redis.Client) NonceStore {
+	return &redisNonceStore{client: client}
+}
+
+func (s *redisNonceStore) Claim(ctx context.Context, nonce string, ttl time.Duration) (bool, error) {
+	return s.client.SetNX(ctx, "signature:nonce:"+nonce, 1, ttl).Result()
+}
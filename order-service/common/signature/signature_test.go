@@ -0,0 +1,158 @@
+package signature
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// The same fixed vector is used by fe/src/library/signature.test.ts, so the Go and
+// FE signers are held to the same canonical string and signature.
+const (
+	vectorKey       = "kita-futsal-signature-key"
+	vectorCanonical = "order-service\n" +
+		"POST\n" +
+		"/api/v1/field/schedule/status?source=order\n" +
+		"c66d3b9e64a016e35dd7875306a52f99b4c7e0097f18b6aeb883a51381f7a9af\n" +
+		"1760000000\n" +
+		"00112233445566778899aabbccddeeff"
+	vectorSignature = "a909217e3777ace2ebfa460ac02c32cdefcfbc1288d7df5d31b8dd7bbf8233d9"
+)
+
+func vectorRequest() Request {
+	return Request{
+		ServiceName: "order-service",
+		Method:      "post",
+		Path:        "/api/v1/field/schedule/status?source=order",
+		Body:        []byte(`{"fieldScheduleIds":["6f1c2a52-3b0e-4c1e-9c55-2f4a8e7d9b10"]}`),
+		Timestamp:   "1760000000",
+		Nonce:       "00112233445566778899aabbccddeeff",
+	}
+}
+
+type memoryNonceStore struct {
+	mu     sync.Mutex
+	nonces map[string]bool
+}
+
+func (s *memoryNonceStore) Claim(_ context.Context, nonce string, _ time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.nonces[nonce] {
+		return false, nil
+	}
+	s.nonces[nonce] = true
+	return true, nil
+}
+
+func newNonceStore() *memoryNonceStore {
+	return &memoryNonceStore{nonces: map[string]bool{}}
+}
+
+// signedAt copies the vector with a timestamp offset from now.
+func signedAt(offset time.Duration) (Request, string) {
+	request := vectorRequest()
+	request.Timestamp = strconv.FormatInt(time.Now().Add(offset).Unix(), 10)
+	return request, request.Sign(vectorKey)
+}
+
+func TestSignFixedVector(t *testing.T) {
+	request := vectorRequest()
+
+	if got := request.canonical(); got != vectorCanonical {
+		t.Fatalf("canonical = %q, want %q", got, vectorCanonical)
+	}
+	if got := request.Sign(vectorKey); got != vectorSignature {
+		t.Fatalf("Sign = %s, want %s", got, vectorSignature)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(*Request, *string)
+		wantErr error
+	}{
+		{name: "valid", mutate: func(*Request, *string) {}},
+		{name: "missing header", mutate: func(r *Request, _ *string) { r.Nonce = "" }, wantErr: ErrMissing},
+		{name: "timestamp not a number", mutate: func(r *Request, _ *string) { r.Timestamp = "yesterday" }, wantErr: ErrMissing},
+		{name: "body changed", mutate: func(r *Request, _ *string) { r.Body = []byte(`{}`) }, wantErr: ErrMismatch},
+		{name: "path changed", mutate: func(r *Request, _ *string) { r.Path = "/api/v1/field/schedule/status" }, wantErr: ErrMismatch},
+		{name: "wrong key", mutate: func(r *Request, s *string) { *s = r.Sign("other-key") }, wantErr: ErrMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request, sign := signedAt(0)
+			tt.mutate(&request, &sign)
+
+			err := Verify(context.Background(), vectorKey, request, sign, newNonceStore())
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Verify error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVerifyTimestampSkew(t *testing.T) {
+	tests := []struct {
+		name    string
+		offset  time.Duration
+		wantErr error
+	}{
+		{name: "within skew in the past", offset: -MaxSkew + time.Minute},
+		{name: "within skew in the future", offset: MaxSkew - time.Minute},
+		{name: "too old", offset: -MaxSkew - time.Minute, wantErr: ErrExpired},
+		{name: "too far in the future", offset: MaxSkew + time.Minute, wantErr: ErrExpired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request, sign := signedAt(tt.offset)
+
+			err := Verify(context.Background(), vectorKey, request, sign, newNonceStore())
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Verify error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	// the fixed vector was signed long ago, so it cannot be replayed
+	err := Verify(context.Background(), vectorKey, vectorRequest(), vectorSignature, newNonceStore())
+	if !errors.Is(err, ErrExpired) {
+		t.Fatalf("Verify fixed vector error = %v, want %v", err, ErrExpired)
+	}
+}
+
+func TestVerifyRejectsReplayedNonce(t *testing.T) {
+	ctx := context.Background()
+	nonces := newNonceStore()
+	request, sign := signedAt(0)
+
+	// a forged request must not use up the nonce
+	err := Verify(ctx, vectorKey, request, "forged", nonces)
+	if !errors.Is(err, ErrMismatch) {
+		t.Fatalf("forged Verify error = %v, want %v", err, ErrMismatch)
+	}
+
+	err = Verify(ctx, vectorKey, request, sign, nonces)
+	if err != nil {
+		t.Fatalf("first Verify: %v", err)
+	}
+
+	err = Verify(ctx, vectorKey, request, sign, nonces)
+	if !errors.Is(err, ErrReplayed) {
+		t.Fatalf("replayed Verify error = %v, want %v", err, ErrReplayed)
+	}
+
+	// the same nonce from another service is a different nonce
+	other := request
+	other.ServiceName = "payment-service"
+	err = Verify(ctx, vectorKey, other, other.Sign(vectorKey), nonces)
+	if err != nil {
+		t.Fatalf("Verify from other service: %v", err)
+	}
+}
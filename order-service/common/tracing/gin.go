@@ -0,0 +1,47 @@
+package tracing
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Middleware starts a server span for every request, joining the trace of the
+// caller when it sent one. The span is named after the route template, the
+// same label the metrics use, so /orders/:uuid is one operation.
+func Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+
+		ctx := Extract(c.Request.Context(), propagation.HeaderCarrier(c.Request.Header))
+		ctx, span := Tracer().Start(ctx, fmt.Sprintf("%s %s", c.Request.Method, route),
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPRequestMethodKey.String(c.Request.Method),
+				semconv.HTTPRoute(route),
+				semconv.URLPath(c.Request.URL.Path),
+			),
+		)
+		defer span.End()
+
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+
+		status := c.Writer.Status()
+		span.SetAttributes(semconv.HTTPResponseStatusCode(status))
+		if status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(status))
+		}
+		if err := c.Errors.Last(); err != nil {
+			span.RecordError(err)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+)
+
+const instrumentationName = "github.com/anddriii/kita-futsal/order-service"
+
+// flushTimeout bounds how long shutdown waits for the spans still buffered to
+// be exported, so an unreachable collector does not hold the process.
+const flushTimeout = 5 * time.Second
+
+// Init installs the W3C trace context propagator and a tracer provider that
+// sends spans to the given exporter. Without an exporter no span is recorded,
+// but the trace context of incoming requests and messages is still passed on
+// to the downstreams. The returned function flushes and stops the provider.
+func Init(serviceName, exporter, endpoint string, insecure bool) (func() error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	var (
+		spanExporter sdktrace.SpanExporter
+		err          error
+	)
+	switch exporter {
+	case "":
+		return func() error { return nil }, nil
+	case ExporterStdout:
+		spanExporter, err = stdouttrace.New()
+	case ExporterOTLP:
+		options := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(endpoint)}
+		if insecure {
+			options = append(options, otlptracegrpc.WithInsecure())
+		}
+		spanExporter, err = otlptracegrpc.New(context.Background(), options...)
+	default:
+		return nil, fmt.Errorf("unknown trace exporter %q", exporter)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(spanExporter),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(serviceName))),
+	)
+	otel.SetTracerProvider(provider)
+
+	return func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), flushTimeout)
+		defer cancel()
+		return provider.Shutdown(ctx)
+	}, nil
+}
+
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// Inject writes the trace context of ctx into an outgoing request or message.
+func Inject(ctx context.Context, carrier propagation.TextMapCarrier) {
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+}
+
+// Extract returns ctx carrying the trace context of an incoming request or
+// message, so spans started from it join the caller's trace.
+func Extract(ctx context.Context, carrier propagation.TextMapCarrier) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, carrier)
+}
+
+// End marks the span failed when err is set and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
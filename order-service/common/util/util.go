@@ -21,6 +21,18 @@ type PaginationParam struct {
 	Page  int         `json:"page"`
 	Limit int         `json:"limit"`
 	Data  interface{} `json:"data"`
+	// SkipCount means Count was not queried, so HasNext decides the next page.
+	SkipCount bool `json:"-"`
+	HasNext   bool `json:"-"`
+}
+
+type CursorPaginationParam struct {
+	Count      int64
+	Limit      int
+	SkipCount  bool
+	NextCursor *string
+	PrevCursor *string
+	Data       interface{}
 }
 
 type PaginationResult struct {
@@ -28,20 +40,28 @@ type PaginationResult struct {
 	TotalData    int64       `json:"totalData"`
 	NextPage     *int        `json:"nextPage"`
 	PreviousPage *int        `json:"previousPage"`
+	NextCursor   *string     `json:"nextCursor,omitempty"`
+	PrevCursor   *string     `json:"prevCursor,omitempty"`
 	Page         int         `json:"page"`
 	Limit        int         `json:"limit"`
 	Data         interface{} `json:"data"`
 }
 
 func GeneratePagination(params PaginationParam) PaginationResult {
-	totalPage := int(math.Ceil(float64(params.Count) / float64(params.Limit)))
-
 	var (
+		totalPage    int
 		nextPage     int
 		previousPage int
 	)
-	if params.Page < totalPage {
-		nextPage = params.Page + 1
+	if params.SkipCount {
+		if params.HasNext {
+			nextPage = params.Page + 1
+		}
+	} else {
+		totalPage = int(math.Ceil(float64(params.Count) / float64(params.Limit)))
+		if params.Page < totalPage {
+			nextPage = params.Page + 1
+		}
 	}
 
 	if params.Page > 1 {
@@ -60,6 +80,22 @@ func GeneratePagination(params PaginationParam) PaginationResult {
 	return result
 }
 
+func GenerateCursorPagination(params CursorPaginationParam) PaginationResult {
+	var totalPage int
+	if !params.SkipCount {
+		totalPage = int(math.Ceil(float64(params.Count) / float64(params.Limit)))
+	}
+
+	return PaginationResult{
+		TotalPage:  totalPage,
+		TotalData:  params.Count,
+		NextCursor: params.NextCursor,
+		PrevCursor: params.PrevCursor,
+		Limit:      params.Limit,
+		Data:       params.Data,
+	}
+}
+
 func GenerateSHA256(inputString string) string {
 	hash := sha256.New()
 	hash.Write([]byte(inputString))
@@ -68,6 +104,19 @@ func GenerateSHA256(inputString string) string {
 	return hashString
 }
 
+// Chunk splits items into consecutive slices of at most size elements.
+func Chunk[T any](items []T, size int) [][]T {
+	chunks := make([][]T, 0, (len(items)+size-1)/size)
+	for size < len(items) {
+		chunks = append(chunks, items[:size:size])
+		items = items[size:]
+	}
+	if len(items) > 0 {
+		chunks = append(chunks, items)
+	}
+	return chunks
+}
+
 func RupiahFormat(amount *float64) string {
 	stringValue := "0"
 	if amount != nil {
@@ -15,6 +15,7 @@ type AppConfig struct {
 	AppEnv                     string          `json:"appEnv"`
 	SignatureKey               string          `json:"signatureKey"`
 	Database                   Database        `json:"database"`
+	Redis                      Redis           `json:"redis"`
 	RateLimiterMaxRequest      float64         `json:"rateLimiterMaxRequest"`
 	RateLimiterTimeSecond      int             `json:"rateLimiterTimeSecond"`
 	InternalService            InternalService `json:"internalService"`
@@ -31,6 +32,8 @@ type AppConfig struct {
 	GCSUniverseDomain          string          `json:"gcsUniverseDomain"`
 	GCSBucketName              string          `json:"gcsBucketName"`
 	Kafka                      Kafka           `json:"kafka"`
+	Notification               Notification    `json:"notification"`
+	Tracing                    Tracing         `json:"tracing"`
 }
 
 type Database struct {
@@ -45,24 +48,33 @@ type Database struct {
 	MaxIdleTime           int    `json:"maxIdleTime"`
 }
 
+type Redis struct {
+	Addr     string `json:"addr"`
+	Password string `json:"password"`
+	DB       int    `json:"db"`
+}
+
 type InternalService struct {
 	User    User    `json:"user"`
 	Field   Field   `json:"field"`
-	Payment Payment `json:"order"`
+	Payment Payment `json:"payment"`
 }
 
 type User struct {
 	Host         string `json:"host"`
+	GrpcHost     string `json:"grpcHost"`
 	SignatureKey string `json:"signatureKey"`
 }
 
 type Field struct {
 	Host         string `json:"host"`
+	GrpcHost     string `json:"grpcHost"`
 	SignatureKey string `json:"signatureKey"`
 }
 
 type Payment struct {
 	Host         string `json:"host"`
+	GrpcHost     string `json:"grpcHost"`
 	SignatureKey string `json:"signatureKey"`
 }
 
@@ -77,6 +89,35 @@ type Kafka struct {
 	BackOffTimeInMs       int      `json:"backOffTimeInMs"`
 }
 
+type Notification struct {
+	Channels                 []string `json:"channels"`
+	PaymentReminderInMinutes int      `json:"paymentReminderInMinutes"`
+	MatchReminderInMinutes   int      `json:"matchReminderInMinutes"`
+	Email                    Email    `json:"email"`
+	WhatsApp                 WhatsApp `json:"whatsapp"`
+}
+
+type Email struct {
+	Host     string `json:"host"`
+	Port     int    `json:"port"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+	From     string `json:"from"`
+}
+
+type WhatsApp struct {
+	URL   string `json:"url"`
+	Token string `json:"token"`
+}
+
+type Tracing struct {
+	// Exporter is "otlp", "stdout" or empty to export no spans.
+	Exporter string `json:"exporter"`
+	// Endpoint is the host:port of the OTLP gRPC collector.
+	Endpoint string `json:"endpoint"`
+	Insecure bool   `json:"insecure"`
+}
+
 func Init() {
 	err := util.BindFromJSON(&Config, "config.json", ".")
 	if err != nil {
@@ -5,6 +5,8 @@ import (
 	"net/url"
 	"time"
 
+	"github.com/redis/go-redis/v9"
+
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
@@ -36,3 +38,11 @@ func InitDatabase() (*gorm.DB, error) {
 	sqlDB.SetConnMaxIdleTime(time.Duration(config.Database.MaxIdleTime) * time.Second)
 	return db, nil
 }
+
+func NewRedisClient() *redis.Client {
+	return redis.NewClient(&redis.Options{
+		Addr:     Config.Redis.Addr,
+		Password: Config.Redis.Password,
+		DB:       Config.Redis.DB,
+	})
+}
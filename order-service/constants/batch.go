@@ -0,0 +1,5 @@
+package constants
+
+// MaxBatchSize is the largest number of UUIDs the batch endpoints of user-service and
+// payment-service accept in one request.
+const MaxBatchSize = 100
@@ -0,0 +1,7 @@
+package constants
+
+// Keys of the request context values the loggers read.
+const (
+	RequestID = "request_id"
+	UserUUID  = "user_uuid"
+)
@@ -11,6 +11,11 @@ var (
 	ErrInvalidUploadFile   = errors.New("invalid upload file")
 	ErrSizeTooBig          = errors.New("size too big")
 	ErrForbidden           = errors.New("forbidden")
+	ErrInvalidSortColumn   = errors.New("invalid sort column")
+	ErrInvalidSortOrder    = errors.New("invalid sort order, must be asc or desc")
+	ErrInvalidCursor       = errors.New("invalid cursor")
+	ErrCursorSortColumn    = errors.New("cursor pagination only supports sorting by createdAt")
+	ErrServiceUnavailable  = errors.New("service temporarily unavailable, please try again later")
 )
 
 var GeneralErrors = []error{
@@ -20,4 +25,9 @@ var GeneralErrors = []error{
 	ErrUnauthorized,
 	ErrInvalidToken,
 	ErrForbidden,
+	ErrInvalidSortColumn,
+	ErrInvalidSortOrder,
+	ErrInvalidCursor,
+	ErrCursorSortColumn,
+	ErrServiceUnavailable,
 }
@@ -5,9 +5,15 @@ import "errors"
 var (
 	ErrOrderNotFound      = errors.New("order not found")
 	ErrFieldAlreadyBooked = errors.New("field schedule already booked")
+	ErrReviewNotEligible  = errors.New("order is not eligible for review")
+	ErrFieldClaimed       = errors.New("field schedule is reserved for a waitlisted user")
+	ErrOrderNotCancelable = errors.New("only unpaid orders can be cancelled")
 )
 
 var OrderErrors = []error{
 	ErrOrderNotFound,
 	ErrFieldAlreadyBooked,
+	ErrReviewNotEligible,
+	ErrFieldClaimed,
+	ErrOrderNotCancelable,
 }
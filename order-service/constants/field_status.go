@@ -1,12 +1,8 @@
 package constants
 
-type FieldStatusString string
-
+// Field schedule statuses as named by field-service.
 const (
-	AvailableStatus FieldStatusString = "pending"
-	BookedStatus    FieldStatusString = "settlement"
+	FieldScheduleAvailable = "Available"
+	FieldScheduleHeld      = "Held"
+	FieldScheduleBooked    = "Booked"
 )
-
-func (p FieldStatusString) String() string {
-	return string(p)
-}
@@ -4,7 +4,9 @@ import "net/textproto"
 
 var (
 	XServiceName  = textproto.CanonicalMIMEHeaderKey("x-service-name")
-	XApiKey       = textproto.CanonicalMIMEHeaderKey("x-api-key")
 	XRequestAt    = textproto.CanonicalMIMEHeaderKey("x-request-at")
+	XNonce        = textproto.CanonicalMIMEHeaderKey("x-nonce")
+	XSignature    = textproto.CanonicalMIMEHeaderKey("x-signature")
 	Authorization = textproto.CanonicalMIMEHeaderKey("authorization")
+	XRequestID    = textproto.CanonicalMIMEHeaderKey("x-request-id")
 )
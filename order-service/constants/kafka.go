@@ -0,0 +1,6 @@
+package constants
+
+const (
+	OrderTopic        = "order-service-event"
+	OrderCreatedEvent = "ORDER_CREATED"
+)
@@ -0,0 +1,26 @@
+package constants
+
+type NotificationType string
+type NotificationStatus string
+
+const (
+	OrderCreatedNotification    NotificationType = "order-created"
+	PaymentReminderNotification NotificationType = "payment-reminder"
+	PaymentSuccessNotification  NotificationType = "payment-success"
+	PaymentExpiredNotification  NotificationType = "payment-expired"
+	MatchReminderNotification   NotificationType = "match-reminder"
+
+	NotificationPending   NotificationStatus = "pending"
+	NotificationSending   NotificationStatus = "sending"
+	NotificationSent      NotificationStatus = "sent"
+	NotificationFailed    NotificationStatus = "failed"
+	NotificationCancelled NotificationStatus = "cancelled"
+
+	LogChannel      = "log"
+	EmailChannel    = "email"
+	WhatsAppChannel = "whatsapp"
+)
+
+func (n NotificationType) String() string {
+	return string(n)
+}
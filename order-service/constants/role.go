@@ -1,7 +1,8 @@
 package constants
 
 const (
-	Admin    = "admin"
-	Customer = "customer"
-	System   = "system"
+	Admin        = "admin"
+	Customer     = "customer"
+	System       = "system"
+	VenueManager = "venue_manager"
 )
@@ -8,11 +8,13 @@ const (
 	PendingPayment OrderStatus = 200
 	PaymentSuccess OrderStatus = 300
 	Expired        OrderStatus = 400
+	Cancelled      OrderStatus = 500
 
 	PendingString        OrderStatusString = "pending"
 	PendingPaymentString OrderStatusString = "pending-payment"
 	PaymentSuccessString OrderStatusString = "payment-success"
 	ExpiredString        OrderStatusString = "expired"
+	CancelledString      OrderStatusString = "cancelled"
 )
 
 var mapStatusStringToInt = map[OrderStatusString]OrderStatus{
@@ -20,6 +22,7 @@ var mapStatusStringToInt = map[OrderStatusString]OrderStatus{
 	PendingPaymentString: PendingPayment,
 	PaymentSuccessString: PaymentSuccess,
 	ExpiredString:        Expired,
+	CancelledString:      Cancelled,
 }
 
 var mapStatusIntToString = map[OrderStatus]OrderStatusString{
@@ -27,6 +30,7 @@ var mapStatusIntToString = map[OrderStatus]OrderStatusString{
 	PendingPayment: PendingPaymentString,
 	PaymentSuccess: PaymentSuccessString,
 	Expired:        ExpiredString,
+	Cancelled:      CancelledString,
 }
 
 func (p OrderStatusString) String() string {
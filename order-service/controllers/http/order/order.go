@@ -1,10 +1,10 @@
 package controllers
 
 import (
-	"fmt"
 	"net/http"
 
 	error2 "github.com/anddriii/kita-futsal/order-service/common/error"
+	"github.com/anddriii/kita-futsal/order-service/common/logger"
 	"github.com/anddriii/kita-futsal/order-service/common/response"
 	"github.com/anddriii/kita-futsal/order-service/domain/dto"
 	"github.com/anddriii/kita-futsal/order-service/services"
@@ -20,8 +20,11 @@ type OrderController struct {
 type IOrderController interface {
 	GetAllWithPagination(*gin.Context)
 	GetByUUID(*gin.Context)
+	GetByCode(*gin.Context)
 	GetOrderByUserID(*gin.Context)
+	CheckReviewEligibility(*gin.Context)
 	Create(*gin.Context)
+	Cancel(*gin.Context)
 }
 
 func NewOrderController(service services.IServiceRegistry) IOrderController {
@@ -90,6 +93,25 @@ func (o *OrderController) GetByUUID(c *gin.Context) {
 	})
 }
 
+func (o *OrderController) GetByCode(c *gin.Context) {
+	code := c.Param("code")
+	result, err := o.service.GetOrder().GetByCode(c.Request.Context(), code)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  c,
+	})
+}
+
 func (o *OrderController) GetOrderByUserID(c *gin.Context) {
 	result, err := o.service.GetOrder().GetOrderByUserID(c.Request.Context())
 	if err != nil {
@@ -108,6 +130,49 @@ func (o *OrderController) GetOrderByUserID(c *gin.Context) {
 	})
 }
 
+func (o *OrderController) CheckReviewEligibility(c *gin.Context) {
+	var params dto.ReviewEligibilityRequestParam
+	err := c.ShouldBindQuery(&params)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	validate := validator.New()
+	if err = validate.Struct(params); err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errorResponse := error2.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Err:     err,
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errorResponse,
+			Gin:     c,
+		})
+		return
+	}
+
+	result, err := o.service.GetOrder().CheckReviewEligibility(c.Request.Context(), c.Param("uuid"), &params)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  c,
+	})
+}
+
 func (o *OrderController) Create(c *gin.Context) {
 	var (
 		request dto.OrderRequest
@@ -140,7 +205,7 @@ func (o *OrderController) Create(c *gin.Context) {
 
 	result, err := o.service.GetOrder().Create(ctx, &request)
 	if err != nil {
-		fmt.Println("DEBUG: Error Create Order, ", err)
+		logger.FromContext(ctx).Errorf("failed to create order: %v", err)
 		response.HttpResponse(response.ParamHTTPResp{
 			Code: http.StatusBadRequest,
 			Err:  err,
@@ -155,3 +220,21 @@ func (o *OrderController) Create(c *gin.Context) {
 		Gin:  c,
 	})
 }
+
+func (o *OrderController) Cancel(c *gin.Context) {
+	result, err := o.service.GetOrder().Cancel(c.Request.Context(), c.Param("uuid"))
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  c,
+	})
+}
@@ -2,9 +2,17 @@ package kafka
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/anddriii/kita-futsal/order-service/common/logger"
+	"github.com/anddriii/kita-futsal/order-service/common/metrics"
+	"github.com/anddriii/kita-futsal/order-service/common/tracing"
 	"github.com/anddriii/kita-futsal/order-service/config"
+	"github.com/anddriii/kita-futsal/order-service/constants"
+	"github.com/anddriii/kita-futsal/order-service/controllers/kafka"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/IBM/sarama"
 	"github.com/sirupsen/logrus"
@@ -33,37 +41,74 @@ func (c *ConsumerGroup) Cleanup(sarama sarama.ConsumerGroupSession) error {
 	return nil
 }
 
+// ConsumeClaim returns as soon as the session ends instead of waiting for the
+// claim to drain, so a shutdown only waits for the message being handled.
 func (c *ConsumerGroup) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
-	messages := claim.Messages()
-	for message := range messages {
-		handler, ok := c.handler[TopicName(message.Topic)]
-		if !ok {
-			logrus.Errorf("handler for topic %s not found", message.Topic)
-			continue
-		}
-
-		var err error
-		maxRetry := config.Config.Kafka.MaxRetry
-		for attempt := 1; attempt <= maxRetry; attempt++ {
-			err = handler(context.Background(), message)
-			if err == nil {
-				break
+	for {
+		select {
+		case <-session.Context().Done():
+			return nil
+		case message, ok := <-claim.Messages():
+			if !ok {
+				return nil
 			}
+			metrics.SetKafkaConsumerLag(message.Topic, message.Partition, claim.HighWaterMarkOffset()-message.Offset-1)
 
-			logrus.Errorf("error handling message pn %s, attempt %d: %v", message.Topic, attempt, err)
-			if attempt == maxRetry {
-				logrus.Errorf("max retry reached, message will be ignored")
+			err := c.handle(message)
+			metrics.ObserveKafkaConsume(message.Topic, err)
+			if err != nil {
+				logrus.Errorf("error handling message on %s: %v", message.Topic, err)
+				session.MarkMessage(message, err.Error())
+				return nil
 			}
+			session.MarkMessage(message, time.Now().UTC().String())
+		}
+	}
+}
+
+// handle runs the topic's handler with retries inside a consumer span that
+// continues the trace and the request ID of the producer. The handler gets a
+// context that is not tied to the session so a shutdown does not abort it halfway.
+func (c *ConsumerGroup) handle(message *sarama.ConsumerMessage) error {
+	handler, ok := c.handler[TopicName(message.Topic)]
+	if !ok {
+		logrus.Errorf("handler for topic %s not found", message.Topic)
+		return nil
+	}
+
+	headers := kafka.ConsumerHeaders{Message: message}
+	ctx := context.WithValue(context.Background(), constants.RequestID, logger.RequestID(headers.Get(constants.XRequestID)))
+	ctx = tracing.Extract(ctx, headers)
+	ctx, span := tracing.Tracer().Start(ctx, fmt.Sprintf("%s process", message.Topic),
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKafka,
+			semconv.MessagingDestinationName(message.Topic),
+			semconv.MessagingKafkaDestinationPartition(int(message.Partition)),
+			semconv.MessagingKafkaMessageOffset(int(message.Offset)),
+		),
+	)
+
+	err := retry(ctx, handler, message)
+	tracing.End(span, err)
+	return err
+}
+
+func retry(ctx context.Context, handler Handler, message *sarama.ConsumerMessage) error {
+	var err error
+	maxRetry := config.Config.Kafka.MaxRetry
+	for attempt := 1; attempt <= maxRetry; attempt++ {
+		err = handler(ctx, message)
+		if err == nil {
+			return nil
 		}
 
-		if err != nil {
-			logrus.Errorf("error handling message on %s: %v", message.Topic, err)
-			session.MarkMessage(message, err.Error())
-			break
+		logger.FromContext(ctx).Errorf("error handling message on %s, attempt %d: %v", message.Topic, attempt, err)
+		if attempt == maxRetry {
+			logger.FromContext(ctx).Errorf("max retry reached, message will be ignored")
 		}
-		session.MarkMessage(message, time.Now().UTC().String())
 	}
-	return nil
+	return err
 }
 
 func (c *ConsumerGroup) RegisterHandler(topic TopicName, handler Handler) {
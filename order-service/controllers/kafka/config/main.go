@@ -2,6 +2,7 @@ package kafka
 
 import (
 	"github.com/anddriii/kita-futsal/order-service/config"
+	"github.com/anddriii/kita-futsal/order-service/constants"
 	"github.com/anddriii/kita-futsal/order-service/controllers/kafka"
 	kafka2 "github.com/anddriii/kita-futsal/order-service/controllers/kafka/payment"
 	"golang.org/x/exp/slices"
@@ -22,6 +23,7 @@ func NewKafkaConsumer(consumer *ConsumerGroup, kafka kafka.IKafkaRegistry) IKafk
 
 func (k *Kafka) Register() {
 	k.paymentHandler()
+	k.orderHandler()
 }
 
 func (k *Kafka) paymentHandler() {
@@ -29,3 +31,9 @@ func (k *Kafka) paymentHandler() {
 		k.consumer.RegisterHandler(kafka2.PaymentTopic, k.kafka.GetPayment().HandlePayment)
 	}
 }
+
+func (k *Kafka) orderHandler() {
+	if slices.Contains(config.Config.Kafka.Topics, constants.OrderTopic) {
+		k.consumer.RegisterHandler(constants.OrderTopic, k.kafka.GetOrder().HandleOrder)
+	}
+}
@@ -0,0 +1,44 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/IBM/sarama"
+	"github.com/anddriii/kita-futsal/order-service/common/logger"
+	"github.com/anddriii/kita-futsal/order-service/common/util"
+	"github.com/anddriii/kita-futsal/order-service/domain/dto"
+	"github.com/anddriii/kita-futsal/order-service/services"
+)
+
+type OrderKafka struct {
+	service services.IServiceRegistry
+}
+
+type IOrderKafka interface {
+	HandleOrder(context.Context, *sarama.ConsumerMessage) error
+}
+
+func NewOrderKafka(service services.IServiceRegistry) IOrderKafka {
+	return &OrderKafka{service: service}
+}
+
+func (o *OrderKafka) HandleOrder(ctx context.Context, message *sarama.ConsumerMessage) error {
+	defer util.Recover()
+	var body dto.OrderContent
+	err := json.Unmarshal(message.Value, &body)
+	if err != nil {
+		logger.FromContext(ctx).Errorf("failed to unmarshal message: %v", err)
+		return err
+	}
+
+	data := body.Body.Data
+	err = o.service.GetNotification().HandleOrderEvent(ctx, &data)
+	if err != nil {
+		logger.FromContext(ctx).Errorf("failed to handle order event: %v", err)
+		return err
+	}
+
+	logger.FromContext(ctx).Infof("success handle order event %s", body.Event.Name)
+	return nil
+}
@@ -5,10 +5,10 @@ import (
 	"encoding/json"
 
 	"github.com/IBM/sarama"
+	"github.com/anddriii/kita-futsal/order-service/common/logger"
 	"github.com/anddriii/kita-futsal/order-service/common/util"
 	"github.com/anddriii/kita-futsal/order-service/domain/dto"
 	"github.com/anddriii/kita-futsal/order-service/services"
-	"github.com/sirupsen/logrus"
 )
 
 const PaymentTopic = "payment-service-callback"
@@ -30,17 +30,29 @@ func (p *PaymentKafka) HandlePayment(ctx context.Context, message *sarama.Consum
 	var body dto.PaymentContent
 	err := json.Unmarshal(message.Value, &body)
 	if err != nil {
-		logrus.Errorf("failed to unmarshal message: %v", err)
+		logger.FromContext(ctx).Errorf("failed to unmarshal message: %v", err)
 		return err
 	}
 
 	data := body.Body.Data
-	err = p.service.GetOrder().HandlePayment(ctx, &data)
+	applied, err := p.service.GetOrder().HandlePayment(ctx, &data)
 	if err != nil {
-		logrus.Errorf("failed to handle payment: %v", err)
+		logger.FromContext(ctx).Errorf("failed to handle payment: %v", err)
 		return err
 	}
 
-	logrus.Infof("success handle payment")
+	// a cancelled order or a redelivered event leaves the order untouched, and the
+	// customer must not be told about a payment the order no longer tracks
+	if !applied {
+		logger.FromContext(ctx).Infof("payment of order %s skipped", data.OrderID)
+		return nil
+	}
+
+	err = p.service.GetNotification().HandlePaymentEvent(ctx, &data)
+	if err != nil {
+		logger.FromContext(ctx).Errorf("failed to send payment notification: %v", err)
+	}
+
+	logger.FromContext(ctx).Infof("success handle payment")
 	return nil
 }
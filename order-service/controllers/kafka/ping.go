@@ -0,0 +1,27 @@
+package kafka
+
+import (
+	"context"
+	"time"
+
+	"github.com/IBM/sarama"
+)
+
+// Ping connects to the brokers and fetches the cluster metadata, the same first
+// step the producer and the consumer group take.
+func Ping(ctx context.Context, brokers []string) error {
+	config := sarama.NewConfig()
+	config.Metadata.Retry.Max = 0
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout := time.Until(deadline)
+		config.Net.DialTimeout = timeout
+		config.Net.ReadTimeout = timeout
+		config.Net.WriteTimeout = timeout
+	}
+
+	client, err := sarama.NewClient(brokers, config)
+	if err != nil {
+		return err
+	}
+	return client.Close()
+}
@@ -0,0 +1,77 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM/sarama"
+	"github.com/anddriii/kita-futsal/order-service/common/event"
+	"github.com/anddriii/kita-futsal/order-service/common/logger"
+	"github.com/anddriii/kita-futsal/order-service/common/metrics"
+	"github.com/anddriii/kita-futsal/order-service/common/tracing"
+	configApp "github.com/anddriii/kita-futsal/order-service/config"
+	"github.com/anddriii/kita-futsal/order-service/constants"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type Producer struct {
+	brokers []string
+}
+
+func NewKafkaProducer(brokers []string) event.IProducer {
+	return &Producer{brokers: brokers}
+}
+
+// ProduceMessage sends data inside a producer span whose trace context and
+// request ID go in the message headers, so the consumer continues the trace of ctx.
+func (p *Producer) ProduceMessage(ctx context.Context, topic string, data []byte) error {
+	ctx, span := tracing.Tracer().Start(ctx, fmt.Sprintf("%s publish", topic),
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(semconv.MessagingSystemKafka, semconv.MessagingDestinationName(topic)),
+	)
+	err := p.produce(ctx, topic, data)
+	tracing.End(span, err)
+	return err
+}
+
+func (p *Producer) produce(ctx context.Context, topic string, data []byte) error {
+	config := sarama.NewConfig()
+	config.Producer.Return.Successes = true
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	config.Producer.Retry.Max = configApp.Config.Kafka.MaxRetry
+
+	producer, err := sarama.NewSyncProducer(p.brokers, config)
+	if err != nil {
+		logger.FromContext(ctx).Errorf("failed to create producer: %v", err)
+		metrics.ObserveKafkaProduce(topic, err)
+		return err
+	}
+
+	defer func(producer sarama.SyncProducer) {
+		err = producer.Close()
+		if err != nil {
+			logger.FromContext(ctx).Errorf("failed to close kafka producer: %v", err)
+		}
+	}(producer)
+
+	message := &sarama.ProducerMessage{
+		Topic: topic,
+		Value: sarama.ByteEncoder(data),
+	}
+	headers := ProducerHeaders{Message: message}
+	if requestID, ok := ctx.Value(constants.RequestID).(string); ok && requestID != "" {
+		headers.Set(constants.XRequestID, requestID)
+	}
+	tracing.Inject(ctx, headers)
+
+	partition, offset, err := producer.SendMessage(message)
+	metrics.ObserveKafkaProduce(topic, err)
+	if err != nil {
+		logger.FromContext(ctx).Errorf("failed to produce to kafka: %v", err)
+		return err
+	}
+
+	logger.FromContext(ctx).Infof("message is stored in topic(%s)/partition(%d)/offset(%d)", topic, partition, offset)
+	return nil
+}
@@ -1,6 +1,7 @@
 package kafka
 
 import (
+	orderKafka "github.com/anddriii/kita-futsal/order-service/controllers/kafka/order"
 	kafka "github.com/anddriii/kita-futsal/order-service/controllers/kafka/payment"
 	"github.com/anddriii/kita-futsal/order-service/services"
 )
@@ -11,6 +12,7 @@ type Registry struct {
 
 type IKafkaRegistry interface {
 	GetPayment() kafka.IPaymentKafka
+	GetOrder() orderKafka.IOrderKafka
 }
 
 func NewKafkaRegistry(service services.IServiceRegistry) IKafkaRegistry {
@@ -20,3 +22,7 @@ func NewKafkaRegistry(service services.IServiceRegistry) IKafkaRegistry {
 func (r *Registry) GetPayment() kafka.IPaymentKafka {
 	return kafka.NewPaymentKafka(r.service)
 }
+
+func (r *Registry) GetOrder() orderKafka.IOrderKafka {
+	return orderKafka.NewOrderKafka(r.service)
+}
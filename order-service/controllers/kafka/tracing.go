@@ -0,0 +1,64 @@
+package kafka
+
+import "github.com/IBM/sarama"
+
+// ProducerHeaders lets the propagator write the trace context into the headers
+// of a message about to be produced.
+type ProducerHeaders struct {
+	Message *sarama.ProducerMessage
+}
+
+func (h ProducerHeaders) Get(key string) string {
+	for _, header := range h.Message.Headers {
+		if string(header.Key) == key {
+			return string(header.Value)
+		}
+	}
+	return ""
+}
+
+func (h ProducerHeaders) Set(key, value string) {
+	for i, header := range h.Message.Headers {
+		if string(header.Key) == key {
+			h.Message.Headers[i].Value = []byte(value)
+			return
+		}
+	}
+	h.Message.Headers = append(h.Message.Headers, sarama.RecordHeader{Key: []byte(key), Value: []byte(value)})
+}
+
+func (h ProducerHeaders) Keys() []string {
+	keys := make([]string, 0, len(h.Message.Headers))
+	for _, header := range h.Message.Headers {
+		keys = append(keys, string(header.Key))
+	}
+	return keys
+}
+
+// ConsumerHeaders lets the propagator read the trace context from the headers
+// of a consumed message.
+type ConsumerHeaders struct {
+	Message *sarama.ConsumerMessage
+}
+
+func (h ConsumerHeaders) Get(key string) string {
+	for _, header := range h.Message.Headers {
+		if header != nil && string(header.Key) == key {
+			return string(header.Value)
+		}
+	}
+	return ""
+}
+
+// Set is a no-op; a consumed message is never re-sent with its headers.
+func (h ConsumerHeaders) Set(string, string) {}
+
+func (h ConsumerHeaders) Keys() []string {
+	keys := make([]string, 0, len(h.Message.Headers))
+	for _, header := range h.Message.Headers {
+		if header != nil {
+			keys = append(keys, string(header.Key))
+		}
+	}
+	return keys
+}
@@ -2,4 +2,6 @@ package dto
 
 type UpdateFieldScheduleStatusRequest struct {
 	FieldScheduleIDs []string `json:"fieldScheduleIDs"`
+	// Status is one of the constants.FieldSchedule* names; empty means booked.
+	Status string `json:"status"`
 }
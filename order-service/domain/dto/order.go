@@ -12,16 +12,28 @@ type OrderRequest struct {
 }
 
 type OrderRequestParam struct {
-	Page       int     `form:"page" validate:"required"`
-	Limit      int     `form:"limit" validate:"required"`
-	SortColumn *string `form:"sortColumn"`
-	SortOrder  *string `form:"sortOrder"`
+	Page       int                          `form:"page" validate:"required_unless=Pagination cursor"`
+	Limit      int                          `form:"limit" validate:"required,max=100"`
+	Pagination string                       `form:"pagination" validate:"omitempty,oneof=offset cursor"`
+	Cursor     *string                      `form:"cursor"`
+	SkipCount  bool                         `form:"skipCount"`
+	SortColumn *string                      `form:"sortColumn" validate:"omitempty,oneof=code amount status date createdAt updatedAt"`
+	SortOrder  *string                      `form:"sortOrder" validate:"omitempty,oneof=asc desc"`
+	VenueID    *string                      `form:"venueID" validate:"omitempty,uuid"`
+	UserID     *string                      `form:"userID" validate:"omitempty,uuid"`
+	Status     *constants.OrderStatusString `form:"status" validate:"omitempty,oneof=pending pending-payment payment-success expired cancelled"`
+	StartDate  *string                      `form:"startDate" validate:"omitempty,datetime=2006-01-02"`
+	EndDate    *string                      `form:"endDate" validate:"omitempty,datetime=2006-01-02"`
+	MinAmount  *float64                     `form:"minAmount" validate:"omitempty,gte=0"`
+	MaxAmount  *float64                     `form:"maxAmount" validate:"omitempty,gte=0"`
+	VenueIDs   []string                     `form:"-"`
 }
 
 type OrderResponse struct {
 	UUID        uuid.UUID                   `json:"uuid"`
 	Code        string                      `json:"code"`
 	UserName    string                      `json:"userName"`
+	VenueID     *uuid.UUID                  `json:"venueID,omitempty"`
 	Amount      float64                     `json:"amount"`
 	Status      constants.OrderStatusString `json:"status"`
 	PaymentLink string                      `json:"paymentLink,omitempty"`
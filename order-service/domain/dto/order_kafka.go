@@ -0,0 +1,27 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type OrderEventData struct {
+	OrderID     uuid.UUID  `json:"orderID"`
+	Code        string     `json:"code"`
+	UserID      uuid.UUID  `json:"userID"`
+	Name        string     `json:"name"`
+	Email       string     `json:"email"`
+	PhoneNumber string     `json:"phoneNumber"`
+	FieldName   string     `json:"fieldName"`
+	Amount      float64    `json:"amount"`
+	PaymentLink string     `json:"paymentLink"`
+	ExpiredAt   *time.Time `json:"expiredAt"`
+	MatchAt     *time.Time `json:"matchAt"`
+}
+
+type OrderContent struct {
+	Event    KafkaEvent                `json:"event"`
+	Metadata KafkaMetaData             `json:"metadata"`
+	Body     KafkaBody[OrderEventData] `json:"body"`
+}
@@ -7,6 +7,7 @@ import (
 
 type PaymentRequest struct {
 	OrderID        uuid.UUID      `json:"orderID"`
+	VenueID        *uuid.UUID     `json:"venueID,omitempty"`
 	ExpiredAt      time.Time      `json:"expiredAt"`
 	Amount         float64        `json:"amount"`
 	Description    string         `json:"description"`
@@ -0,0 +1,15 @@
+package dto
+
+import "github.com/google/uuid"
+
+type ReviewEligibilityRequestParam struct {
+	FieldID string `form:"fieldID" validate:"required,uuid"`
+}
+
+type ReviewEligibilityResponse struct {
+	OrderID          uuid.UUID   `json:"orderID"`
+	UserID           uuid.UUID   `json:"userID"`
+	FieldID          uuid.UUID   `json:"fieldID"`
+	FieldScheduleIDs []uuid.UUID `json:"fieldScheduleIDs"`
+	Eligible         bool        `json:"eligible"`
+}
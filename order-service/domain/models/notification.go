@@ -0,0 +1,44 @@
+package models
+
+import (
+	"time"
+
+	"github.com/anddriii/kita-futsal/order-service/constants"
+	"github.com/google/uuid"
+)
+
+type Notification struct {
+	ID          uint                         `gorm:"primaryKey;autoIncrement"`
+	UUID        uuid.UUID                    `gorm:"type:uuid;not null"`
+	OrderID     uuid.UUID                    `gorm:"type:uuid;not null;uniqueIndex:idx_notification_order_type_channel"`
+	Type        constants.NotificationType   `gorm:"type:varchar(30);not null;uniqueIndex:idx_notification_order_type_channel"`
+	Channel     string                       `gorm:"type:varchar(20);not null;uniqueIndex:idx_notification_order_type_channel"`
+	Name        string                       `gorm:"type:varchar(100);not null"`
+	Email       string                       `gorm:"type:varchar(100)"`
+	PhoneNumber string                       `gorm:"type:varchar(20)"`
+	Subject     string                       `gorm:"type:varchar(255);not null"`
+	Body        string                       `gorm:"type:text;not null"`
+	Status      constants.NotificationStatus `gorm:"type:varchar(20);not null;index"`
+	ScheduledAt time.Time                    `gorm:"type:timestamp;not null;index"`
+	SentAt      *time.Time                   `gorm:"type:timestamp"`
+	Error       *string                      `gorm:"type:text"`
+	CreatedAt   *time.Time
+	UpdatedAt   *time.Time
+}
+
+type NotificationContact struct {
+	ID          uint       `gorm:"primaryKey;autoIncrement"`
+	OrderID     uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex"`
+	UserID      uuid.UUID  `gorm:"type:uuid;not null"`
+	Name        string     `gorm:"type:varchar(100);not null"`
+	Email       string     `gorm:"type:varchar(100)"`
+	PhoneNumber string     `gorm:"type:varchar(20)"`
+	OrderCode   string     `gorm:"type:varchar(30);not null"`
+	FieldName   string     `gorm:"type:varchar(100)"`
+	Amount      float64    `gorm:"type:decimal(10,2);not null"`
+	PaymentLink string     `gorm:"type:text"`
+	ExpiredAt   *time.Time `gorm:"type:timestamp"`
+	MatchAt     *time.Time `gorm:"type:timestamp"`
+	CreatedAt   *time.Time
+	UpdatedAt   *time.Time
+}
@@ -10,9 +10,10 @@ import (
 type Order struct {
 	ID        uint                  `gorm:"primaryKey;autoIncrement"`
 	UUID      uuid.UUID             `gorm:"type:uuid;not null"`
-	Code      string                `gorm:"type:varchar(30);not null"`
+	Code      string                `gorm:"type:varchar(30);not null;uniqueIndex"`
 	UserID    uuid.UUID             `gorm:"type:uuid;not null"`
 	PaymentID uuid.UUID             `gorm:"type:uuid;not null"`
+	VenueID   *uuid.UUID            `gorm:"type:uuid"`
 	Amount    float64               `gorm:"type:decimal(10,2);not null"`
 	Status    constants.OrderStatus `gorm:"type:int;not null"`
 	Date      time.Time             `gorm:"type:timestamp;not null"`
@@ -0,0 +1,8 @@
+package models
+
+// OrderCodeCounter keeps the last order number issued per day. The row is
+// incremented inside the order transaction so concurrent orders never share a code.
+type OrderCodeCounter struct {
+	Date       string `gorm:"type:varchar(8);primaryKey"`
+	LastNumber int    `gorm:"type:int;not null"`
+}
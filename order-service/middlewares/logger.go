@@ -0,0 +1,54 @@
+package middlewares
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/anddriii/kita-futsal/order-service/common/logger"
+	"github.com/anddriii/kita-futsal/order-service/constants"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// RequestID takes the X-Request-ID of the caller, or generates one, puts it in
+// the request context for the logger and the clients, and echoes it back.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := logger.RequestID(c.GetHeader(constants.XRequestID))
+		c.Writer.Header().Set(constants.XRequestID, requestID)
+		c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), constants.RequestID, requestID))
+		c.Next()
+	}
+}
+
+// Logger writes one structured line per request. It replaces the gin logger so
+// access logs carry the request ID and the user like every other line.
+func Logger() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+		status := c.Writer.Status()
+		entry := logger.FromContext(c.Request.Context()).WithFields(logrus.Fields{
+			"method":     c.Request.Method,
+			"route":      route,
+			"status":     status,
+			"latency_ms": time.Since(start).Milliseconds(),
+			"client_ip":  c.ClientIP(),
+		})
+		if err := c.Errors.Last(); err != nil {
+			entry = entry.WithError(err)
+		}
+
+		if status >= http.StatusInternalServerError {
+			entry.Error("request failed")
+			return
+		}
+		entry.Info("request completed")
+	}
+}
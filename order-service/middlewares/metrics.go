@@ -0,0 +1,24 @@
+package middlewares
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/anddriii/kita-futsal/order-service/common/metrics"
+	"github.com/gin-gonic/gin"
+)
+
+// Metrics records the duration and status of every request. Requests that
+// match no route share a single label so unknown paths cannot flood it.
+func Metrics() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+		metrics.ObserveHTTPRequest(c.Request.Method, route, strconv.Itoa(c.Writer.Status()), time.Since(start))
+	}
+}
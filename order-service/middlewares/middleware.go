@@ -2,29 +2,26 @@ package middlewares
 
 import (
 	"context"
-	"crypto/sha256"
-	"encoding/hex"
-	"fmt"
+	"errors"
 	"net/http"
 	"strings"
 
 	"github.com/anddriii/kita-futsal/order-service/clients"
+	"github.com/anddriii/kita-futsal/order-service/common/logger"
 	"github.com/anddriii/kita-futsal/order-service/common/response"
-	"github.com/anddriii/kita-futsal/order-service/config"
 	"github.com/anddriii/kita-futsal/order-service/constants"
 	errConstant "github.com/anddriii/kita-futsal/order-service/constants/error"
 
 	"github.com/didip/tollbooth"
 	"github.com/didip/tollbooth/limiter"
 	"github.com/gin-gonic/gin"
-	"github.com/sirupsen/logrus"
 )
 
 func HandlePanic() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		defer func() {
 			if r := recover(); r != nil {
-				logrus.Errorf("Recovered from panic: %v", r)
+				logger.FromContext(c.Request.Context()).Errorf("recovered from panic: %v", r)
 				c.JSON(http.StatusInternalServerError, response.Response{
 					Status:  constants.Error,
 					Message: errConstant.ErrInternalServerError.Error(),
@@ -66,21 +63,12 @@ func responseUnauthorized(c *gin.Context, message string) {
 	c.Abort()
 }
 
-func validateAPIKey(c *gin.Context) error {
-	apiKey := c.GetHeader(constants.XApiKey)
-	requestAt := c.GetHeader(constants.XRequestAt)
-	serviceName := c.GetHeader(constants.XServiceName)
-	signatureKey := config.Config.SignatureKey
-
-	validateKey := fmt.Sprintf("%s:%s:%s", serviceName, signatureKey, requestAt)
-	hash := sha256.New()
-	hash.Write([]byte(validateKey))
-	resultHash := hex.EncodeToString(hash.Sum(nil))
-
-	if apiKey != resultHash {
-		return errConstant.ErrUnauthorized
-	}
-	return nil
+func responseServiceUnavailable(c *gin.Context) {
+	c.JSON(http.StatusServiceUnavailable, response.Response{
+		Status:  constants.Error,
+		Message: errConstant.ErrServiceUnavailable.Error(),
+	})
+	c.Abort()
 }
 
 func contains(roles []string, role string) bool {
@@ -96,6 +84,10 @@ func CheckRole(roles []string, client clients.IClientRegistry) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		user, err := client.GetUser().GetUserByToken(c.Request.Context())
 		if err != nil {
+			if errors.Is(err, errConstant.ErrServiceUnavailable) {
+				responseServiceUnavailable(c)
+				return
+			}
 			responseUnauthorized(c, errConstant.ErrUnauthorized.Error())
 			return
 		}
@@ -104,8 +96,9 @@ func CheckRole(roles []string, client clients.IClientRegistry) gin.HandlerFunc {
 			responseUnauthorized(c, errConstant.ErrUnauthorized.Error())
 			return
 		}
-		userLogin := c.Request.WithContext(context.WithValue(c.Request.Context(), constants.User, user))
-		c.Request = userLogin
+		ctx := context.WithValue(c.Request.Context(), constants.User, user)
+		ctx = context.WithValue(ctx, constants.UserUUID, user.UUID.String())
+		c.Request = c.Request.WithContext(ctx)
 		c.Next()
 	}
 }
@@ -119,7 +112,7 @@ func Authenticate() gin.HandlerFunc {
 			return
 		}
 
-		err = validateAPIKey(c)
+		err = validateSignature(c)
 		if err != nil {
 			responseUnauthorized(c, err.Error())
 			return
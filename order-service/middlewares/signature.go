@@ -0,0 +1,53 @@
+package middlewares
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/anddriii/kita-futsal/order-service/common/logger"
+	"github.com/anddriii/kita-futsal/order-service/common/signature"
+	"github.com/anddriii/kita-futsal/order-service/config"
+	"github.com/anddriii/kita-futsal/order-service/constants"
+	errConstant "github.com/anddriii/kita-futsal/order-service/constants/error"
+	"github.com/gin-gonic/gin"
+)
+
+var nonces signature.NonceStore
+
+// SetNonceStore installs the store used to reject replayed requests. It is
+// called once at startup, before the server accepts traffic.
+func SetNonceStore(store signature.NonceStore) {
+	nonces = store
+}
+
+// validateSignature verifies the request's HMAC signature. The body is read to
+// be hashed and then put back so controllers can still bind it. The reason
+// for a rejection is only logged; callers always get ErrUnauthorized.
+func validateSignature(c *gin.Context) error {
+	if nonces == nil {
+		logger.FromContext(c.Request.Context()).Error("nonce store is not set, rejecting signed request")
+		return errConstant.ErrUnauthorized
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		return errConstant.ErrUnauthorized
+	}
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+	request := signature.Request{
+		ServiceName: c.GetHeader(constants.XServiceName),
+		Method:      c.Request.Method,
+		Path:        c.Request.URL.RequestURI(),
+		Body:        body,
+		Timestamp:   c.GetHeader(constants.XRequestAt),
+		Nonce:       c.GetHeader(constants.XNonce),
+	}
+	err = signature.Verify(c.Request.Context(), config.Config.SignatureKey, request, c.GetHeader(constants.XSignature), nonces)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Warnf("rejected signature from %q: %v", request.ServiceName, err)
+		return errConstant.ErrUnauthorized
+	}
+
+	return nil
+}
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS notification_contacts;
+DROP TABLE IF EXISTS notifications;
+DROP TABLE IF EXISTS order_fields;
+DROP TABLE IF EXISTS order_histories;
+DROP TABLE IF EXISTS order_code_counters;
+DROP TABLE IF EXISTS orders;
@@ -0,0 +1,82 @@
+-- Initial schema, identical to what AutoMigrate used to create. IF NOT EXISTS lets a
+-- database created by AutoMigrate adopt this migration as is.
+CREATE TABLE IF NOT EXISTS orders (
+    id bigserial PRIMARY KEY,
+    uuid uuid NOT NULL,
+    code varchar(30) NOT NULL,
+    user_id uuid NOT NULL,
+    payment_id uuid NOT NULL,
+    venue_id uuid,
+    amount decimal(10,2) NOT NULL,
+    status int NOT NULL,
+    date timestamp NOT NULL,
+    is_paid boolean NOT NULL,
+    paid_at timestamp,
+    created_at timestamptz,
+    updated_at timestamptz
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_orders_code ON orders (code);
+
+CREATE TABLE IF NOT EXISTS order_code_counters (
+    date varchar(8) PRIMARY KEY,
+    last_number int NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS order_histories (
+    id bigserial PRIMARY KEY,
+    order_id bigint NOT NULL,
+    status varchar(30) NOT NULL,
+    created_at timestamptz,
+    updated_at timestamptz
+);
+
+CREATE TABLE IF NOT EXISTS order_fields (
+    id bigserial PRIMARY KEY,
+    order_id bigint NOT NULL,
+    field_schedule_id uuid NOT NULL,
+    created_at timestamptz,
+    updated_at timestamptz
+);
+
+CREATE TABLE IF NOT EXISTS notifications (
+    id bigserial PRIMARY KEY,
+    uuid uuid NOT NULL,
+    order_id uuid NOT NULL,
+    type varchar(30) NOT NULL,
+    channel varchar(20) NOT NULL,
+    name varchar(100) NOT NULL,
+    email varchar(100),
+    phone_number varchar(20),
+    subject varchar(255) NOT NULL,
+    body text NOT NULL,
+    status varchar(20) NOT NULL,
+    scheduled_at timestamp NOT NULL,
+    sent_at timestamp,
+    error text,
+    created_at timestamptz,
+    updated_at timestamptz
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_notification_order_type_channel ON notifications (order_id, type, channel);
+CREATE INDEX IF NOT EXISTS idx_notifications_status ON notifications (status);
+CREATE INDEX IF NOT EXISTS idx_notifications_scheduled_at ON notifications (scheduled_at);
+
+CREATE TABLE IF NOT EXISTS notification_contacts (
+    id bigserial PRIMARY KEY,
+    order_id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    name varchar(100) NOT NULL,
+    email varchar(100),
+    phone_number varchar(20),
+    order_code varchar(30) NOT NULL,
+    field_name varchar(100),
+    amount decimal(10,2) NOT NULL,
+    payment_link text,
+    expired_at timestamp,
+    match_at timestamp,
+    created_at timestamptz,
+    updated_at timestamptz
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_notification_contacts_order_id ON notification_contacts (order_id);
@@ -0,0 +1,2 @@
+-- Order history pages list orders by the logged in user.
+CREATE INDEX IF NOT EXISTS idx_orders_user_id ON orders (user_id);
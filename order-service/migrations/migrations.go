@@ -0,0 +1,9 @@
+// Package migrations holds the order-service database schema as SQL files named
+// <version>_<name>.up.sql and <version>_<name>.down.sql. A released version must
+// never be edited.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
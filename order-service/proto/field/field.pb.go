@@ -0,0 +1,218 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        (unknown)
+// source: field/field.proto
+
+package field
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type UpdateFieldScheduleStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FieldScheduleIds []string `protobuf:"bytes,1,rep,name=field_schedule_ids,json=fieldScheduleIds,proto3" json:"field_schedule_ids,omitempty"`
+	// Available, Held or Booked; empty means Booked
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *UpdateFieldScheduleStatusRequest) Reset() {
+	*x = UpdateFieldScheduleStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_field_field_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateFieldScheduleStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFieldScheduleStatusRequest) ProtoMessage() {}
+
+func (x *UpdateFieldScheduleStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_field_field_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFieldScheduleStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFieldScheduleStatusRequest) Descriptor() ([]byte, []int) {
+	return file_field_field_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UpdateFieldScheduleStatusRequest) GetFieldScheduleIds() []string {
+	if x != nil {
+		return x.FieldScheduleIds
+	}
+	return nil
+}
+
+func (x *UpdateFieldScheduleStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type UpdateFieldScheduleStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateFieldScheduleStatusResponse) Reset() {
+	*x = UpdateFieldScheduleStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_field_field_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateFieldScheduleStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFieldScheduleStatusResponse) ProtoMessage() {}
+
+func (x *UpdateFieldScheduleStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_field_field_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFieldScheduleStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateFieldScheduleStatusResponse) Descriptor() ([]byte, []int) {
+	return file_field_field_proto_rawDescGZIP(), []int{1}
+}
+
+var File_field_field_proto protoreflect.FileDescriptor
+
+var file_field_field_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x68, 0x0a, 0x20, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c,
+	0x0a, 0x12, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x23, 0x0a, 0x21, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x7e, 0x0a, 0x0c, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x6e, 0x0a, 0x19, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x28, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_field_field_proto_rawDescOnce sync.Once
+	file_field_field_proto_rawDescData = file_field_field_proto_rawDesc
+)
+
+func file_field_field_proto_rawDescGZIP() []byte {
+	file_field_field_proto_rawDescOnce.Do(func() {
+		file_field_field_proto_rawDescData = protoimpl.X.CompressGZIP(file_field_field_proto_rawDescData)
+	})
+	return file_field_field_proto_rawDescData
+}
+
+var file_field_field_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_field_field_proto_goTypes = []interface{}{
+	(*UpdateFieldScheduleStatusRequest)(nil),  // 0: field.UpdateFieldScheduleStatusRequest
+	(*UpdateFieldScheduleStatusResponse)(nil), // 1: field.UpdateFieldScheduleStatusResponse
+}
+var file_field_field_proto_depIdxs = []int32{
+	0, // 0: field.FieldService.UpdateFieldScheduleStatus:input_type -> field.UpdateFieldScheduleStatusRequest
+	1, // 1: field.FieldService.UpdateFieldScheduleStatus:output_type -> field.UpdateFieldScheduleStatusResponse
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_field_field_proto_init() }
+func file_field_field_proto_init() {
+	if File_field_field_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_field_field_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateFieldScheduleStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_field_field_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateFieldScheduleStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_field_field_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_field_field_proto_goTypes,
+		DependencyIndexes: file_field_field_proto_depIdxs,
+		MessageInfos:      file_field_field_proto_msgTypes,
+	}.Build()
+	File_field_field_proto = out.File
+	file_field_field_proto_rawDesc = nil
+	file_field_field_proto_goTypes = nil
+	file_field_field_proto_depIdxs = nil
+}
@@ -0,0 +1,191 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: payment/payment.proto
+
+package payment
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	PaymentService_CreatePaymentLink_FullMethodName  = "/payment.PaymentService/CreatePaymentLink"
+	PaymentService_ExpirePayment_FullMethodName      = "/payment.PaymentService/ExpirePayment"
+	PaymentService_GetPaymentsByUUIDs_FullMethodName = "/payment.PaymentService/GetPaymentsByUUIDs"
+)
+
+// PaymentServiceClient is the client API for PaymentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PaymentServiceClient interface {
+	CreatePaymentLink(ctx context.Context, in *CreatePaymentLinkRequest, opts ...grpc.CallOption) (*Payment, error)
+	// ExpirePayment closes the payment of a cancelled order so it can no longer be
+	// paid. Fails with FAILED_PRECONDITION when the payment is already settled.
+	ExpirePayment(ctx context.Context, in *ExpirePaymentRequest, opts ...grpc.CallOption) (*Payment, error)
+	// GetPaymentsByUUIDs resolves many payments at once; unknown UUIDs are absent
+	// from the response.
+	GetPaymentsByUUIDs(ctx context.Context, in *GetPaymentsByUUIDsRequest, opts ...grpc.CallOption) (*GetPaymentsByUUIDsResponse, error)
+}
+
+type paymentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPaymentServiceClient(cc grpc.ClientConnInterface) PaymentServiceClient {
+	return &paymentServiceClient{cc}
+}
+
+func (c *paymentServiceClient) CreatePaymentLink(ctx context.Context, in *CreatePaymentLinkRequest, opts ...grpc.CallOption) (*Payment, error) {
+	out := new(Payment)
+	err := c.cc.Invoke(ctx, PaymentService_CreatePaymentLink_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ExpirePayment(ctx context.Context, in *ExpirePaymentRequest, opts ...grpc.CallOption) (*Payment, error) {
+	out := new(Payment)
+	err := c.cc.Invoke(ctx, PaymentService_ExpirePayment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetPaymentsByUUIDs(ctx context.Context, in *GetPaymentsByUUIDsRequest, opts ...grpc.CallOption) (*GetPaymentsByUUIDsResponse, error) {
+	out := new(GetPaymentsByUUIDsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_GetPaymentsByUUIDs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PaymentServiceServer is the server API for PaymentService service.
+// All implementations must embed UnimplementedPaymentServiceServer
+// for forward compatibility
+type PaymentServiceServer interface {
+	CreatePaymentLink(context.Context, *CreatePaymentLinkRequest) (*Payment, error)
+	// ExpirePayment closes the payment of a cancelled order so it can no longer be
+	// paid. Fails with FAILED_PRECONDITION when the payment is already settled.
+	ExpirePayment(context.Context, *ExpirePaymentRequest) (*Payment, error)
+	// GetPaymentsByUUIDs resolves many payments at once; unknown UUIDs are absent
+	// from the response.
+	GetPaymentsByUUIDs(context.Context, *GetPaymentsByUUIDsRequest) (*GetPaymentsByUUIDsResponse, error)
+	mustEmbedUnimplementedPaymentServiceServer()
+}
+
+// UnimplementedPaymentServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPaymentServiceServer struct {
+}
+
+func (UnimplementedPaymentServiceServer) CreatePaymentLink(context.Context, *CreatePaymentLinkRequest) (*Payment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePaymentLink not implemented")
+}
+func (UnimplementedPaymentServiceServer) ExpirePayment(context.Context, *ExpirePaymentRequest) (*Payment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpirePayment not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetPaymentsByUUIDs(context.Context, *GetPaymentsByUUIDsRequest) (*GetPaymentsByUUIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPaymentsByUUIDs not implemented")
+}
+func (UnimplementedPaymentServiceServer) mustEmbedUnimplementedPaymentServiceServer() {}
+
+// UnsafePaymentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PaymentServiceServer will
+// result in compilation errors.
+type UnsafePaymentServiceServer interface {
+	mustEmbedUnimplementedPaymentServiceServer()
+}
+
+func RegisterPaymentServiceServer(s grpc.ServiceRegistrar, srv PaymentServiceServer) {
+	s.RegisterService(&PaymentService_ServiceDesc, srv)
+}
+
+func _PaymentService_CreatePaymentLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePaymentLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).CreatePaymentLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_CreatePaymentLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).CreatePaymentLink(ctx, req.(*CreatePaymentLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ExpirePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpirePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ExpirePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ExpirePayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ExpirePayment(ctx, req.(*ExpirePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetPaymentsByUUIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPaymentsByUUIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetPaymentsByUUIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetPaymentsByUUIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetPaymentsByUUIDs(ctx, req.(*GetPaymentsByUUIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PaymentService_ServiceDesc is the grpc.ServiceDesc for PaymentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PaymentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "payment.PaymentService",
+	HandlerType: (*PaymentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePaymentLink",
+			Handler:    _PaymentService_CreatePaymentLink_Handler,
+		},
+		{
+			MethodName: "ExpirePayment",
+			Handler:    _PaymentService_ExpirePayment_Handler,
+		},
+		{
+			MethodName: "GetPaymentsByUUIDs",
+			Handler:    _PaymentService_GetPaymentsByUUIDs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "payment/payment.proto",
+}
@@ -0,0 +1,139 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	errWrap "github.com/anddriii/kita-futsal/order-service/common/error"
+	"github.com/anddriii/kita-futsal/order-service/constants"
+	errConstant "github.com/anddriii/kita-futsal/order-service/constants/error"
+	"github.com/anddriii/kita-futsal/order-service/domain/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type NotificationRepository struct {
+	db *gorm.DB
+}
+
+type INotificationRepository interface {
+	Create(context.Context, *models.Notification) error
+	ClaimDue(context.Context, int) ([]models.Notification, error)
+	Update(context.Context, *models.Notification) error
+	CancelPending(context.Context, uuid.UUID, constants.NotificationType) error
+	UpsertContact(context.Context, *models.NotificationContact) error
+	FindContactByOrderID(context.Context, uuid.UUID) (*models.NotificationContact, error)
+}
+
+func NewNotificationRepository(db *gorm.DB) INotificationRepository {
+	return &NotificationRepository{db: db}
+}
+
+func (n *NotificationRepository) Create(ctx context.Context, notification *models.Notification) error {
+	notification.UUID = uuid.New()
+	err := n.db.
+		WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(notification).
+		Error
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
+
+func (n *NotificationRepository) ClaimDue(ctx context.Context, limit int) ([]models.Notification, error) {
+	var notifications []models.Notification
+	err := n.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND scheduled_at <= ?", constants.NotificationPending, time.Now()).
+			Order("scheduled_at asc").
+			Limit(limit).
+			Find(&notifications).
+			Error
+		if err != nil {
+			return err
+		}
+
+		if len(notifications) == 0 {
+			return nil
+		}
+
+		ids := make([]uint, 0, len(notifications))
+		for _, notification := range notifications {
+			ids = append(ids, notification.ID)
+		}
+
+		return tx.
+			Model(&models.Notification{}).
+			Where("id IN ?", ids).
+			Update("status", constants.NotificationSending).
+			Error
+	})
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return notifications, nil
+}
+
+func (n *NotificationRepository) Update(ctx context.Context, notification *models.Notification) error {
+	err := n.db.
+		WithContext(ctx).
+		Save(notification).
+		Error
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
+
+func (n *NotificationRepository) CancelPending(
+	ctx context.Context,
+	orderID uuid.UUID,
+	notificationType constants.NotificationType,
+) error {
+	err := n.db.
+		WithContext(ctx).
+		Model(&models.Notification{}).
+		Where("order_id = ? AND type = ? AND status = ?", orderID, notificationType, constants.NotificationPending).
+		Update("status", constants.NotificationCancelled).
+		Error
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
+
+func (n *NotificationRepository) UpsertContact(ctx context.Context, contact *models.NotificationContact) error {
+	err := n.db.
+		WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "order_id"}},
+			UpdateAll: true,
+		}).
+		Create(contact).
+		Error
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
+
+func (n *NotificationRepository) FindContactByOrderID(ctx context.Context, orderID uuid.UUID) (*models.NotificationContact, error) {
+	var contact models.NotificationContact
+	err := n.db.
+		WithContext(ctx).
+		Where("order_id = ?", orderID).
+		First(&contact).
+		Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &contact, nil
+}
@@ -4,10 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strconv"
 	"time"
 
 	errWrap "github.com/anddriii/kita-futsal/order-service/common/error"
+	"github.com/anddriii/kita-futsal/order-service/common/query"
+	"github.com/anddriii/kita-futsal/order-service/constants"
 	errConstant "github.com/anddriii/kita-futsal/order-service/constants/error"
 	errOrder "github.com/anddriii/kita-futsal/order-service/constants/error/order"
 	"github.com/anddriii/kita-futsal/order-service/domain/dto"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type OrderRepository struct {
@@ -23,35 +25,83 @@ type OrderRepository struct {
 
 type IOrderRepository interface {
 	FindAllWithPagination(context.Context, *dto.OrderRequestParam) ([]models.Order, int64, error)
+	FindAllWithCursor(context.Context, *dto.OrderRequestParam, *query.Cursor, bool) ([]models.Order, int64, error)
 	FindByUserID(context.Context, string) ([]models.Order, error)
 	FindByUUID(context.Context, string) (*models.Order, error)
+	FindByCode(context.Context, string) (*models.Order, error)
 	Create(context.Context, *gorm.DB, *models.Order) (*models.Order, error)
 	Update(context.Context, *gorm.DB, *models.Order, uuid.UUID) error
+	UpdateFromStatus(context.Context, *gorm.DB, *models.Order, uuid.UUID, ...constants.OrderStatus) (bool, error)
 }
 
 func NewOrderRepository(db *gorm.DB) IOrderRepository {
 	return &OrderRepository{db: db}
 }
 
+var orderQuerySpec = query.Spec{
+	SortColumns: map[string]string{
+		"code":      "code",
+		"amount":    "amount",
+		"status":    "status",
+		"date":      "date",
+		"createdAt": "created_at",
+		"updatedAt": "updated_at",
+	},
+	DefaultSort: clause.OrderByColumn{Column: clause.Column{Name: "created_at"}, Desc: true},
+}
+
+func (o *OrderRepository) filter(ctx context.Context, param *dto.OrderRequestParam) *gorm.DB {
+	var status *constants.OrderStatus
+	if param.Status != nil {
+		value := param.Status.GetStatusInt()
+		status = &value
+	}
+
+	return query.Apply(o.db.WithContext(ctx).Model(&models.Order{}),
+		query.In("venue_id", param.VenueIDs),
+		query.Equal("user_id", param.UserID),
+		query.Equal("status", status),
+		query.DateRange("date", param.StartDate, param.EndDate),
+		query.Range("amount", param.MinAmount, param.MaxAmount),
+	)
+}
+
+func (o *OrderRepository) count(db *gorm.DB, param *dto.OrderRequestParam) (int64, error) {
+	var total int64
+	if param.SkipCount {
+		return total, nil
+	}
+
+	err := db.
+		Session(&gorm.Session{}).
+		Count(&total).
+		Error
+	if err != nil {
+		return 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return total, nil
+}
+
+// FindAllWithPagination fetches one extra row when SkipCount is set so the
+// caller can tell whether a next page exists.
 func (o *OrderRepository) FindAllWithPagination(
 	ctx context.Context,
 	param *dto.OrderRequestParam,
 ) ([]models.Order, int64, error) {
-	var (
-		orders []models.Order
-		sort   string
-		total  int64
-	)
-	if param.SortColumn != nil {
-		sort = fmt.Sprintf("%s %s", *param.SortColumn, *param.SortOrder)
-	} else {
-		sort = "created_at desc"
+	var orders []models.Order
+	sort, err := orderQuerySpec.OrderBy(param.SortColumn, param.SortOrder)
+	if err != nil {
+		return nil, 0, err
 	}
 
+	db := o.filter(ctx, param)
 	limit := param.Limit
 	offset := (param.Page - 1) * limit
-	err := o.db.
-		WithContext(ctx).
+	if param.SkipCount {
+		limit++
+	}
+	err = db.
+		Session(&gorm.Session{}).
 		Limit(limit).
 		Offset(offset).
 		Order(sort).
@@ -61,15 +111,36 @@ func (o *OrderRepository) FindAllWithPagination(
 		return nil, 0, err
 	}
 
-	err = o.db.
-		WithContext(ctx).
-		Model(&models.Order{}).
-		Count(&total).
+	total, err := o.count(db, param)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return orders, total, nil
+}
+
+// FindAllWithCursor fetches limit+1 rows after the cursor; see query.KeysetPage.
+func (o *OrderRepository) FindAllWithCursor(
+	ctx context.Context,
+	param *dto.OrderRequestParam,
+	cursor *query.Cursor,
+	desc bool,
+) ([]models.Order, int64, error) {
+	var orders []models.Order
+	db := o.filter(ctx, param)
+	err := query.Keyset(db.Session(&gorm.Session{}), cursor, desc).
+		Limit(param.Limit + 1).
+		Find(&orders).
 		Error
 	if err != nil {
 		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
 	}
 
+	total, err := o.count(db, param)
+	if err != nil {
+		return nil, 0, err
+	}
+
 	return orders, total, nil
 }
 
@@ -90,6 +161,23 @@ func (o *OrderRepository) FindByUUID(ctx context.Context, uuid string) (*models.
 	return &order, nil
 }
 
+func (o *OrderRepository) FindByCode(ctx context.Context, code string) (*models.Order, error) {
+	var order models.Order
+	err := o.db.
+		WithContext(ctx).
+		Where("code = ?", code).
+		First(&order).
+		Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errWrap.WrapError(errOrder.ErrOrderNotFound)
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return &order, nil
+}
+
 func (o *OrderRepository) FindByUserID(ctx context.Context, userID string) ([]models.Order, error) {
 	var orders []models.Order
 	err := o.db.
@@ -107,28 +195,27 @@ func (o *OrderRepository) FindByUserID(ctx context.Context, userID string) ([]mo
 	return orders, nil
 }
 
-func (o *OrderRepository) incrementCode(ctx context.Context) (*string, error) {
+// nextCode bumps today's counter row with an upsert, so the number resets daily. It
+// runs on its own connection rather than in the order's transaction: that transaction
+// spans the field and payment calls, and holding the counter row lock that long would
+// serialize every order of the day. A rolled back order leaves a gap in the numbers.
+func (o *OrderRepository) nextCode(ctx context.Context) (*string, error) {
 	var (
-		order  *models.Order
-		result string
-		today  = time.Now().Format("20060102")
+		counter models.OrderCodeCounter
+		today   = time.Now().Format("20060102")
 	)
-	err := o.db.WithContext(ctx).Order("id desc").First(&order).Error
+	err := o.db.
+		WithContext(ctx).
+		Raw(`INSERT INTO order_code_counters (date, last_number) VALUES (?, 1)
+			ON CONFLICT (date) DO UPDATE SET last_number = order_code_counters.last_number + 1
+			RETURNING date, last_number`, today).
+		Scan(&counter).
+		Error
 	if err != nil {
-		if !errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, nil
-		}
-	}
-
-	if order.ID != 0 {
-		orderCode := order.Code
-		splitOrderName, _ := strconv.Atoi(orderCode[4:9])
-		code := splitOrderName + 1
-		result = fmt.Sprintf("ORD-%05d-%s", code, today)
-	} else {
-		result = fmt.Sprintf("ORD-%05d-%s", 1, today)
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
 	}
 
+	result := fmt.Sprintf("ORD-%05d-%s", counter.LastNumber, today)
 	return &result, nil
 }
 
@@ -137,19 +224,20 @@ func (o *OrderRepository) Create(
 	tx *gorm.DB,
 	param *models.Order,
 ) (*models.Order, error) {
-	code, err := o.incrementCode(ctx)
+	code, err := o.nextCode(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	order := &models.Order{
-		UUID:   uuid.New(),
-		Code:   *code,
-		UserID: param.UserID,
-		Amount: param.Amount,
-		Date:   param.Date,
-		Status: param.Status,
-		IsPaid: param.IsPaid,
+		UUID:    uuid.New(),
+		Code:    *code,
+		UserID:  param.UserID,
+		VenueID: param.VenueID,
+		Amount:  param.Amount,
+		Date:    param.Date,
+		Status:  param.Status,
+		IsPaid:  param.IsPaid,
 	}
 
 	err = tx.
@@ -179,3 +267,24 @@ func (o *OrderRepository) Update(
 	}
 	return nil
 }
+
+// UpdateFromStatus updates the order only while it is still in one of the given
+// statuses and reports whether it did. The status check and the write are one
+// statement, so two concurrent transitions cannot both apply.
+func (o *OrderRepository) UpdateFromStatus(
+	ctx context.Context,
+	tx *gorm.DB,
+	request *models.Order,
+	uuid uuid.UUID,
+	from ...constants.OrderStatus,
+) (bool, error) {
+	result := tx.
+		WithContext(ctx).
+		Model(&models.Order{}).
+		Where("uuid = ? AND status IN ?", uuid, from).
+		Updates(request)
+	if result.Error != nil {
+		return false, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return result.RowsAffected > 0, nil
+}
@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	notificationRepo "github.com/anddriii/kita-futsal/order-service/repositories/notification"
 	orderRepo "github.com/anddriii/kita-futsal/order-service/repositories/order"
 	orderFieldRepo "github.com/anddriii/kita-futsal/order-service/repositories/orderfield"
 	orderHistoryRepo "github.com/anddriii/kita-futsal/order-service/repositories/orderhistory"
@@ -15,6 +16,7 @@ type IRepositoryRegistry interface {
 	GetOrder() orderRepo.IOrderRepository
 	GetOrderField() orderFieldRepo.IOrderFieldRepository
 	GetOrderHistory() orderHistoryRepo.IOrderHistoryRepository
+	GetNotification() notificationRepo.INotificationRepository
 	GetTx() *gorm.DB
 }
 
@@ -34,6 +36,10 @@ func (r *Registry) GetOrderHistory() orderHistoryRepo.IOrderHistoryRepository {
 	return orderHistoryRepo.NewOrderHistoryRepository(r.db)
 }
 
+func (r *Registry) GetNotification() notificationRepo.INotificationRepository {
+	return notificationRepo.NewNotificationRepository(r.db)
+}
+
 func (r *Registry) GetTx() *gorm.DB {
 	return r.db
 }
@@ -36,15 +36,27 @@ func (o *OrderRoute) Run() {
 	group.GET("", middlewares.CheckRole([]string{
 		constants.Admin,
 		constants.Customer,
+		constants.VenueManager,
 	}, o.client), o.GetOrder().GetAllWithPagination)
+	group.GET("/code/:code", middlewares.CheckRole([]string{
+		constants.Admin,
+		constants.VenueManager,
+	}, o.client), o.GetOrder().GetByCode)
 	group.GET("/:uuid", middlewares.CheckRole([]string{
 		constants.Admin,
 		constants.Customer,
+		constants.VenueManager,
 	}, o.client), o.GetOrder().GetByUUID)
+	group.GET("/:uuid/review-eligibility", middlewares.CheckRole([]string{
+		constants.Customer,
+	}, o.client), o.GetOrder().CheckReviewEligibility)
 	group.GET("/user", middlewares.CheckRole([]string{
 		constants.Customer,
 	}, o.client), o.GetOrder().GetOrderByUserID)
 	group.POST("", middlewares.CheckRole([]string{
 		constants.Customer,
 	}, o.client), o.GetOrder().Create)
+	group.PATCH("/:uuid/cancel", middlewares.CheckRole([]string{
+		constants.Customer,
+	}, o.client), o.GetOrder().Cancel)
 }
@@ -0,0 +1,241 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/anddriii/kita-futsal/order-service/common/logger"
+	"github.com/anddriii/kita-futsal/order-service/common/notification"
+	"github.com/anddriii/kita-futsal/order-service/common/util"
+	"github.com/anddriii/kita-futsal/order-service/config"
+	"github.com/anddriii/kita-futsal/order-service/constants"
+	"github.com/anddriii/kita-futsal/order-service/domain/dto"
+	"github.com/anddriii/kita-futsal/order-service/domain/models"
+	"github.com/anddriii/kita-futsal/order-service/repositories"
+)
+
+const (
+	defaultPaymentReminder = 15 * time.Minute
+	defaultMatchReminder   = 60 * time.Minute
+	sendBatchSize          = 100
+	displayTimeFormat      = "02 Jan 2006 15:04"
+)
+
+type NotificationService struct {
+	repository repositories.IRepositoryRegistry
+	channels   map[string]notification.IChannel
+}
+
+type INotificationService interface {
+	HandleOrderEvent(context.Context, *dto.OrderEventData) error
+	HandlePaymentEvent(context.Context, *dto.PaymentData) error
+	SendDue(context.Context) error
+}
+
+func NewNotificationService(
+	repository repositories.IRepositoryRegistry,
+	channels map[string]notification.IChannel,
+) INotificationService {
+	return &NotificationService{repository: repository, channels: channels}
+}
+
+func (n *NotificationService) HandleOrderEvent(ctx context.Context, request *dto.OrderEventData) error {
+	contact := &models.NotificationContact{
+		OrderID:     request.OrderID,
+		UserID:      request.UserID,
+		Name:        request.Name,
+		Email:       request.Email,
+		PhoneNumber: request.PhoneNumber,
+		OrderCode:   request.Code,
+		FieldName:   request.FieldName,
+		Amount:      request.Amount,
+		PaymentLink: request.PaymentLink,
+		ExpiredAt:   request.ExpiredAt,
+		MatchAt:     request.MatchAt,
+	}
+	err := n.repository.GetNotification().UpsertContact(ctx, contact)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	err = n.schedule(ctx, contact, constants.OrderCreatedNotification, now, nil)
+	if err != nil {
+		return err
+	}
+
+	if contact.ExpiredAt != nil {
+		reminderAt := contact.ExpiredAt.Add(-n.reminderBefore(config.Config.Notification.PaymentReminderInMinutes, defaultPaymentReminder))
+		if reminderAt.After(now) {
+			err = n.schedule(ctx, contact, constants.PaymentReminderNotification, reminderAt, nil)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return n.SendDue(ctx)
+}
+
+func (n *NotificationService) HandlePaymentEvent(ctx context.Context, request *dto.PaymentData) error {
+	contact, err := n.repository.GetNotification().FindContactByOrderID(ctx, request.OrderID)
+	if err != nil {
+		return err
+	}
+
+	if contact == nil {
+		logger.FromContext(ctx).Warnf("notification contact for order %s not found", request.OrderID)
+		return nil
+	}
+
+	now := time.Now()
+	switch request.Status {
+	case constants.SettlementPaymentStatus:
+		err = n.repository.GetNotification().CancelPending(ctx, contact.OrderID, constants.PaymentReminderNotification)
+		if err != nil {
+			return err
+		}
+
+		err = n.schedule(ctx, contact, constants.PaymentSuccessNotification, now, request.InvoiceLink)
+		if err != nil {
+			return err
+		}
+
+		if contact.MatchAt != nil {
+			reminderAt := contact.MatchAt.Add(-n.reminderBefore(config.Config.Notification.MatchReminderInMinutes, defaultMatchReminder))
+			if reminderAt.After(now) {
+				err = n.schedule(ctx, contact, constants.MatchReminderNotification, reminderAt, nil)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	case constants.ExpirePaymentStatus:
+		err = n.repository.GetNotification().CancelPending(ctx, contact.OrderID, constants.PaymentReminderNotification)
+		if err != nil {
+			return err
+		}
+
+		err = n.schedule(ctx, contact, constants.PaymentExpiredNotification, now, nil)
+		if err != nil {
+			return err
+		}
+	default:
+		return nil
+	}
+
+	return n.SendDue(ctx)
+}
+
+func (n *NotificationService) SendDue(ctx context.Context) error {
+	notifications, err := n.repository.GetNotification().ClaimDue(ctx, sendBatchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range notifications {
+		now := time.Now()
+		item.Status = constants.NotificationSent
+		item.SentAt = &now
+		item.Error = nil
+
+		err = n.send(ctx, &item)
+		if err != nil {
+			logger.FromContext(ctx).Errorf("failed to send %s notification for order %s via %s: %v", item.Type, item.OrderID, item.Channel, err)
+			message := err.Error()
+			item.Status = constants.NotificationFailed
+			item.SentAt = nil
+			item.Error = &message
+		}
+
+		err = n.repository.GetNotification().Update(ctx, &item)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (n *NotificationService) send(ctx context.Context, item *models.Notification) error {
+	channel, ok := n.channels[item.Channel]
+	if !ok {
+		return fmt.Errorf("notification channel %s is not configured", item.Channel)
+	}
+
+	return channel.Send(ctx, &notification.Message{
+		Name:        item.Name,
+		Email:       item.Email,
+		PhoneNumber: item.PhoneNumber,
+		Subject:     item.Subject,
+		Body:        item.Body,
+	})
+}
+
+func (n *NotificationService) schedule(
+	ctx context.Context,
+	contact *models.NotificationContact,
+	notificationType constants.NotificationType,
+	scheduledAt time.Time,
+	invoiceLink *string,
+) error {
+	subject, body, err := notification.Render(notificationType, n.templateData(contact, invoiceLink))
+	if err != nil {
+		return err
+	}
+
+	for name, channel := range n.channels {
+		message := &notification.Message{
+			Name:        contact.Name,
+			Email:       contact.Email,
+			PhoneNumber: contact.PhoneNumber,
+		}
+		if !channel.CanSend(message) {
+			continue
+		}
+
+		err = n.repository.GetNotification().Create(ctx, &models.Notification{
+			OrderID:     contact.OrderID,
+			Type:        notificationType,
+			Channel:     name,
+			Name:        contact.Name,
+			Email:       contact.Email,
+			PhoneNumber: contact.PhoneNumber,
+			Subject:     subject,
+			Body:        body,
+			Status:      constants.NotificationPending,
+			ScheduledAt: scheduledAt,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (n *NotificationService) templateData(contact *models.NotificationContact, invoiceLink *string) notification.TemplateData {
+	data := notification.TemplateData{
+		Name:        contact.Name,
+		OrderCode:   contact.OrderCode,
+		FieldName:   contact.FieldName,
+		Amount:      util.RupiahFormat(&contact.Amount),
+		PaymentLink: contact.PaymentLink,
+	}
+	if contact.ExpiredAt != nil {
+		data.ExpiredAt = contact.ExpiredAt.In(time.Local).Format(displayTimeFormat)
+	}
+	if contact.MatchAt != nil {
+		data.MatchAt = contact.MatchAt.In(time.Local).Format(displayTimeFormat)
+	}
+	if invoiceLink != nil {
+		data.InvoiceLink = *invoiceLink
+	}
+	return data
+}
+
+func (n *NotificationService) reminderBefore(minutes int, fallback time.Duration) time.Duration {
+	if minutes <= 0 {
+		return fallback
+	}
+	return time.Duration(minutes) * time.Minute
+}
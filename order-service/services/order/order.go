@@ -2,15 +2,23 @@ package services
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/anddriii/kita-futsal/order-service/clients"
 	clientField "github.com/anddriii/kita-futsal/order-service/clients/field"
 	clientPayment "github.com/anddriii/kita-futsal/order-service/clients/payment"
 	clientUser "github.com/anddriii/kita-futsal/order-service/clients/user"
+	"github.com/anddriii/kita-futsal/order-service/common/event"
+	"github.com/anddriii/kita-futsal/order-service/common/logger"
+	"github.com/anddriii/kita-futsal/order-service/common/metrics"
+	"github.com/anddriii/kita-futsal/order-service/common/query"
 	"github.com/anddriii/kita-futsal/order-service/common/util"
+	configApp "github.com/anddriii/kita-futsal/order-service/config"
 	"github.com/anddriii/kita-futsal/order-service/constants"
+	errConstant "github.com/anddriii/kita-futsal/order-service/constants/error"
 	errOrder "github.com/anddriii/kita-futsal/order-service/constants/error/order"
 	"github.com/anddriii/kita-futsal/order-service/domain/dto"
 	"github.com/anddriii/kita-futsal/order-service/domain/models"
@@ -22,35 +30,179 @@ import (
 type OrderService struct {
 	repository repositories.IRepositoryRegistry
 	client     clients.IClientRegistry
+	producer   event.IProducer
 }
 
 type IOrderService interface {
 	GetAllWithPagination(context.Context, *dto.OrderRequestParam) (*util.PaginationResult, error)
 	GetByUUID(context.Context, string) (*dto.OrderResponse, error)
+	GetByCode(context.Context, string) (*dto.OrderResponse, error)
 	GetOrderByUserID(context.Context) ([]dto.OrderByUserIDResponse, error)
+	CheckReviewEligibility(context.Context, string, *dto.ReviewEligibilityRequestParam) (*dto.ReviewEligibilityResponse, error)
 	Create(context.Context, *dto.OrderRequest) (*dto.OrderResponse, error)
-	HandlePayment(context.Context, *dto.PaymentData) error
+	HandlePayment(context.Context, *dto.PaymentData) (bool, error)
+	Cancel(context.Context, string) (*dto.OrderResponse, error)
 }
 
-func NewOrderService(repository repositories.IRepositoryRegistry, client clients.IClientRegistry) IOrderService {
-	return &OrderService{repository: repository, client: client}
+func NewOrderService(
+	repository repositories.IRepositoryRegistry,
+	client clients.IClientRegistry,
+	producer event.IProducer,
+) IOrderService {
+	return &OrderService{repository: repository, client: client, producer: producer}
+}
+
+func (o *OrderService) managedVenueIDs(ctx context.Context) ([]string, error) {
+	user := ctx.Value(constants.User).(*clientUser.UserData)
+	venues, err := o.client.GetField().GetVenuesByManager(ctx, user.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	venueIDs := make([]string, 0, len(venues))
+	for _, venue := range venues {
+		venueIDs = append(venueIDs, venue.UUID.String())
+	}
+	return venueIDs, nil
+}
+
+func (o *OrderService) resolveVenueFilter(ctx context.Context, param *dto.OrderRequestParam) error {
+	user := ctx.Value(constants.User).(*clientUser.UserData)
+	if user.Role != constants.VenueManager {
+		if param.VenueID != nil && *param.VenueID != "" {
+			param.VenueIDs = []string{*param.VenueID}
+		}
+		return nil
+	}
+
+	venueIDs, err := o.managedVenueIDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	if param.VenueID == nil {
+		param.VenueIDs = venueIDs
+		return nil
+	}
+
+	for _, venueID := range venueIDs {
+		if venueID == *param.VenueID {
+			param.VenueIDs = []string{venueID}
+			return nil
+		}
+	}
+	return errConstant.ErrForbidden
 }
 
 func (o *OrderService) GetAllWithPagination(ctx context.Context, param *dto.OrderRequestParam) (*util.PaginationResult, error) {
+	err := o.resolveVenueFilter(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+
+	if param.Pagination == query.CursorPagination {
+		return o.getAllWithCursor(ctx, param)
+	}
+
 	orders, total, err := o.repository.GetOrder().FindAllWithPagination(ctx, param)
 	if err != nil {
 		return nil, err
 	}
-	orderResults := make([]dto.OrderResponse, 0, len(orders))
-	for _, order := range orders {
-		user, err := o.client.GetUser().GetUserByUUID(ctx, order.UserID)
+
+	hasNext := param.SkipCount && len(orders) > param.Limit
+	if hasNext {
+		orders = orders[:param.Limit]
+	}
+
+	orderResults, err := o.toOrderResponses(ctx, orders)
+	if err != nil {
+		return nil, err
+	}
+
+	paginationParam := util.PaginationParam{
+		Page:      param.Page,
+		Limit:     param.Limit,
+		Count:     total,
+		Data:      orderResults,
+		SkipCount: param.SkipCount,
+		HasNext:   hasNext,
+	}
+
+	response := util.GeneratePagination(paginationParam)
+	return &response, nil
+}
+
+func (o *OrderService) getAllWithCursor(ctx context.Context, param *dto.OrderRequestParam) (*util.PaginationResult, error) {
+	var cursor *query.Cursor
+	desc, err := query.CursorDesc(param.SortColumn, param.SortOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	if param.Cursor != nil && *param.Cursor != "" {
+		cursor, err = query.DecodeCursor(*param.Cursor)
 		if err != nil {
 			return nil, err
 		}
+	}
+
+	orders, total, err := o.repository.GetOrder().FindAllWithCursor(ctx, param, cursor, desc)
+	if err != nil {
+		return nil, err
+	}
+
+	orders, nextCursor, prevCursor := query.KeysetPage(orders, param.Limit, cursor, func(order models.Order) query.Cursor {
+		return query.Cursor{CreatedAt: *order.CreatedAt, ID: order.ID}
+	})
+
+	orderResults, err := o.toOrderResponses(ctx, orders)
+	if err != nil {
+		return nil, err
+	}
+
+	response := util.GenerateCursorPagination(util.CursorPaginationParam{
+		Count:      total,
+		Limit:      param.Limit,
+		SkipCount:  param.SkipCount,
+		NextCursor: nextCursor,
+		PrevCursor: prevCursor,
+		Data:       orderResults,
+	})
+	return &response, nil
+}
+
+func (o *OrderService) toOrderResponses(ctx context.Context, orders []models.Order) ([]dto.OrderResponse, error) {
+	orderResults := make([]dto.OrderResponse, 0, len(orders))
+	if len(orders) == 0 {
+		return orderResults, nil
+	}
+
+	// resolve every user in one call instead of one call per order
+	seen := make(map[uuid.UUID]bool, len(orders))
+	userIDs := make([]uuid.UUID, 0, len(orders))
+	for _, order := range orders {
+		if !seen[order.UserID] {
+			seen[order.UserID] = true
+			userIDs = append(userIDs, order.UserID)
+		}
+	}
+
+	users, err := o.client.GetUser().GetUsersByUUIDs(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	userNames := make(map[uuid.UUID]string, len(users))
+	for _, user := range users {
+		userNames[user.UUID] = user.Name
+	}
+
+	for _, order := range orders {
 		orderResults = append(orderResults, dto.OrderResponse{
 			UUID:      order.UUID,
 			Code:      order.Code,
-			UserName:  user.Name,
+			UserName:  userNames[order.UserID],
+			VenueID:   order.VenueID,
 			Amount:    order.Amount,
 			Status:    order.Status.GetStatusString(),
 			OrderDate: order.Date,
@@ -58,30 +210,35 @@ func (o *OrderService) GetAllWithPagination(ctx context.Context, param *dto.Orde
 			UpdatedAt: *order.UpdatedAt,
 		})
 	}
+	return orderResults, nil
+}
 
-	paginationParam := util.PaginationParam{
-		Page:  param.Page,
-		Limit: param.Limit,
-		Count: total,
-		Data:  orderResults,
+func (o *OrderService) GetByUUID(ctx context.Context, uuid string) (*dto.OrderResponse, error) {
+	order, err := o.repository.GetOrder().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
 	}
 
-	response := util.GeneratePagination(paginationParam)
-	return &response, nil
+	return o.toOrderResponse(ctx, order)
 }
 
-func (o *OrderService) GetByUUID(ctx context.Context, uuid string) (*dto.OrderResponse, error) {
-	var (
-		order *models.Order
-		user  *clientUser.UserData
-		err   error
-	)
-	order, err = o.repository.GetOrder().FindByUUID(ctx, uuid)
+// GetByCode lets front-desk staff look an order up by the code printed on the booking.
+func (o *OrderService) GetByCode(ctx context.Context, code string) (*dto.OrderResponse, error) {
+	order, err := o.repository.GetOrder().FindByCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	return o.toOrderResponse(ctx, order)
+}
+
+func (o *OrderService) toOrderResponse(ctx context.Context, order *models.Order) (*dto.OrderResponse, error) {
+	err := o.checkVenueAccess(ctx, order)
 	if err != nil {
 		return nil, err
 	}
 
-	user, err = o.client.GetUser().GetUserByUUID(ctx, order.UserID)
+	user, err := o.client.GetUser().GetUserByUUID(ctx, order.UserID)
 	if err != nil {
 		return nil, err
 	}
@@ -90,6 +247,7 @@ func (o *OrderService) GetByUUID(ctx context.Context, uuid string) (*dto.OrderRe
 		UUID:      order.UUID,
 		Code:      order.Code,
 		UserName:  user.Name,
+		VenueID:   order.VenueID,
 		Amount:    order.Amount,
 		Status:    order.Status.GetStatusString(),
 		OrderDate: order.Date,
@@ -99,6 +257,29 @@ func (o *OrderService) GetByUUID(ctx context.Context, uuid string) (*dto.OrderRe
 	return &response, nil
 }
 
+func (o *OrderService) checkVenueAccess(ctx context.Context, order *models.Order) error {
+	user := ctx.Value(constants.User).(*clientUser.UserData)
+	if user.Role != constants.VenueManager {
+		return nil
+	}
+
+	if order.VenueID == nil {
+		return errConstant.ErrForbidden
+	}
+
+	venueIDs, err := o.managedVenueIDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, venueID := range venueIDs {
+		if venueID == order.VenueID.String() {
+			return nil
+		}
+	}
+	return errConstant.ErrForbidden
+}
+
 func (o *OrderService) GetOrderByUserID(ctx context.Context) ([]dto.OrderByUserIDResponse, error) {
 	var (
 		order []models.Order
@@ -111,12 +292,27 @@ func (o *OrderService) GetOrderByUserID(ctx context.Context) ([]dto.OrderByUserI
 	}
 
 	orderLists := make([]dto.OrderByUserIDResponse, 0, len(order))
+	if len(order) == 0 {
+		return orderLists, nil
+	}
+
+	paymentIDs := make([]uuid.UUID, 0, len(order))
 	for _, item := range order {
-		payment, err := o.client.GetPayment().GetPaymentByUUID(ctx, item.PaymentID)
-		if err != nil {
-			return nil, err
-		}
+		paymentIDs = append(paymentIDs, item.PaymentID)
+	}
+
+	payments, err := o.client.GetPayment().GetPaymentsByUUIDs(ctx, paymentIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	paymentByUUID := make(map[uuid.UUID]clientPayment.PaymentData, len(payments))
+	for _, payment := range payments {
+		paymentByUUID[payment.UUID] = payment
+	}
 
+	for _, item := range order {
+		payment := paymentByUUID[item.PaymentID]
 		orderLists = append(orderLists, dto.OrderByUserIDResponse{
 			Code:        item.Code,
 			Amount:      fmt.Sprintf("%s", util.RupiahFormat(&item.Amount)),
@@ -129,6 +325,90 @@ func (o *OrderService) GetOrderByUserID(ctx context.Context) ([]dto.OrderByUserI
 	return orderLists, nil
 }
 
+func (o *OrderService) CheckReviewEligibility(
+	ctx context.Context,
+	orderUUID string,
+	param *dto.ReviewEligibilityRequestParam,
+) (*dto.ReviewEligibilityResponse, error) {
+	user := ctx.Value(constants.User).(*clientUser.UserData)
+	order, err := o.repository.GetOrder().FindByUUID(ctx, orderUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if order.UserID != user.UUID {
+		return nil, errConstant.ErrForbidden
+	}
+
+	if order.Status != constants.PaymentSuccess {
+		return nil, errOrder.ErrReviewNotEligible
+	}
+
+	orderFieldSchedules, err := o.repository.GetOrderField().FindByOrderID(ctx, order.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldID := uuid.MustParse(param.FieldID)
+	fieldScheduleIDs := make([]uuid.UUID, 0, len(orderFieldSchedules))
+	for _, item := range orderFieldSchedules {
+		field, err := o.client.GetField().GetFieldByUUID(ctx, item.FieldScheduleID)
+		if err != nil {
+			return nil, err
+		}
+
+		if field.FieldUUID != fieldID {
+			continue
+		}
+
+		_, endAt, err := o.scheduleTimes(field)
+		if err != nil {
+			return nil, err
+		}
+
+		if endAt.Before(time.Now()) {
+			fieldScheduleIDs = append(fieldScheduleIDs, item.FieldScheduleID)
+		}
+	}
+
+	if len(fieldScheduleIDs) == 0 {
+		return nil, errOrder.ErrReviewNotEligible
+	}
+
+	response := dto.ReviewEligibilityResponse{
+		OrderID:          order.UUID,
+		UserID:           order.UserID,
+		FieldID:          fieldID,
+		FieldScheduleIDs: fieldScheduleIDs,
+		Eligible:         true,
+	}
+	return &response, nil
+}
+
+// scheduleTimes parses the schedule date and its "start - end" time range. A slot
+// that ends at or before its start runs past midnight.
+func (o *OrderService) scheduleTimes(field *clientField.FieldData) (time.Time, time.Time, error) {
+	times := strings.Split(field.Time, " - ")
+	if len(times) != 2 {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid field schedule time: %s", field.Time)
+	}
+
+	startAt, err := time.ParseInLocation(time.DateTime, fmt.Sprintf("%s %s", field.Date, times[0]), time.Local)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	endAt, err := time.ParseInLocation(time.DateTime, fmt.Sprintf("%s %s", field.Date, times[1]), time.Local)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	if !endAt.After(startAt) {
+		endAt = endAt.Add(24 * time.Hour)
+	}
+	return startAt, endAt, nil
+}
+
 func (o *OrderService) Create(ctx context.Context, request *dto.OrderRequest) (*dto.OrderResponse, error) {
 	var (
 		order               *models.Order
@@ -138,6 +418,10 @@ func (o *OrderService) Create(ctx context.Context, request *dto.OrderRequest) (*
 		paymentResponse     *clientPayment.PaymentData
 		orderFieldSchedules = make([]models.OrderField, 0, len(request.FieldScheduleIDs))
 		totalAmount         float64
+		venueID             *uuid.UUID
+		matchAt             *time.Time
+		expiredAt           = time.Now().Add(1 * time.Hour)
+		held                bool
 	)
 
 	for _, fieldID := range request.FieldScheduleIDs {
@@ -148,18 +432,32 @@ func (o *OrderService) Create(ctx context.Context, request *dto.OrderRequest) (*
 		}
 
 		totalAmount += field.PricePerHour
-		if field.Status == constants.BookedStatus.String() {
+		if field.Status != constants.FieldScheduleAvailable {
 			return nil, errOrder.ErrFieldAlreadyBooked
 		}
+
+		if field.ClaimedBy != nil && *field.ClaimedBy != user.UUID {
+			return nil, errOrder.ErrFieldClaimed
+		}
+
+		if venueID == nil {
+			venueID = field.VenueUUID
+		}
+
+		startAt, _, err := o.scheduleTimes(field)
+		if err == nil && (matchAt == nil || startAt.Before(*matchAt)) {
+			matchAt = &startAt
+		}
 	}
 
 	err = o.repository.GetTx().Transaction(func(tx *gorm.DB) error {
 		order, txErr = o.repository.GetOrder().Create(ctx, tx, &models.Order{
-			UserID: user.UUID,
-			Amount: totalAmount,
-			Date:   time.Now(),
-			Status: constants.Pending,
-			IsPaid: false,
+			UserID:  user.UUID,
+			VenueID: venueID,
+			Amount:  totalAmount,
+			Date:    time.Now(),
+			Status:  constants.Pending,
+			IsPaid:  false,
 		})
 		if txErr != nil {
 			return txErr
@@ -186,10 +484,18 @@ func (o *OrderService) Create(ctx context.Context, request *dto.OrderRequest) (*
 			return txErr
 		}
 
-		expiredAt := time.Now().Add(1 * time.Hour)
+		// field-service only holds schedules that are still available, so of two orders
+		// created at the same time for the same slot only one gets through
+		txErr = o.setScheduleStatus(ctx, request.FieldScheduleIDs, constants.FieldScheduleHeld)
+		if txErr != nil {
+			return txErr
+		}
+		held = true
+
 		description := fmt.Sprintf("Pembayaran Sewa %s", field.FieldName)
 		paymentResponse, txErr = o.client.GetPayment().CreatePaymentLink(ctx, &dto.PaymentRequest{
 			OrderID:     order.UUID,
+			VenueID:     venueID,
 			ExpiredAt:   expiredAt,
 			Amount:      totalAmount,
 			Description: description,
@@ -221,13 +527,35 @@ func (o *OrderService) Create(ctx context.Context, request *dto.OrderRequest) (*
 		return nil
 	})
 	if err != nil {
+		if held {
+			releaseErr := o.setScheduleStatus(ctx, request.FieldScheduleIDs, constants.FieldScheduleAvailable)
+			if releaseErr != nil {
+				logger.FromContext(ctx).Errorf("failed to release field schedules of a failed order: %v", releaseErr)
+			}
+		}
 		return nil, err
 	}
+	metrics.OrdersCreated.Inc()
+
+	o.produceOrderCreated(ctx, &dto.OrderEventData{
+		OrderID:     order.UUID,
+		Code:        order.Code,
+		UserID:      user.UUID,
+		Name:        user.Name,
+		Email:       user.Email,
+		PhoneNumber: user.PhoneNumber,
+		FieldName:   field.FieldName,
+		Amount:      order.Amount,
+		PaymentLink: paymentResponse.PaymentLink,
+		ExpiredAt:   &expiredAt,
+		MatchAt:     matchAt,
+	})
 
 	response := dto.OrderResponse{
 		UUID:        order.UUID,
 		Code:        order.Code,
 		UserName:    user.Name,
+		VenueID:     order.VenueID,
 		Amount:      order.Amount,
 		Status:      order.Status.GetStatusString(),
 		OrderDate:   order.Date,
@@ -238,6 +566,33 @@ func (o *OrderService) Create(ctx context.Context, request *dto.OrderRequest) (*
 	return &response, nil
 }
 
+func (o *OrderService) produceOrderCreated(ctx context.Context, data *dto.OrderEventData) {
+	message := dto.OrderContent{
+		Event: dto.KafkaEvent{
+			Name: constants.OrderCreatedEvent,
+		},
+		Metadata: dto.KafkaMetaData{
+			Sender:    configApp.Config.AppName,
+			SendingAt: time.Now().Format(time.RFC3339),
+		},
+		Body: dto.KafkaBody[dto.OrderEventData]{
+			Type: "JSON",
+			Data: *data,
+		},
+	}
+
+	messageJSON, err := json.Marshal(message)
+	if err != nil {
+		logger.FromContext(ctx).Errorf("failed to marshal order event: %v", err)
+		return
+	}
+
+	err = o.producer.ProduceMessage(ctx, constants.OrderTopic, messageJSON)
+	if err != nil {
+		logger.FromContext(ctx).Errorf("failed to produce order event for %s: %v", data.OrderID, err)
+	}
+}
+
 func (o *OrderService) mapPaymentStatusToOrder(request *dto.PaymentData) (constants.OrderStatus, *models.Order) {
 	var (
 		status constants.OrderStatus
@@ -271,51 +626,140 @@ func (o *OrderService) mapPaymentStatusToOrder(request *dto.PaymentData) (consta
 	return status, order
 }
 
-func (o *OrderService) HandlePayment(ctx context.Context, request *dto.PaymentData) error {
-	var (
-		err, txErr          error
-		order               *models.Order
-		orderFieldSchedules []models.OrderField
-	)
+// HandlePayment applies a payment status to the order. The order's field schedules, held
+// since the order was created, are booked on settlement and released on expiry so the
+// waitlist can offer them again. Only unpaid orders are updated: a cancelled order's
+// schedules are already released and may be booked by someone else, so a late payment
+// update must not touch them. It reports whether the order was updated.
+func (o *OrderService) HandlePayment(ctx context.Context, request *dto.PaymentData) (bool, error) {
+	order, err := o.repository.GetOrder().FindByUUID(ctx, request.OrderID.String())
+	if err != nil {
+		return false, err
+	}
+
+	var applied bool
 	status, body := o.mapPaymentStatusToOrder(request)
 	err = o.repository.GetTx().Transaction(func(tx *gorm.DB) error {
-		txErr = o.repository.GetOrder().Update(ctx, tx, body, request.OrderID)
+		var txErr error
+		applied, txErr = o.repository.GetOrder().UpdateFromStatus(ctx, tx, body, request.OrderID,
+			constants.Pending, constants.PendingPayment)
+		if txErr != nil || !applied {
+			return txErr
+		}
+
+		txErr = o.repository.GetOrderHistory().Create(ctx, tx, &dto.OrderHistoryRequest{
+			Status:  status.GetStatusString(),
+			OrderID: order.ID,
+		})
 		if txErr != nil {
 			return txErr
 		}
 
-		order, txErr = o.repository.GetOrder().FindByUUID(ctx, request.OrderID.String())
+		switch request.Status {
+		case constants.SettlementPaymentStatus:
+			return o.updateScheduleStatus(ctx, order.ID, constants.FieldScheduleBooked)
+		case constants.ExpirePaymentStatus:
+			return o.updateScheduleStatus(ctx, order.ID, constants.FieldScheduleAvailable)
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if !applied && request.Status == constants.SettlementPaymentStatus {
+		// a redelivered settlement finds the order already paid; any other status
+		// means the order was cancelled or expired before the payment arrived
+		current, err := o.repository.GetOrder().FindByUUID(ctx, request.OrderID.String())
+		if err != nil {
+			return false, err
+		}
+		if current.Status != constants.PaymentSuccess {
+			logger.FromContext(ctx).Errorf("order %s was paid after it was %s and needs a refund",
+				current.UUID, current.Status.GetStatusString())
+		}
+	}
+	return applied, nil
+}
+
+// Cancel lets a customer drop an order they have not paid yet. The payment link is
+// expired first so it can no longer be paid, then the order is cancelled only if it is
+// still unpaid, its field schedules are released right away and its pending payment
+// reminders are dropped.
+func (o *OrderService) Cancel(ctx context.Context, uuid string) (*dto.OrderResponse, error) {
+	user := ctx.Value(constants.User).(*clientUser.UserData)
+	order, err := o.repository.GetOrder().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	if order.UserID != user.UUID {
+		return nil, errConstant.ErrForbidden
+	}
+
+	if order.Status != constants.Pending && order.Status != constants.PendingPayment {
+		return nil, errOrder.ErrOrderNotCancelable
+	}
+
+	err = o.client.GetPayment().ExpirePayment(ctx, order.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = o.repository.GetTx().Transaction(func(tx *gorm.DB) error {
+		applied, txErr := o.repository.GetOrder().UpdateFromStatus(ctx, tx, &models.Order{
+			Status: constants.Cancelled,
+		}, order.UUID, constants.Pending, constants.PendingPayment)
 		if txErr != nil {
 			return txErr
 		}
+		if !applied {
+			return errOrder.ErrOrderNotCancelable
+		}
 
 		txErr = o.repository.GetOrderHistory().Create(ctx, tx, &dto.OrderHistoryRequest{
-			Status:  status.GetStatusString(),
+			Status:  constants.Cancelled.GetStatusString(),
 			OrderID: order.ID,
 		})
+		if txErr != nil {
+			return txErr
+		}
 
-		if request.Status == constants.SettlementPaymentStatus {
-			orderFieldSchedules, txErr = o.repository.GetOrderField().FindByOrderID(ctx, order.ID)
-			if txErr != nil {
-				return txErr
-			}
+		return o.updateScheduleStatus(ctx, order.ID, constants.FieldScheduleAvailable)
+	})
+	if err != nil {
+		return nil, err
+	}
 
-			filedScheduleIDs := make([]string, 0, len(orderFieldSchedules))
-			for _, item := range orderFieldSchedules {
-				filedScheduleIDs = append(filedScheduleIDs, item.FieldScheduleID.String())
-			}
+	// the order is already cancelled at this point, so a failure here only leaves a
+	// stale reminder behind and must not fail the request
+	err = o.repository.GetNotification().CancelPending(ctx, order.UUID, constants.PaymentReminderNotification)
+	if err != nil {
+		logger.FromContext(ctx).Errorf("failed to cancel payment reminders of order %s: %v", order.UUID, err)
+	}
 
-			txErr = o.client.GetField().UpdateStatus(&dto.UpdateFieldScheduleStatusRequest{
-				FieldScheduleIDs: filedScheduleIDs,
-			})
-			if txErr != nil {
-				return txErr
-			}
-		}
-		return nil
-	})
+	order.Status = constants.Cancelled
+	return o.toOrderResponse(ctx, order)
+}
+
+// updateScheduleStatus sets the status of every field schedule in the order.
+func (o *OrderService) updateScheduleStatus(ctx context.Context, orderID uint, status string) error {
+	orderFields, err := o.repository.GetOrderField().FindByOrderID(ctx, orderID)
 	if err != nil {
 		return err
 	}
-	return nil
+
+	fieldScheduleIDs := make([]string, 0, len(orderFields))
+	for _, item := range orderFields {
+		fieldScheduleIDs = append(fieldScheduleIDs, item.FieldScheduleID.String())
+	}
+
+	return o.setScheduleStatus(ctx, fieldScheduleIDs, status)
+}
+
+func (o *OrderService) setScheduleStatus(ctx context.Context, fieldScheduleIDs []string, status string) error {
+	return o.client.GetField().UpdateStatus(ctx, &dto.UpdateFieldScheduleStatusRequest{
+		FieldScheduleIDs: fieldScheduleIDs,
+		Status:           status,
+	})
 }
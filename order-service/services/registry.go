@@ -2,23 +2,43 @@ package services
 
 import (
 	"github.com/anddriii/kita-futsal/order-service/clients"
+	"github.com/anddriii/kita-futsal/order-service/common/event"
+	"github.com/anddriii/kita-futsal/order-service/common/notification"
+	"github.com/anddriii/kita-futsal/order-service/config"
 	"github.com/anddriii/kita-futsal/order-service/repositories"
+	notificationService "github.com/anddriii/kita-futsal/order-service/services/notification"
 	services "github.com/anddriii/kita-futsal/order-service/services/order"
 )
 
 type Registry struct {
 	repository repositories.IRepositoryRegistry
 	client     clients.IClientRegistry
+	producer   event.IProducer
+	channels   map[string]notification.IChannel
 }
 
 type IServiceRegistry interface {
 	GetOrder() services.IOrderService
+	GetNotification() notificationService.INotificationService
 }
 
-func NewServiceRegistry(repository repositories.IRepositoryRegistry, client clients.IClientRegistry) IServiceRegistry {
-	return &Registry{repository: repository, client: client}
+func NewServiceRegistry(
+	repository repositories.IRepositoryRegistry,
+	client clients.IClientRegistry,
+	producer event.IProducer,
+) IServiceRegistry {
+	return &Registry{
+		repository: repository,
+		client:     client,
+		producer:   producer,
+		channels:   notification.NewChannels(config.Config.Notification),
+	}
 }
 
 func (r *Registry) GetOrder() services.IOrderService {
-	return services.NewOrderService(r.repository, r.client)
+	return services.NewOrderService(r.repository, r.client, r.producer)
+}
+
+func (r *Registry) GetNotification() notificationService.INotificationService {
+	return notificationService.NewNotificationService(r.repository, r.channels)
 }
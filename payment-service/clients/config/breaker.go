@@ -0,0 +1,102 @@
+package config
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	breakerFailureThreshold = 5
+	breakerOpenTimeout      = 30 * time.Second
+)
+
+type breakerState int
+
+const (
+	breakerClosed breakerState = iota
+	breakerOpen
+	breakerHalfOpen
+)
+
+// CircuitBreaker menghentikan request ke downstream setelah beberapa kegagalan berturut-turut.
+// Setelah open timeout lewat, satu request percobaan diizinkan: jika berhasil breaker tertutup
+// kembali, jika gagal breaker terbuka lagi.
+type CircuitBreaker struct {
+	mu        sync.Mutex
+	state     breakerState
+	failures  int
+	openedAt  time.Time
+	probing   bool
+	threshold int
+	timeout   time.Duration
+}
+
+var (
+	breakersMu sync.Mutex
+	breakers   = map[string]*CircuitBreaker{}
+)
+
+// breakerFor mengambil breaker milik downstream. ClientConfig dibuat ulang setiap kali
+// registry dipanggil, sehingga breaker disimpan di sini agar statusnya tidak hilang.
+func breakerFor(name string) *CircuitBreaker {
+	breakersMu.Lock()
+	defer breakersMu.Unlock()
+
+	breaker, ok := breakers[name]
+	if !ok {
+		breaker = &CircuitBreaker{threshold: breakerFailureThreshold, timeout: breakerOpenTimeout}
+		breakers[name] = breaker
+	}
+	return breaker
+}
+
+func (b *CircuitBreaker) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case breakerOpen:
+		if time.Since(b.openedAt) < b.timeout {
+			return false
+		}
+		b.state = breakerHalfOpen
+		b.probing = true
+		return true
+	case breakerHalfOpen:
+		if b.probing {
+			return false
+		}
+		b.probing = true
+		return true
+	}
+	return true
+}
+
+func (b *CircuitBreaker) Success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.state = breakerClosed
+	b.failures = 0
+	b.probing = false
+}
+
+func (b *CircuitBreaker) Failure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	b.probing = false
+	if b.state == breakerHalfOpen || b.failures >= b.threshold {
+		b.state = breakerOpen
+		b.openedAt = time.Now()
+	}
+}
+
+// Cancel melepas request percobaan yang hasilnya tidak diketahui.
+func (b *CircuitBreaker) Cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+}
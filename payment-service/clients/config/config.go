@@ -1,44 +1,61 @@
 package config
 
 import (
-	"fmt"
+	"context"
+	"net/http"
+	"time"
+)
 
-	"github.com/parnurzeal/gorequest"
+const (
+	defaultTimeout    = 5 * time.Second
+	defaultMaxRetries = 2
+	defaultBackoff    = 100 * time.Millisecond
 )
 
+// httpClient dipakai bersama oleh semua downstream agar koneksi bisa di-pool.
+// Berbeda dengan gorequest.SuperAgent sebelumnya, http.Client aman dipakai secara concurrent.
+var httpClient = &http.Client{}
+
 type ClientConfig struct {
-	client       *gorequest.SuperAgent
+	name         string
 	baseUrl      string
 	signatureKey string
+	timeout      time.Duration
+	maxRetries   int
+	backoff      time.Duration
 }
 
 type IClientConfig interface {
-	Client() *gorequest.SuperAgent
+	Name() string
 	BaseUrl() string
 	SignatureKey() string
+	Do(ctx context.Context, request Request) (*Response, error)
+	Ping(ctx context.Context) error
 }
 
 type Option func(*ClientConfig)
 
 func NewClientConfig(options ...Option) IClientConfig {
 	clientConfig := &ClientConfig{
-		client: gorequest.New().
-			Set("Content-Type", "application/json").
-			Set("Accept", "application/json"),
+		timeout:    defaultTimeout,
+		maxRetries: defaultMaxRetries,
+		backoff:    defaultBackoff,
 	}
 	for _, option := range options {
 		option(clientConfig)
 	}
+	if clientConfig.name == "" {
+		clientConfig.name = clientConfig.baseUrl
+	}
 
 	return clientConfig
 }
 
-func (c *ClientConfig) Client() *gorequest.SuperAgent {
-	return c.client
+func (c *ClientConfig) Name() string {
+	return c.name
 }
 
 func (c *ClientConfig) BaseUrl() string {
-	fmt.Println(c.baseUrl)
 	return c.baseUrl
 }
 
@@ -46,6 +63,13 @@ func (c *ClientConfig) SignatureKey() string {
 	return c.signatureKey
 }
 
+// WithName memberi nama downstream. Client dengan nama yang sama memakai circuit breaker yang sama.
+func WithName(name string) Option {
+	return func(cc *ClientConfig) {
+		cc.name = name
+	}
+}
+
 func WithBaseURL(baseURL string) Option {
 	return func(cc *ClientConfig) {
 		cc.baseUrl = baseURL
@@ -57,3 +81,18 @@ func WithSignatureKey(signatureKey string) Option {
 		cc.signatureKey = signatureKey
 	}
 }
+
+// WithTimeout membatasi durasi setiap percobaan request, di luar deadline milik pemanggil.
+func WithTimeout(timeout time.Duration) Option {
+	return func(cc *ClientConfig) {
+		cc.timeout = timeout
+	}
+}
+
+// WithRetry mengatur berapa kali request idempotent diulang dan jeda dasar backoff di antara percobaan.
+func WithRetry(maxRetries int, backoff time.Duration) Option {
+	return func(cc *ClientConfig) {
+		cc.maxRetries = maxRetries
+		cc.backoff = backoff
+	}
+}
@@ -0,0 +1,50 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	errConst "github.com/anddriii/kita-futsal/payment-service/constants/error"
+)
+
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// DownstreamError menandakan service lain tidak bisa melayani request: breaker sedang terbuka,
+// timeout, tidak bisa dihubungi, atau terus membalas 5xx. Error ini cocok dengan
+// errConst.ErrServiceUnavailable sehingga handler bisa membalas 503.
+type DownstreamError struct {
+	Service    string
+	StatusCode int
+	Err        error
+}
+
+func (e *DownstreamError) Error() string {
+	return fmt.Sprintf("%s service unavailable: %v", e.Service, e.Err)
+}
+
+func (e *DownstreamError) Unwrap() error {
+	return e.Err
+}
+
+func (e *DownstreamError) Is(target error) bool {
+	return target == errConst.ErrServiceUnavailable
+}
+
+// errorReason memberi nama penyebab gagalnya panggilan untuk metric client, atau "" jika
+// berhasil. rejected berarti downstream sengaja membalas dengan error.
+func errorReason(err error) string {
+	var downstreamErr *DownstreamError
+	switch {
+	case err == nil:
+		return ""
+	case errors.Is(err, ErrCircuitOpen):
+		return "circuit_open"
+	case errors.As(err, &downstreamErr):
+		return "unavailable"
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return "canceled"
+	default:
+		return "rejected"
+	}
+}
@@ -0,0 +1,215 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/anddriii/kita-futsal/payment-service/common/metrics"
+	"github.com/anddriii/kita-futsal/payment-service/common/signature"
+	"github.com/anddriii/kita-futsal/payment-service/common/tracing"
+	configApp "github.com/anddriii/kita-futsal/payment-service/config"
+	"github.com/anddriii/kita-futsal/payment-service/constants"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Request berisi data request ke service lain.
+type Request struct {
+	Method string
+	URL    string
+	Header map[string]string
+	Query  url.Values
+	Body   any
+	// Idempotent menandai request selain GET yang aman diulang, misalnya lookup batch via POST.
+	Idempotent bool
+}
+
+// Response berisi status code dan body mentah dari service lain.
+type Response struct {
+	StatusCode int
+	Body       []byte
+}
+
+// Decode mengubah body response JSON ke struct tujuan.
+func (r *Response) Decode(out any) error {
+	if len(r.Body) == 0 {
+		return nil
+	}
+	return json.Unmarshal(r.Body, out)
+}
+
+func (r *Request) retryable() bool {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return r.Idempotent
+}
+
+// Do mengirim request melalui circuit breaker milik downstream.
+// Error jaringan, timeout dan response 5xx diulang untuk request idempotent, lalu dikembalikan
+// sebagai *DownstreamError jika semua percobaan gagal. Response lain dikembalikan apa adanya
+// agar bisa dibaca oleh pemanggil. Latency dan hasil seluruh panggilan, termasuk retry,
+// dicatat ke metric client.
+func (c *ClientConfig) Do(ctx context.Context, request Request) (*Response, error) {
+	start := time.Now()
+	resp, err := c.do(ctx, request)
+	metrics.ObserveClientCall(c.name, time.Since(start), errorReason(err))
+	return resp, err
+}
+
+func (c *ClientConfig) do(ctx context.Context, request Request) (*Response, error) {
+	var payload []byte
+	if request.Body != nil {
+		var err error
+		payload, err = json.Marshal(request.Body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	attempts := 1
+	if request.retryable() {
+		attempts += c.maxRetries
+	}
+
+	breaker := breakerFor(c.name)
+	downstreamErr := &DownstreamError{Service: c.name}
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 {
+			err := sleep(ctx, jitter(c.backoff, attempt))
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if !breaker.Allow() {
+			downstreamErr.Err = ErrCircuitOpen
+			return nil, downstreamErr
+		}
+
+		resp, err := c.send(ctx, &request, payload)
+		if err != nil && ctx.Err() != nil {
+			// pemanggil sudah menyerah, ini bukan kesalahan downstream
+			breaker.Cancel()
+			return nil, ctx.Err()
+		}
+		if err == nil && resp.StatusCode < http.StatusInternalServerError {
+			breaker.Success()
+			return resp, nil
+		}
+
+		breaker.Failure()
+		downstreamErr.StatusCode, downstreamErr.Err = 0, err
+		if resp != nil {
+			downstreamErr.StatusCode = resp.StatusCode
+			downstreamErr.Err = errors.New(http.StatusText(resp.StatusCode))
+		}
+	}
+
+	return nil, downstreamErr
+}
+
+// send menjalankan satu percobaan di dalam span client sendiri, sehingga setiap retry
+// terlihat berdampingan di trace.
+func (c *ClientConfig) send(ctx context.Context, request *Request, payload []byte) (*Response, error) {
+	ctx, span := tracing.Tracer().Start(ctx, fmt.Sprintf("%s %s", request.Method, c.name),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.HTTPRequestMethodKey.String(request.Method),
+			semconv.URLFull(request.URL),
+			semconv.PeerService(c.name),
+		),
+	)
+
+	resp, err := c.roundTrip(ctx, request, payload)
+	if err != nil {
+		tracing.End(span, err)
+		return nil, err
+	}
+	span.SetAttributes(semconv.HTTPResponseStatusCode(resp.StatusCode))
+	if resp.StatusCode >= http.StatusInternalServerError {
+		span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+	}
+	span.End()
+	return resp, nil
+}
+
+func (c *ClientConfig) roundTrip(ctx context.Context, request *Request, payload []byte) (*Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, request.Method, request.URL, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	for key, value := range request.Header {
+		req.Header.Set(key, value)
+	}
+	if len(request.Query) > 0 {
+		req.URL.RawQuery = request.Query.Encode()
+	}
+
+	// signature dibuat ulang di setiap percobaan karena penerima hanya menerima satu nonce sekali
+	signed := signature.New(configApp.Config.AppName, req.Method, req.URL.RequestURI(), payload)
+	req.Header.Set(constants.XServiceName, signed.ServiceName)
+	req.Header.Set(constants.XRequestAt, signed.Timestamp)
+	req.Header.Set(constants.XNonce, signed.Nonce)
+	req.Header.Set(constants.XSignature, signed.Sign(c.signatureKey))
+	if requestID, ok := ctx.Value(constants.RequestID).(string); ok && requestID != "" {
+		req.Header.Set(constants.XRequestID, requestID)
+	}
+	tracing.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Response{StatusCode: resp.StatusCode, Body: respBody}, nil
+}
+
+// jitter menghasilkan jeda acak antara 0 dan base*2^attempt (full jitter)
+// agar pemanggil yang retry tidak menyerang downstream secara bersamaan.
+func jitter(base time.Duration, attempt int) time.Duration {
+	ceiling := base << attempt
+	if ceiling <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(ceiling)))
+}
+
+func sleep(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
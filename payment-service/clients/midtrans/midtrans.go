@@ -1,14 +1,20 @@
 package clients
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"time"
 
+	"github.com/anddriii/kita-futsal/payment-service/common/logger"
+	"github.com/anddriii/kita-futsal/payment-service/common/tracing"
 	errConstant "github.com/anddriii/kita-futsal/payment-service/constants/error/payment"
 	"github.com/anddriii/kita-futsal/payment-service/domains/dto"
 	"github.com/midtrans/midtrans-go"
+	"github.com/midtrans/midtrans-go/coreapi"
 	"github.com/midtrans/midtrans-go/snap"
-	"github.com/sirupsen/logrus"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // MidtransClient adalah struktur utama untuk melakukan komunikasi dengan Midtrans.
@@ -19,9 +25,10 @@ type MidtransClient struct {
 }
 
 // IMidtransClient adalah interface yang menyediakan kontrak fungsi untuk interaksi Midtrans,
-// saat ini hanya menyediakan fungsi pembuatan payment link.
+// yaitu pembuatan payment link dan penutupan transaksi yang belum dibayar.
 type IMidtransClient interface {
-	CreatePaymentLink(request *dto.PaymentRequest) (*MidtransData, error)
+	CreatePaymentLink(ctx context.Context, request *dto.PaymentRequest) (*MidtransData, error)
+	ExpirePayment(ctx context.Context, orderID string) error
 }
 
 // NewMidtransClient mengembalikan instance MidtransClient baru.
@@ -35,12 +42,13 @@ func NewMidtransClient(serverKey string, isProduction bool) *MidtransClient {
 
 // CreatePaymentLink membuat transaksi pembayaran di Midtrans Snap dan mengembalikan token serta URL redirect.
 // Parameter:
+//   - ctx: context request, dipakai untuk span tracing dan logger
 //   - request: pointer ke dto.PaymentRequest yang berisi detail transaksi seperti order ID, customer, item, dll.
 //
 // Return:
 //   - *MidtransData: berisi token dan URL redirect dari Snap Midtrans jika sukses
 //   - error: error jika terjadi kegagalan saat membuat transaksi
-func (c *MidtransClient) CreatePaymentLink(request *dto.PaymentRequest) (*MidtransData, error) {
+func (c *MidtransClient) CreatePaymentLink(ctx context.Context, request *dto.PaymentRequest) (*MidtransData, error) {
 	var (
 		snapClient   snap.Client
 		isProduction = midtrans.Sandbox // Default ke sandbox
@@ -52,7 +60,7 @@ func (c *MidtransClient) CreatePaymentLink(request *dto.PaymentRequest) (*Midtra
 	duration := expireDateTime.Sub(currentTime)
 
 	if duration <= 0 {
-		logrus.Errorf("Expired at is invalid")
+		logger.FromContext(ctx).Errorf("Expired at is invalid")
 		return nil, errConstant.ErrExpireArInvalid
 	}
 
@@ -109,14 +117,26 @@ func (c *MidtransClient) CreatePaymentLink(request *dto.PaymentRequest) (*Midtra
 		},
 	}
 
-	fmt.Printf("Midtrans Snap Request: %+v\n", req)
-
-	// Kirim request transaksi ke Midtrans Snap API
-	response, err := snapClient.CreateTransaction(req)
-	if err != nil {
-		logrus.Errorf("Failed to create transaction: %v", err)
-		return nil, err
+	// Kirim request transaksi ke Midtrans Snap API di dalam span client agar latensi dan
+	// kegagalan Midtrans terlihat di trace
+	url := fmt.Sprintf("%s/snap/v1/transactions", isProduction.SnapURL())
+	_, span := tracing.Tracer().Start(ctx, "POST midtrans",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.HTTPRequestMethodKey.String(http.MethodPost),
+			semconv.URLFull(url),
+			semconv.PeerService("midtrans"),
+		),
+	)
+	// midtransErr bertipe *midtrans.Error; nilai nil-nya tidak boleh diteruskan sebagai
+	// error interface karena akan dianggap tidak nil
+	response, midtransErr := snapClient.CreateTransaction(req)
+	if midtransErr != nil {
+		tracing.End(span, midtransErr)
+		logger.FromContext(ctx).Errorf("Failed to create transaction: %v", midtransErr)
+		return nil, midtransErr
 	}
+	tracing.End(span, nil)
 
 	// Jika sukses, kembalikan token dan URL redirect
 	return &MidtransData{
@@ -124,3 +144,36 @@ func (c *MidtransClient) CreatePaymentLink(request *dto.PaymentRequest) (*Midtra
 		Token:       response.Token,
 	}, nil
 }
+
+// ExpirePayment menutup transaksi Midtrans milik order agar payment link tidak bisa
+// dibayar lagi. Midtrans menjawab 404 jika customer belum memilih metode pembayaran
+// di Snap; pada kondisi itu belum ada transaksi yang bisa dibayar sehingga dianggap
+// sukses.
+func (c *MidtransClient) ExpirePayment(ctx context.Context, orderID string) error {
+	var (
+		coreClient   coreapi.Client
+		isProduction = midtrans.Sandbox
+	)
+	if c.IsProduction {
+		isProduction = midtrans.Production
+	}
+	coreClient.New(c.ServerKey, isProduction)
+
+	url := fmt.Sprintf("%s/v2/%s/expire", isProduction.BaseUrl(), orderID)
+	_, span := tracing.Tracer().Start(ctx, "POST midtrans",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.HTTPRequestMethodKey.String(http.MethodPost),
+			semconv.URLFull(url),
+			semconv.PeerService("midtrans"),
+		),
+	)
+	_, midtransErr := coreClient.ExpireTransaction(orderID)
+	if midtransErr != nil && midtransErr.GetStatusCode() != http.StatusNotFound {
+		tracing.End(span, midtransErr)
+		logger.FromContext(ctx).Errorf("Failed to expire transaction: %v", midtransErr)
+		return midtransErr
+	}
+	tracing.End(span, nil)
+	return nil
+}
@@ -11,6 +11,7 @@ type ClientRegistry struct {
 
 type IClientRegistry interface {
 	GetUser() clients.IUserClient
+	Downstreams() []config.IClientConfig
 }
 
 func NewClientRegistry() IClientRegistry {
@@ -18,10 +19,18 @@ func NewClientRegistry() IClientRegistry {
 }
 
 func (c *ClientRegistry) GetUser() clients.IUserClient {
-	return clients.NewUserClient(
-		config.NewClientConfig(
-			config.WithBaseURL(config2.Config.InternalService.User.Host),
-			config.WithSignatureKey(config2.Config.InternalService.User.SignatureKey),
-		))
+	return clients.NewUserClient(userConfig())
+}
+
+// Downstreams berisi semua service yang dipanggil oleh service ini, untuk pemeriksaan readiness.
+func (c *ClientRegistry) Downstreams() []config.IClientConfig {
+	return []config.IClientConfig{userConfig()}
+}
 
+func userConfig() config.IClientConfig {
+	return config.NewClientConfig(
+		config.WithName("user-service"),
+		config.WithBaseURL(config2.Config.InternalService.User.Host),
+		config.WithSignatureKey(config2.Config.InternalService.User.SignatureKey),
+	)
 }
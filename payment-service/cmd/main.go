@@ -1,22 +1,29 @@
 package cmd
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
-	"log"
 	"net/http"
 	"time"
 
 	"github.com/anddriii/kita-futsal/payment-service/clients"
 	midtransClient "github.com/anddriii/kita-futsal/payment-service/clients/midtrans"
 	"github.com/anddriii/kita-futsal/payment-service/common/gcs"
+	"github.com/anddriii/kita-futsal/payment-service/common/health"
+	"github.com/anddriii/kita-futsal/payment-service/common/lifecycle"
+	"github.com/anddriii/kita-futsal/payment-service/common/logger"
+	"github.com/anddriii/kita-futsal/payment-service/common/metrics"
 	"github.com/anddriii/kita-futsal/payment-service/common/response"
+	"github.com/anddriii/kita-futsal/payment-service/common/signature"
+	"github.com/anddriii/kita-futsal/payment-service/common/tracing"
 	"github.com/anddriii/kita-futsal/payment-service/config"
 	"github.com/anddriii/kita-futsal/payment-service/constants"
+	grpcController "github.com/anddriii/kita-futsal/payment-service/controllers/grpc"
 	controllers "github.com/anddriii/kita-futsal/payment-service/controllers/http"
 	kafkaClient "github.com/anddriii/kita-futsal/payment-service/controllers/kafka"
-	"github.com/anddriii/kita-futsal/payment-service/domains/models"
 	"github.com/anddriii/kita-futsal/payment-service/middlewares"
+	pb "github.com/anddriii/kita-futsal/payment-service/proto/payment"
 	"github.com/anddriii/kita-futsal/payment-service/repositories"
 	"github.com/anddriii/kita-futsal/payment-service/routes"
 	"github.com/anddriii/kita-futsal/payment-service/service"
@@ -24,9 +31,18 @@ import (
 	"github.com/didip/tollbooth/limiter"
 	"github.com/gin-gonic/gin"
 	"github.com/joho/godotenv"
+	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
 )
 
+// shutdownTimeout membatasi waktu yang diberikan ke request yang sedang berjalan,
+// termasuk pengiriman event Kafka di dalamnya, untuk selesai setelah SIGTERM.
+const shutdownTimeout = 30 * time.Second
+
+// probeTimeout membatasi durasi setiap pemeriksaan dependency pada endpoint readiness.
+const probeTimeout = 2 * time.Second
+
 // command adalah objek Cobra yang digunakan untuk menjalankan perintah "serve"
 // Perintah ini akan menjalankan server payment-service.
 var command = cobra.Command{
@@ -36,31 +52,52 @@ var command = cobra.Command{
 		// Memuat file .env ke dalam environment
 		_ = godotenv.Load()
 		config.Init()
-		fmt.Printf("Config setelah Init: %+v\n", config.Config)
+		// Logger JSON dipasang paling awal supaya semua log berikutnya sudah terstruktur
+		logger.Init(config.Config.AppName)
+
+		// Tracer dipasang sebelum database supaya query pertama ikut tercatat
+		shutdownTracing, err := tracing.Init(
+			config.Config.AppName,
+			config.Config.Tracing.Exporter,
+			config.Config.Tracing.Endpoint,
+			config.Config.Tracing.Insecure,
+		)
+		if err != nil {
+			logrus.Fatalf("error in init tracing %s", err)
+		}
 
 		// Inisialisasi koneksi database menggunakan konfigurasi
 		db, err := config.InitDB()
 		if err != nil {
-			log.Fatalf("error in config init db %s", err)
+			logrus.Fatalf("error in config init db %s", err)
 			panic(err)
 		}
 
+		// Mencatat durasi setiap query GORM ke metric Prometheus
+		err = db.Use(metrics.GormPlugin{})
+		if err != nil {
+			logrus.Fatalf("error in register gorm metrics %s", err)
+		}
+
+		// Mencatat setiap query GORM sebagai span di trace request
+		err = db.Use(tracing.GormPlugin{})
+		if err != nil {
+			logrus.Fatalf("error in register gorm tracing %s", err)
+		}
+
 		// Set zona waktu server ke Asia/Jakarta
 		loc, err := time.LoadLocation("Asia/Jakarta")
 		if err != nil {
-			log.Fatalf("load location %s", err)
+			logrus.Fatalf("load location %s", err)
 			panic(err)
 		}
 		time.Local = loc
 
-		// Migrasi tabel-tabel yang dibutuhkan
-		err = db.AutoMigrate(
-			&models.Payment{},
-			&models.PaymentHistory{},
-		)
+		// Menjalankan migrasi SQL yang belum diterapkan. Replica yang start bersamaan
+		// menunggu lewat advisory lock, jadi setiap migrasi hanya dijalankan sekali
+		err = runMigrations(context.Background(), loadMigrator(db))
 		if err != nil {
-			log.Fatalf("error in migrate %s", err)
-			panic(err)
+			logrus.Fatalf("error in migrate %s", err)
 		}
 
 		// Inisialisasi klien Google Cloud Storage
@@ -76,13 +113,51 @@ var command = cobra.Command{
 		// Inisialisasi client internal antar layanan
 		client := clients.NewClientRegistry()
 
+		// Nonce request antar service disimpan di Redis untuk menolak replay
+		rdb := config.NewRedisClient()
+		middlewares.SetNonceStore(signature.NewRedisNonceStore(rdb))
+
 		// Inisialisasi layer repository, service, dan controller
 		repository := repositories.NewRepositoryRegistry(db)
 		service := service.NewServiceRegistry(repository, gcsClient, kafka, midtrans)
 		controller := controllers.NewControllerRegistry(service)
 
+		sqlDB, err := db.DB()
+		if err != nil {
+			logrus.Fatalf("error in get sql db %s", err)
+		}
+
+		// Readiness memeriksa database, Redis dan Kafka, sedangkan service lain hanya dilaporkan
+		checker := health.NewChecker(probeTimeout,
+			health.Check{Name: "postgres", Probe: sqlDB.PingContext},
+			health.Check{Name: "redis", Probe: func(ctx context.Context) error {
+				return rdb.Ping(ctx).Err()
+			}},
+			health.Check{Name: "kafka", Probe: func(ctx context.Context) error {
+				return kafkaClient.Ping(ctx, config.Config.Kafka.Brokers)
+			}},
+		)
+		for _, downstream := range client.Downstreams() {
+			checker.Add(health.Check{Name: downstream.Name(), Optional: true, Probe: downstream.Ping})
+		}
+
 		// Buat router Gin dan pasang middleware
-		router := gin.Default()
+		router := gin.New()
+		// Controller meneruskan *gin.Context ke service sebagai context.Context, jadi
+		// nilai di context request (span, user login) harus bisa dibaca lewat gin.Context
+		router.ContextWithFallback = true
+
+		// Middleware untuk memberi setiap request ID yang ikut ke log dan ke service lain
+		router.Use(middlewares.RequestID())
+
+		// Middleware untuk membuat span setiap request, menyambung trace dari header traceparent
+		router.Use(tracing.Middleware())
+
+		// Middleware untuk menulis access log JSON, menggantikan logger bawaan gin
+		router.Use(middlewares.Logger())
+
+		// Middleware untuk mencatat durasi dan status setiap request ke metric Prometheus
+		router.Use(middlewares.Metrics())
 
 		// Middleware untuk menangani panic secara global
 		router.Use(middlewares.HandlePanic())
@@ -103,12 +178,18 @@ var command = cobra.Command{
 			})
 		})
 
+		// Endpoint liveness dan readiness untuk probe Kubernetes
+		router.GET("/healthz", health.Liveness)
+		router.GET("/readyz", checker.Readiness)
+		router.GET("/metrics", gin.WrapH(metrics.Handler()))
+
 		// Middleware untuk menangani CORS (Cross-Origin Resource Sharing)
 		router.Use(func(ctx *gin.Context) {
 			// FIX TYPO: 'Access', bukan 'Acces'
 			ctx.Writer.Header().Set("Access-Control-Allow-Origin", "*")
 			ctx.Writer.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-			ctx.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, x-service-name, x-api-key, x-request-at")
+			ctx.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, x-service-name, x-request-at, x-nonce, x-signature, x-request-id, traceparent, tracestate, baggage")
+			ctx.Writer.Header().Set("Access-Control-Expose-Headers", "X-Request-ID")
 
 			// TANGANI PREFLIGHT REQUEST
 			// Kalau method-nya OPTIONS, kasih response sukses 204 dan STOP di sini.
@@ -132,27 +213,52 @@ var command = cobra.Command{
 		route := routes.NewRouteRegistry(controller, group, client)
 		route.Serve() // Daftarkan seluruh endpoint
 
-		// Menjalankan server pada port yang dikonfigurasi di file .env
-		port := fmt.Sprintf(":%d", config.Config.Port)
-		router.Run(port)
+		// Komponen dihentikan dengan urutan terbalik: HTTP dan gRPC berhenti menerima
+		// request lebih dulu, baru kemudian Redis dan database ditutup
+		app := lifecycle.New(shutdownTimeout)
+		app.AddCloser("tracer", shutdownTracing)
+		app.AddCloser("database", sqlDB.Close)
+		app.AddCloser("redis", rdb.Close)
+		app.Add(lifecycle.GrpcServer(grpcServer(service), fmt.Sprintf(":%d", config.Config.GrpcPort)))
+		app.Add(lifecycle.HTTPServer(&http.Server{
+			Addr:    fmt.Sprintf(":%d", config.Config.Port),
+			Handler: router,
+		}))
+
+		err = app.Run()
+		if err != nil {
+			logrus.Fatalf("error in shutdown %s", err)
+		}
+		logrus.Info("server stopped")
 	},
 }
 
+// grpcServer membuat server gRPC untuk API internal. Kredensial antar service
+// divalidasi oleh interceptor dengan skema yang sama seperti header HTTP, setelah
+// span panggilan dibuat.
+func grpcServer(service service.IServiceRegistry) *grpc.Server {
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		tracing.UnaryServerInterceptor(),
+		middlewares.GrpcAuthenticate(),
+	))
+	pb.RegisterPaymentServiceServer(server, grpcController.NewPaymentServer(service))
+	return server
+}
+
 // Run digunakan untuk mengeksekusi command "serve" saat aplikasi dijalankan.
 func Run() {
 	err := command.Execute()
 	if err != nil {
-		log.Fatalf("error run %s", err)
+		logrus.Fatalf("error run %s", err)
 		panic(err)
 	}
-	log.Println("Server running on port 8001")
 }
 
 // initGCS menginisialisasi Google Cloud Storage Client dengan private key dari konfigurasi
 func initGCS() gcs.IGCSClient {
 	decode, err := base64.StdEncoding.DecodeString(config.Config.GCSPrivateKey)
 	if err != nil {
-		log.Fatalf("error in initGCS %s", err)
+		logrus.Fatalf("error in initGCS %s", err)
 		panic(err)
 	}
 
@@ -16,7 +16,12 @@ type ValidateResponse struct {
 	Message string `json:"message,omitempty"`
 }
 
-var ErrValidator = map[string]string{}
+var ErrValidator = map[string]string{
+	"oneof":    "%s must be one of [%s]",
+	"uuid":     "%s must be a valid UUID",
+	"datetime": "%s must match the format %s",
+	"gte":      "%s must be greater than or equal to %s",
+}
 
 func ErrValidationResponse(err error) (validationResponse []ValidateResponse) {
 	var fieldErrors validator.ValidationErrors
@@ -0,0 +1,125 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Component adalah bagian service yang berjalan terus-menerus seperti server,
+// consumer, atau job berkala.
+type Component struct {
+	Name string
+	// Start berjalan (blocking) sampai komponen berhenti. Error yang dikembalikan
+	// sebelum shutdown dimulai akan menghentikan seluruh service.
+	Start func() error
+	// Stop meminta komponen menyelesaikan pekerjaan yang sedang berjalan lalu
+	// keluar dari Start. Stop harus menyerah ketika ctx selesai.
+	Stop func(ctx context.Context) error
+}
+
+type closer struct {
+	name  string
+	close func() error
+}
+
+// Manager menjalankan komponen, menunggu SIGINT atau SIGTERM, lalu menghentikan
+// komponen dengan urutan terbalik dari pendaftaran sebelum menutup resource bersama.
+type Manager struct {
+	timeout    time.Duration
+	components []Component
+	closers    []closer
+}
+
+// New membuat manager yang memberi waktu maksimal timeout untuk proses shutdown.
+func New(timeout time.Duration) *Manager {
+	return &Manager{timeout: timeout}
+}
+
+// Add mendaftarkan komponen. Komponen yang memberi pekerjaan ke komponen lain
+// (misalnya server HTTP) didaftarkan terakhir agar dihentikan paling awal.
+func (m *Manager) Add(component Component) {
+	m.components = append(m.components, component)
+}
+
+// AddCloser mendaftarkan resource seperti koneksi database atau Redis. Resource
+// ditutup setelah semua komponen berhenti, dengan urutan terbalik dari pendaftaran.
+func (m *Manager) AddCloser(name string, close func() error) {
+	m.closers = append(m.closers, closer{name: name, close: close})
+}
+
+// Run menjalankan semua komponen dan menunggu sampai sinyal shutdown diterima
+// atau salah satu komponen gagal, lalu menghentikan semuanya.
+func (m *Manager) Run() error {
+	signals, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	var wg sync.WaitGroup
+	failures := make(chan error, len(m.components))
+	for _, component := range m.components {
+		wg.Add(1)
+		go func(component Component) {
+			defer wg.Done()
+			logrus.Infof("starting %s", component.Name)
+			err := component.Start()
+			if err != nil {
+				failures <- fmt.Errorf("%s: %w", component.Name, err)
+			}
+		}(component)
+	}
+
+	var runErr error
+	select {
+	case <-signals.Done():
+		logrus.Info("shutdown signal received")
+	case runErr = <-failures:
+		logrus.Errorf("%v, shutting down", runErr)
+	}
+
+	return errors.Join(runErr, m.shutdown(&wg))
+}
+
+func (m *Manager) shutdown(wg *sync.WaitGroup) error {
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+
+	var errs []error
+	for i := len(m.components) - 1; i >= 0; i-- {
+		component := m.components[i]
+		logrus.Infof("stopping %s", component.Name)
+		err := component.Stop(ctx)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("stop %s: %w", component.Name, err))
+		}
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		errs = append(errs, fmt.Errorf("components still running after %s", m.timeout))
+	}
+
+	// resource tetap ditutup walaupun timeout agar koneksi tidak bocor
+	for i := len(m.closers) - 1; i >= 0; i-- {
+		closer := m.closers[i]
+		logrus.Infof("closing %s", closer.name)
+		err := closer.close()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("close %s: %w", closer.name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
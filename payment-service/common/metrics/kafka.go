@@ -0,0 +1,20 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var kafkaMessagesProduced = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "kafka_messages_produced_total",
+	Help: "Messages produced to Kafka by topic and status.",
+}, []string{"topic", "status"})
+
+// ObserveKafkaProduce mencatat hasil pengiriman satu pesan ke Kafka.
+func ObserveKafkaProduce(topic string, err error) {
+	status := "ok"
+	if err != nil {
+		status = "error"
+	}
+	kafkaMessagesProduced.WithLabelValues(topic, status).Inc()
+}
@@ -0,0 +1,20 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	// PaymentsSettled dihitung setelah webhook settlement berhasil disimpan.
+	PaymentsSettled = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "payments_settled_total",
+		Help: "Payments settled, counted once the webhook update commits.",
+	})
+
+	// PaymentsExpired dihitung setelah webhook expire berhasil disimpan.
+	PaymentsExpired = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "payments_expired_total",
+		Help: "Payments expired, counted once the webhook update commits.",
+	})
+)
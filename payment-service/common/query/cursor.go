@@ -0,0 +1,122 @@
+package query
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	errWrap "github.com/anddriii/kita-futsal/payment-service/common/error"
+	errConst "github.com/anddriii/kita-futsal/payment-service/constants/error"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	OffsetPagination = "offset"
+	CursorPagination = "cursor"
+
+	cursorSortColumn = "createdAt"
+)
+
+// Cursor adalah posisi keyset dari satu baris data.
+// Cursor dengan Backward bernilai true dipakai untuk mengambil halaman sebelumnya.
+type Cursor struct {
+	CreatedAt time.Time `json:"createdAt"`
+	ID        uint      `json:"id"`
+	Backward  bool      `json:"backward,omitempty"`
+}
+
+// EncodeCursor mengubah cursor menjadi token opaque untuk client.
+func EncodeCursor(cursor Cursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor mengubah token dari client kembali menjadi cursor.
+func DecodeCursor(token string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errWrap.WrapError(errConst.ErrInvalidCursor)
+	}
+
+	var cursor Cursor
+	err = json.Unmarshal(data, &cursor)
+	if err != nil || cursor.ID == 0 || cursor.CreatedAt.IsZero() {
+		return nil, errWrap.WrapError(errConst.ErrInvalidCursor)
+	}
+	return &cursor, nil
+}
+
+// CursorDesc menentukan arah urutan keyset. Paginasi cursor selalu diurutkan
+// berdasarkan created_at dan id, sehingga kolom sorting lain ditolak.
+func CursorDesc(column, order *string) (bool, error) {
+	if column != nil && *column != "" && *column != cursorSortColumn {
+		return false, errWrap.WrapError(errConst.ErrCursorSortColumn)
+	}
+	if order == nil || *order == "" {
+		return true, nil
+	}
+
+	switch strings.ToLower(*order) {
+	case SortAsc:
+		return false, nil
+	case SortDesc:
+		return true, nil
+	default:
+		return false, errWrap.WrapError(errConst.ErrInvalidSortOrder)
+	}
+}
+
+// Keyset mengurutkan data berdasarkan (created_at, id) dan melanjutkan setelah cursor,
+// atau sebelum cursor jika Backward.
+func Keyset(db *gorm.DB, cursor *Cursor, desc bool) *gorm.DB {
+	if cursor != nil && cursor.Backward {
+		desc = !desc
+	}
+
+	if cursor != nil {
+		operator := ">"
+		if desc {
+			operator = "<"
+		}
+		db = db.Where(fmt.Sprintf("(created_at, id) %s (?, ?)", operator), cursor.CreatedAt, cursor.ID)
+	}
+
+	return db.Order(clause.OrderBy{Columns: []clause.OrderByColumn{
+		{Column: clause.Column{Name: "created_at"}, Desc: desc},
+		{Column: clause.Column{Name: "id"}, Desc: desc},
+	}})
+}
+
+// KeysetPage membuang satu baris tambahan yang dipakai untuk mendeteksi data berikutnya,
+// mengembalikan urutan tampilan untuk halaman sebelumnya, lalu membuat cursor next dan prev.
+func KeysetPage[T any](rows []T, limit int, cursor *Cursor, key func(T) Cursor) ([]T, *string, *string) {
+	hasMore := len(rows) > limit
+	if hasMore {
+		rows = rows[:limit]
+	}
+
+	backward := cursor != nil && cursor.Backward
+	if backward {
+		slices.Reverse(rows)
+	}
+	if len(rows) == 0 {
+		return rows, nil, nil
+	}
+
+	var next, prev *string
+	if backward || hasMore {
+		token := EncodeCursor(key(rows[len(rows)-1]))
+		next = &token
+	}
+	if (cursor != nil && !backward) || (backward && hasMore) {
+		first := key(rows[0])
+		first.Backward = true
+		token := EncodeCursor(first)
+		prev = &token
+	}
+	return rows, next, prev
+}
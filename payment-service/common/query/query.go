@@ -0,0 +1,121 @@
+package query
+
+import (
+	"strings"
+	"time"
+
+	errWrap "github.com/anddriii/kita-futsal/payment-service/common/error"
+	errConst "github.com/anddriii/kita-futsal/payment-service/constants/error"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	SortAsc  = "asc"
+	SortDesc = "desc"
+)
+
+// Spec menyimpan daftar kolom yang boleh dipakai untuk sorting pada satu resource.
+// Key SortColumns adalah nama kolom dari query parameter (sortColumn),
+// sedangkan value-nya adalah nama kolom di database.
+type Spec struct {
+	SortColumns map[string]string
+	DefaultSort clause.OrderByColumn
+}
+
+// OrderBy mengubah sortColumn dan sortOrder dari request menjadi klausa ORDER BY.
+// Kolom yang tidak terdaftar di SortColumns akan ditolak sehingga input user
+// tidak pernah disisipkan langsung ke dalam query SQL.
+func (s Spec) OrderBy(column, order *string) (clause.OrderByColumn, error) {
+	if column == nil || *column == "" {
+		return s.DefaultSort, nil
+	}
+
+	name, ok := s.SortColumns[*column]
+	if !ok {
+		return clause.OrderByColumn{}, errWrap.WrapError(errConst.ErrInvalidSortColumn)
+	}
+
+	desc := false
+	if order != nil && *order != "" {
+		switch strings.ToLower(*order) {
+		case SortAsc:
+		case SortDesc:
+			desc = true
+		default:
+			return clause.OrderByColumn{}, errWrap.WrapError(errConst.ErrInvalidSortOrder)
+		}
+	}
+
+	return clause.OrderByColumn{Column: clause.Column{Name: name}, Desc: desc}, nil
+}
+
+// Filter menambahkan satu kondisi WHERE ke query. Nama kolom selalu berasal dari
+// repository, bukan dari request, dan nilainya dikirim sebagai parameter query.
+type Filter func(db *gorm.DB) *gorm.DB
+
+// Apply menerapkan seluruh filter ke query.
+func Apply(db *gorm.DB, filters ...Filter) *gorm.DB {
+	for _, filter := range filters {
+		db = filter(db)
+	}
+	return db
+}
+
+// Equal memfilter kolom dengan nilai yang sama persis, dilewati jika value nil.
+func Equal[T any](column string, value *T) Filter {
+	return func(db *gorm.DB) *gorm.DB {
+		if value == nil {
+			return db
+		}
+		return db.Where(clause.Eq{Column: clause.Column{Name: column}, Value: *value})
+	}
+}
+
+// In memfilter kolom dengan salah satu nilai pada values, dilewati jika values nil.
+// Slice kosong (bukan nil) tetap diterapkan sehingga tidak ada data yang cocok.
+func In[T any](column string, values []T) Filter {
+	return func(db *gorm.DB) *gorm.DB {
+		if values == nil {
+			return db
+		}
+		items := make([]any, 0, len(values))
+		for _, value := range values {
+			items = append(items, value)
+		}
+		return db.Where(clause.IN{Column: clause.Column{Name: column}, Values: items})
+	}
+}
+
+// Range memfilter kolom numerik dengan batas bawah dan/atau batas atas (inklusif).
+func Range[T int | int64 | float64](column string, min, max *T) Filter {
+	return func(db *gorm.DB) *gorm.DB {
+		if min != nil {
+			db = db.Where(clause.Gte{Column: clause.Column{Name: column}, Value: *min})
+		}
+		if max != nil {
+			db = db.Where(clause.Lte{Column: clause.Column{Name: column}, Value: *max})
+		}
+		return db
+	}
+}
+
+// DateRange memfilter kolom tanggal dengan format YYYY-MM-DD (inklusif di kedua sisi).
+// Format tanggal divalidasi di controller, tanggal yang tidak valid akan dilewati.
+func DateRange(column string, startDate, endDate *string) Filter {
+	return func(db *gorm.DB) *gorm.DB {
+		if startDate != nil && *startDate != "" {
+			start, err := time.ParseInLocation(time.DateOnly, *startDate, time.Local)
+			if err == nil {
+				db = db.Where(clause.Gte{Column: clause.Column{Name: column}, Value: start})
+			}
+		}
+		if endDate != nil && *endDate != "" {
+			end, err := time.ParseInLocation(time.DateOnly, *endDate, time.Local)
+			if err == nil {
+				db = db.Where(clause.Lt{Column: clause.Column{Name: column}, Value: end.AddDate(0, 0, 1)})
+			}
+		}
+		return db
+	}
+}
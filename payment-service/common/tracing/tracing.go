@@ -0,0 +1,97 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+)
+
+const instrumentationName = "github.com/anddriii/kita-futsal/payment-service"
+
+// flushTimeout membatasi waktu menunggu span yang masih di buffer terkirim saat
+// shutdown, supaya collector yang tidak bisa dihubungi tidak menahan proses.
+const flushTimeout = 5 * time.Second
+
+// Init memasang propagator W3C trace context dan tracer provider yang mengirim span
+// ke exporter yang dipilih. Tanpa exporter tidak ada span yang dicatat, tetapi trace
+// context dari request yang masuk tetap diteruskan ke service lain. Fungsi yang dikembalikan
+// mengirim sisa span dan menghentikan provider.
+func Init(serviceName, exporter, endpoint string, insecure bool) (func() error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	var (
+		spanExporter sdktrace.SpanExporter
+		err          error
+	)
+	switch exporter {
+	case "":
+		return func() error { return nil }, nil
+	case ExporterStdout:
+		spanExporter, err = stdouttrace.New()
+	case ExporterOTLP:
+		options := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(endpoint)}
+		if insecure {
+			options = append(options, otlptracegrpc.WithInsecure())
+		}
+		spanExporter, err = otlptracegrpc.New(context.Background(), options...)
+	default:
+		return nil, fmt.Errorf("unknown trace exporter %q", exporter)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(spanExporter),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(serviceName))),
+	)
+	otel.SetTracerProvider(provider)
+
+	return func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), flushTimeout)
+		defer cancel()
+		return provider.Shutdown(ctx)
+	}, nil
+}
+
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// Inject menulis trace context dari ctx ke request atau pesan yang dikirim.
+func Inject(ctx context.Context, carrier propagation.TextMapCarrier) {
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+}
+
+// Extract mengembalikan ctx yang membawa trace context dari request yang masuk,
+// sehingga span yang dibuat darinya menjadi bagian dari trace pemanggil.
+func Extract(ctx context.Context, carrier propagation.TextMapCarrier) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, carrier)
+}
+
+// End menandai span gagal jika err tidak nil, lalu mengakhirinya.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -26,31 +26,52 @@ type PaginationParam struct {
 	Page  int         `json:"page"`  // Halaman saat ini
 	Limit int         `json:"limit"` // Jumlah item per halaman
 	Data  interface{} `json:"data"`  // Data yang akan dipaginasi
+	// SkipCount berarti Count tidak dihitung, sehingga halaman berikutnya ditentukan oleh HasNext.
+	SkipCount bool `json:"-"`
+	HasNext   bool `json:"-"`
+}
+
+// CursorPaginationParam merepresentasikan parameter untuk paginasi berbasis cursor (keyset).
+type CursorPaginationParam struct {
+	Count      int64       // Total jumlah data, 0 jika SkipCount
+	Limit      int         // Jumlah item per halaman
+	SkipCount  bool        // Total data tidak dihitung
+	NextCursor *string     // Cursor untuk halaman berikutnya (jika ada)
+	PrevCursor *string     // Cursor untuk halaman sebelumnya (jika ada)
+	Data       interface{} // Data yang akan dipaginasi
 }
 
 // PaginationResult merepresentasikan hasil dari proses paginasi.
 type PaginationResult struct {
-	TotalPage    int         `json:"totalPage"`    // Total jumlah halaman
-	TotalData    int64       `json:"totalData"`    // Total jumlah data
-	NextPage     *int        `json:"nextPage"`     // Halaman berikutnya (jika ada)
-	PreviousPage *int        `json:"previousPage"` // Halaman sebelumnya (jika ada)
-	Page         int         `json:"page"`         // Halaman saat ini
-	Limit        int         `json:"limit"`        // Jumlah item per halaman
-	Data         interface{} `json:"data"`         // Data yang dipaginasi
+	TotalPage    int         `json:"totalPage"`            // Total jumlah halaman
+	TotalData    int64       `json:"totalData"`            // Total jumlah data
+	NextPage     *int        `json:"nextPage"`             // Halaman berikutnya (jika ada)
+	PreviousPage *int        `json:"previousPage"`         // Halaman sebelumnya (jika ada)
+	NextCursor   *string     `json:"nextCursor,omitempty"` // Cursor halaman berikutnya (mode cursor)
+	PrevCursor   *string     `json:"prevCursor,omitempty"` // Cursor halaman sebelumnya (mode cursor)
+	Page         int         `json:"page"`                 // Halaman saat ini
+	Limit        int         `json:"limit"`                // Jumlah item per halaman
+	Data         interface{} `json:"data"`                 // Data yang dipaginasi
 }
 
 // GeneratePagination menghasilkan hasil paginasi berdasarkan parameter yang diberikan.
 func GeneratePagination(params PaginationParam) PaginationResult {
-	totalPage := int(math.Ceil(float64(params.Count) / float64(params.Limit)))
-
 	var (
+		totalPage    int
 		nextPage     int
 		previousPage int
 	)
 
 	// Menentukan halaman berikutnya jika masih ada
-	if params.Page < totalPage {
-		nextPage = params.Page + 1
+	if params.SkipCount {
+		if params.HasNext {
+			nextPage = params.Page + 1
+		}
+	} else {
+		totalPage = int(math.Ceil(float64(params.Count) / float64(params.Limit)))
+		if params.Page < totalPage {
+			nextPage = params.Page + 1
+		}
 	}
 
 	// Menentukan halaman sebelumnya jika lebih dari 1
@@ -69,6 +90,24 @@ func GeneratePagination(params PaginationParam) PaginationResult {
 	}
 }
 
+// GenerateCursorPagination menghasilkan hasil paginasi berbasis cursor.
+// Field page, nextPage dan previousPage tidak dipakai pada mode ini.
+func GenerateCursorPagination(params CursorPaginationParam) PaginationResult {
+	var totalPage int
+	if !params.SkipCount {
+		totalPage = int(math.Ceil(float64(params.Count) / float64(params.Limit)))
+	}
+
+	return PaginationResult{
+		TotalPage:  totalPage,
+		TotalData:  params.Count,
+		NextCursor: params.NextCursor,
+		PrevCursor: params.PrevCursor,
+		Limit:      params.Limit,
+		Data:       params.Data,
+	}
+}
+
 // GenerateSHA256 menghasilkan hash SHA-256 dari string input.
 func GenerateSHA256(inputString string) string {
 	hash := sha256.New()
@@ -109,9 +148,6 @@ func BindFromJson(dest any, filename, path string) error {
 		v.AddConfigPath(".") // Pakai direktori saat ini jika path kosong
 	}
 
-	// Log untuk debug
-	fmt.Printf("Loading config: %s.json from %s\n", configName, path)
-
 	// Baca file konfigurasi
 	if err := v.ReadInConfig(); err != nil {
 		logrus.Errorf("Failed to read config file: %v", err)
@@ -124,7 +160,6 @@ func BindFromJson(dest any, filename, path string) error {
 		return err
 	}
 
-	fmt.Printf("Config loaded successfully: %+v\n", dest)
 	return nil
 }
 
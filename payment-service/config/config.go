@@ -12,10 +12,12 @@ var Config AppConfig
 
 type AppConfig struct {
 	Port                       int             `json:"port"`
+	GrpcPort                   int             `json:"grpcPort"`
 	AppName                    string          `json:"appName"`
 	AppEnv                     string          `json:"appEnv"`
 	SignatureKey               string          `json:"signatureKey"`
 	Database                   database        `json:"database"`
+	Redis                      redisClient     `json:"redis"`
 	RateLimiterMaxRequest      float64         `json:"rateLimiterMaxRequest"`
 	RateLimiterTimeSecond      int             `json:"rateLimiterTimeSecond"`
 	InternalService            InternalService `json:"internalService"`
@@ -33,6 +35,21 @@ type AppConfig struct {
 	GCSBucketName              string          `json:"gcsBucketName"`
 	Kafka                      Kafka           `json:"kafka"`
 	Midtrans                   Midtrans        `json:"midtrans"`
+	Tracing                    tracing         `json:"tracing"`
+}
+
+type tracing struct {
+	// Exporter berisi "otlp", "stdout", atau kosong jika span tidak diekspor
+	Exporter string `json:"exporter"`
+	// Endpoint adalah host:port collector OTLP gRPC
+	Endpoint string `json:"endpoint"`
+	Insecure bool   `json:"insecure"`
+}
+
+type redisClient struct {
+	Addr     string `json:"addr"`
+	Password string `json:"password"`
+	DB       int    `json:"db"`
 }
 
 type database struct {
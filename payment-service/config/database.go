@@ -2,10 +2,11 @@ package config
 
 import (
 	"fmt"
-	"log"
 	"net/url"
 	"time"
 
+	"github.com/redis/go-redis/v9"
+
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
@@ -15,8 +16,6 @@ func InitDB() (*gorm.DB, error) {
 	encodedPassword := url.QueryEscape(config.Database.Password)
 	uri := fmt.Sprintf("postgresql://%s:%s@%s:%d/%s?sslmode=disable", config.Database.UserName, encodedPassword, config.Database.Host, config.Database.Port, config.Database.Name)
 
-	fmt.Printf("Database Config: %+v\n", config.Database)
-
 	db, err := gorm.Open(postgres.Open(uri), &gorm.Config{})
 	if err != nil {
 		return nil, err
@@ -24,7 +23,7 @@ func InitDB() (*gorm.DB, error) {
 
 	sqlDB, err := db.DB()
 	if err != nil {
-		log.Fatalf("failed to get database instance: %v", err)
+		return nil, err
 	}
 	sqlDB.SetMaxOpenConns(config.Database.MaxOpenConn)
 	sqlDB.SetConnMaxLifetime(time.Duration(config.Database.MaxLifeTimeConn) * time.Second)
@@ -55,3 +54,12 @@ func InitDB() (*gorm.DB, error) {
 	return db, nil
 
 }
+
+// NewRedisClient membuat klien Redis dari konfigurasi service.
+func NewRedisClient() *redis.Client {
+	return redis.NewClient(&redis.Options{
+		Addr:     Config.Redis.Addr,
+		Password: Config.Redis.Password,
+		DB:       Config.Redis.DB,
+	})
+}
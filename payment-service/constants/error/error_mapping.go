@@ -2,6 +2,7 @@ package error
 
 import (
 	errPayment "github.com/anddriii/kita-futsal/payment-service/constants/error/payment"
+	errReport "github.com/anddriii/kita-futsal/payment-service/constants/error/report"
 )
 
 // ErrMapping checks if an error exists in predefined error lists
@@ -9,10 +10,12 @@ func ErrMapping(err error) bool {
 	var (
 		GeneralErrors = GeneralErrors
 		TimerErrors   = errPayment.PaymentErrors
+		ReportErrors  = errReport.ReportErrors
 	)
 	allErrors := make([]error, 0)
 	allErrors = append(allErrors, GeneralErrors...)
 	allErrors = append(allErrors, TimerErrors...)
+	allErrors = append(allErrors, ReportErrors...)
 
 	for _, item := range allErrors {
 		if err.Error() == item.Error() {
@@ -12,6 +12,11 @@ var (
 	ErrForbidden           = errors.New("forbidden")
 	ErrInvalidUploadFile   = errors.New("invalid upload file")
 	ErrSizeTooBig          = errors.New("size too big")
+	ErrInvalidSortColumn   = errors.New("invalid sort column")
+	ErrInvalidSortOrder    = errors.New("invalid sort order, must be asc or desc")
+	ErrInvalidCursor       = errors.New("invalid cursor")
+	ErrCursorSortColumn    = errors.New("cursor pagination only supports sorting by createdAt")
+	ErrServiceUnavailable  = errors.New("service temporarily unavailable, please try again later")
 )
 
 // List of general errors
@@ -22,4 +27,9 @@ var GeneralErrors = []error{
 	ErrUnauthorized,
 	ErrInvalidToken,
 	ErrForbidden,
+	ErrInvalidSortColumn,
+	ErrInvalidSortOrder,
+	ErrInvalidCursor,
+	ErrCursorSortColumn,
+	ErrServiceUnavailable,
 }
@@ -5,9 +5,11 @@ import "errors"
 var (
 	ErrPaymentNotFound = errors.New("payment not found")
 	ErrExpireArInvalid = errors.New("expire is invalid, must be greater than current time")
+	ErrPaymentSettled  = errors.New("payment is already settled")
 )
 
 var PaymentErrors = []error{
 	ErrExpireArInvalid,
 	ErrPaymentNotFound,
+	ErrPaymentSettled,
 }
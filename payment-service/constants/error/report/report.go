@@ -0,0 +1,13 @@
+package error
+
+import "errors"
+
+var (
+	ErrInvalidDateRange = errors.New("start date must not be after end date")
+	ErrInvalidPeriod    = errors.New("period must be one of day, week or month")
+)
+
+var ReportErrors = []error{
+	ErrInvalidDateRange,
+	ErrInvalidPeriod,
+}
@@ -0,0 +1,144 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/anddriii/kita-futsal/payment-service/domains/dto"
+	pb "github.com/anddriii/kita-futsal/payment-service/proto/payment"
+	"github.com/anddriii/kita-futsal/payment-service/service"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// PaymentServer melayani API internal payment-service lewat gRPC, berjalan berdampingan dengan API HTTP Gin.
+type PaymentServer struct {
+	pb.UnimplementedPaymentServiceServer
+	service service.IServiceRegistry
+}
+
+func NewPaymentServer(service service.IServiceRegistry) *PaymentServer {
+	return &PaymentServer{service: service}
+}
+
+// CreatePaymentLink membuat link pembayaran Midtrans untuk order baru, dipanggil oleh order-service.
+func (p *PaymentServer) CreatePaymentLink(ctx context.Context, req *pb.CreatePaymentLinkRequest) (*pb.Payment, error) {
+	request, err := toPaymentRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = validator.New().Struct(request)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	payment, err := p.service.GetPayment().Create(ctx, request)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toPaymentProto(payment), nil
+}
+
+// ExpirePayment menutup pembayaran order yang dibatalkan, dipanggil oleh order-service
+// saat customer membatalkan order agar payment link-nya tidak bisa dibayar lagi.
+func (p *PaymentServer) ExpirePayment(ctx context.Context, req *pb.ExpirePaymentRequest) (*pb.Payment, error) {
+	_, err := uuid.Parse(req.GetOrderId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	payment, err := p.service.GetPayment().Expire(ctx, req.GetOrderId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toPaymentProto(payment), nil
+}
+
+// GetPaymentsByUUIDs mengambil banyak pembayaran sekaligus, dipanggil oleh order-service
+// untuk daftar order customer. Hanya tersedia lewat gRPC yang ditandatangani antar service
+// karena tidak ada filter kepemilikan pembayaran.
+func (p *PaymentServer) GetPaymentsByUUIDs(ctx context.Context, req *pb.GetPaymentsByUUIDsRequest) (*pb.GetPaymentsByUUIDsResponse, error) {
+	request := dto.BatchPaymentRequest{UUIDs: req.GetUuids()}
+	err := validator.New().Struct(request)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	payments, err := p.service.GetPayment().GetBatch(ctx, &request)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	response := &pb.GetPaymentsByUUIDsResponse{Payments: make([]*pb.Payment, 0, len(payments))}
+	for i := range payments {
+		response.Payments = append(response.Payments, toPaymentProto(&payments[i]))
+	}
+
+	return response, nil
+}
+
+func toPaymentProto(payment *dto.PaymentResponse) *pb.Payment {
+	response := &pb.Payment{
+		Uuid:        payment.UUID.String(),
+		OrderId:     payment.OrderID.String(),
+		Amount:      payment.Amount,
+		Status:      string(payment.Status),
+		PaymentLink: payment.PaymentLink,
+	}
+	if payment.Description != nil {
+		response.Description = *payment.Description
+	}
+	if payment.ExpiredAt != nil {
+		response.ExpiredAt = timestamppb.New(*payment.ExpiredAt)
+	}
+	if payment.InvoiceLink != nil {
+		response.InvoiceLink = *payment.InvoiceLink
+	}
+	return response
+}
+
+func toPaymentRequest(req *pb.CreatePaymentLinkRequest) (*dto.PaymentRequest, error) {
+	request := &dto.PaymentRequest{
+		OrderID:     req.GetOrderId(),
+		ExpiredAt:   req.GetExpiredAt().AsTime(),
+		Amount:      req.GetAmount(),
+		ItemDetails: make([]dto.ItemDetail, 0, len(req.GetItemDetails())),
+	}
+
+	if req.GetVenueId() != "" {
+		venueID, err := uuid.Parse(req.GetVenueId())
+		if err != nil {
+			return nil, err
+		}
+		request.VenueID = &venueID
+	}
+
+	if req.GetDescription() != "" {
+		description := req.GetDescription()
+		request.Description = &description
+	}
+
+	if customer := req.GetCustomerDetail(); customer != nil {
+		request.CustomerDetail = &dto.CustomerDetail{
+			Name:  customer.GetName(),
+			Email: customer.GetEmail(),
+			Phone: customer.GetPhone(),
+		}
+	}
+
+	for _, item := range req.GetItemDetails() {
+		request.ItemDetails = append(request.ItemDetails, dto.ItemDetail{
+			ID:       item.GetId(),
+			Name:     item.GetName(),
+			Amount:   item.GetAmount(),
+			Quantity: int(item.GetQuantity()),
+		})
+	}
+
+	return request, nil
+}
@@ -1,7 +1,6 @@
 package controllers
 
 import (
-	"fmt"
 	"net/http"
 
 	errValidation "github.com/anddriii/kita-futsal/payment-service/common/error"
@@ -136,7 +135,6 @@ func (p *PaymentController) Webhook(c *gin.Context) {
 	var request dto.Webhook
 	err := c.ShouldBindJSON(&request)
 	if err != nil {
-		fmt.Println("error", err)
 		response.HTTPResponse(response.ParamHTTPResp{
 			Code: http.StatusBadRequest,
 			Err:  err,
@@ -2,6 +2,7 @@ package controllers
 
 import (
 	controllers "github.com/anddriii/kita-futsal/payment-service/controllers/http/payment"
+	reportController "github.com/anddriii/kita-futsal/payment-service/controllers/http/report"
 	"github.com/anddriii/kita-futsal/payment-service/service"
 )
 
@@ -14,8 +15,14 @@ func (r *Registry) GetPayment() controllers.IPaymentController {
 	return controllers.NewPaymentController(r.service)
 }
 
+// GetReport implements IControllerRegistry.
+func (r *Registry) GetReport() reportController.IReportController {
+	return reportController.NewReportController(r.service)
+}
+
 type IControllerRegistry interface {
 	GetPayment() controllers.IPaymentController
+	GetReport() reportController.IReportController
 }
 
 func NewControllerRegistry(service service.IServiceRegistry) IControllerRegistry {
@@ -0,0 +1,142 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	errValidation "github.com/anddriii/kita-futsal/payment-service/common/error"
+	"github.com/anddriii/kita-futsal/payment-service/common/response"
+	"github.com/anddriii/kita-futsal/payment-service/domains/dto"
+	"github.com/anddriii/kita-futsal/payment-service/service"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+const csvFormat = "csv"
+
+type ReportController struct {
+	service service.IServiceRegistry
+}
+
+type IReportController interface {
+	GetRevenue(*gin.Context)
+	GetPaymentMethod(*gin.Context)
+}
+
+func NewReportController(service service.IServiceRegistry) IReportController {
+	return &ReportController{service: service}
+}
+
+// bindParam membaca dan memvalidasi parameter query laporan.
+// Mengembalikan false jika response error sudah dikirim ke client.
+func (r *ReportController) bindParam(c *gin.Context) (*dto.ReportRequestParam, bool) {
+	var param dto.ReportRequestParam
+	err := c.ShouldBindQuery(&param)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return nil, false
+	}
+
+	validate := validator.New()
+	if err = validate.Struct(param); err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errorResponse := errValidation.ErrValidationResponse(err)
+		response.HTTPResponse(response.ParamHTTPResp{
+			Err:     err,
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errorResponse,
+			Gin:     c,
+		})
+		return nil, false
+	}
+
+	return &param, true
+}
+
+func (r *ReportController) GetRevenue(c *gin.Context) {
+	param, ok := r.bindParam(c)
+	if !ok {
+		return
+	}
+
+	result, err := r.service.GetReport().GetRevenue(c, param)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	if param.Format == csvFormat {
+		rows := make([][]string, 0, len(result))
+		for _, item := range result {
+			rows = append(rows, []string{
+				item.PeriodStart.Format(time.DateOnly),
+				strconv.FormatFloat(item.TotalAmount, 'f', 2, 64),
+				strconv.FormatInt(item.TotalTransaction, 10),
+			})
+		}
+		response.CSVResponse(c,
+			fmt.Sprintf("revenue_%s_%s.csv", param.StartDate, param.EndDate),
+			[]string{"period_start", "total_amount", "total_transaction"},
+			rows,
+		)
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  c,
+	})
+}
+
+func (r *ReportController) GetPaymentMethod(c *gin.Context) {
+	param, ok := r.bindParam(c)
+	if !ok {
+		return
+	}
+
+	result, err := r.service.GetReport().GetPaymentMethod(c, param)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  c,
+		})
+		return
+	}
+
+	if param.Format == csvFormat {
+		rows := make([][]string, 0, len(result))
+		for _, item := range result {
+			rows = append(rows, []string{
+				item.Bank,
+				item.Acquirer,
+				strconv.FormatFloat(item.TotalAmount, 'f', 2, 64),
+				strconv.FormatInt(item.TotalTransaction, 10),
+			})
+		}
+		response.CSVResponse(c,
+			fmt.Sprintf("payment_method_%s_%s.csv", param.StartDate, param.EndDate),
+			[]string{"bank", "acquirer", "total_amount", "total_transaction"},
+			rows,
+		)
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  c,
+	})
+}
@@ -1,9 +1,17 @@
 package kafka
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/IBM/sarama"
+	"github.com/anddriii/kita-futsal/payment-service/common/logger"
+	"github.com/anddriii/kita-futsal/payment-service/common/metrics"
+	"github.com/anddriii/kita-futsal/payment-service/common/tracing"
 	configApp "github.com/anddriii/kita-futsal/payment-service/config"
-	"github.com/sirupsen/logrus"
+	"github.com/anddriii/kita-futsal/payment-service/constants"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Kafka adalah struct yang mewakili koneksi ke cluster Kafka.
@@ -15,7 +23,7 @@ type Kafka struct {
 // IKafka adalah interface untuk Kafka client yang mendefinisikan
 // fungsi utama yang harus diimplementasikan, yaitu pengiriman pesan.
 type IKafka interface {
-	ProduceMessage(topic string, data []byte) error
+	ProduceMessage(ctx context.Context, topic string, data []byte) error
 }
 
 // NewKafkaProducer mengembalikan instance Kafka baru sebagai implementasi dari IKafka.
@@ -27,14 +35,27 @@ func NewKafkaProducer(brokers []string) IKafka {
 	}
 }
 
-// ProduceMessage mengirim pesan ke Kafka ke topik tertentu.
+// ProduceMessage mengirim pesan ke Kafka ke topik tertentu di dalam span producer.
+// Trace context span tersebut dan request ID ditulis ke header pesan supaya consumer
+// melanjutkan trace dan log dari ctx.
 // Parameter:
+//   - ctx: context yang membawa trace dan request ID pemanggil
 //   - topic: nama topik Kafka
 //   - data: payload/message dalam bentuk byte array
 //
 // Return:
 //   - error jika gagal mengirim pesan atau membuat producer
-func (k *Kafka) ProduceMessage(topic string, data []byte) error {
+func (k *Kafka) ProduceMessage(ctx context.Context, topic string, data []byte) error {
+	ctx, span := tracing.Tracer().Start(ctx, fmt.Sprintf("%s publish", topic),
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(semconv.MessagingSystemKafka, semconv.MessagingDestinationName(topic)),
+	)
+	err := k.produce(ctx, topic, data)
+	tracing.End(span, err)
+	return err
+}
+
+func (k *Kafka) produce(ctx context.Context, topic string, data []byte) error {
 	// Konfigurasi producer Kafka menggunakan sarama
 	config := sarama.NewConfig()
 	config.Producer.Return.Successes = true
@@ -44,33 +65,41 @@ func (k *Kafka) ProduceMessage(topic string, data []byte) error {
 	// Inisialisasi producer sinkron
 	producer, err := sarama.NewSyncProducer(k.brokers, config)
 	if err != nil {
-		logrus.Errorf("Failed to create producer: %v", err)
+		logger.FromContext(ctx).Errorf("failed to create producer: %v", err)
+		metrics.ObserveKafkaProduce(topic, err)
 		return err
 	}
 
 	defer func(producer sarama.SyncProducer) {
 		err = producer.Close()
 		if err != nil {
-			logrus.Errorf("Failed to close kafka: %v", err)
+			logger.FromContext(ctx).Errorf("failed to close kafka: %v", err)
 			return
 		}
 	}(producer)
 
 	message := &sarama.ProducerMessage{
-		Topic:   topic, // Nama topik Kafka
-		Headers: nil,
-		Value:   sarama.ByteEncoder(data), // Payload sebagai byte encoder
+		Topic: topic,                    // Nama topik Kafka
+		Value: sarama.ByteEncoder(data), // Payload sebagai byte encoder
+	}
+	// Header traceparent dan X-Request-ID dibaca consumer order-service untuk menyambung
+	// trace dan log
+	headers := producerHeaders{message: message}
+	if requestID, ok := ctx.Value(constants.RequestID).(string); ok && requestID != "" {
+		headers.Set(constants.XRequestID, requestID)
 	}
+	tracing.Inject(ctx, headers)
 
 	// Kirim pesan ke Kafka
 	partition, offset, err := producer.SendMessage(message)
+	metrics.ObserveKafkaProduce(topic, err)
 	if err != nil {
-		logrus.Errorf("Failed to produce to kafka: %v", err)
+		logger.FromContext(ctx).Errorf("failed to produce to kafka: %v", err)
 		return err
 	}
 
 	// Log info lokasi penyimpanan pesan di Kafka
-	logrus.Infof("Message is stored in topic(%s)/partition(%d)/offset(%d)\n", topic, partition, offset)
+	logger.FromContext(ctx).Infof("message is stored in topic(%s)/partition(%d)/offset(%d)", topic, partition, offset)
 
 	return nil
 }
@@ -0,0 +1,36 @@
+package kafka
+
+import "github.com/IBM/sarama"
+
+// producerHeaders membuat propagator bisa menulis trace context ke header pesan
+// yang akan dikirim.
+type producerHeaders struct {
+	message *sarama.ProducerMessage
+}
+
+func (h producerHeaders) Get(key string) string {
+	for _, header := range h.message.Headers {
+		if string(header.Key) == key {
+			return string(header.Value)
+		}
+	}
+	return ""
+}
+
+func (h producerHeaders) Set(key, value string) {
+	for i, header := range h.message.Headers {
+		if string(header.Key) == key {
+			h.message.Headers[i].Value = []byte(value)
+			return
+		}
+	}
+	h.message.Headers = append(h.message.Headers, sarama.RecordHeader{Key: []byte(key), Value: []byte(value)})
+}
+
+func (h producerHeaders) Keys() []string {
+	keys := make([]string, 0, len(h.message.Headers))
+	for _, header := range h.message.Headers {
+		keys = append(keys, string(header.Key))
+	}
+	return keys
+}
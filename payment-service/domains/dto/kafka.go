@@ -20,11 +20,12 @@ type KafkaMetaData struct {
 // KafkaData berisi data utama yang dikirim melalui Kafka.
 // Digunakan untuk menyampaikan status pembayaran atau data transaksi.
 type KafkaData struct {
-	OrderID   uuid.UUID  `json:"orderID"`   // UUID dari pesanan
-	PaymentID uuid.UUID  `json:"paymentID"` // UUID dari pembayaran
-	Status    string     `json:"status"`    // Status pembayaran (e.g., "PAID", "EXPIRED")
-	ExpiredAt time.Time  `json:"expiredAt"` // Waktu kadaluarsa pembayaran
-	PaidAt    *time.Time `json:"paidAt"`    // Waktu pembayaran dilakukan (bisa null)
+	OrderID     uuid.UUID  `json:"orderID"`               // UUID dari pesanan
+	PaymentID   uuid.UUID  `json:"paymentID"`             // UUID dari pembayaran
+	Status      string     `json:"status"`                // Status pembayaran (e.g., "PAID", "EXPIRED")
+	ExpiredAt   time.Time  `json:"expiredAt"`             // Waktu kadaluarsa pembayaran
+	PaidAt      *time.Time `json:"paidAt"`                // Waktu pembayaran dilakukan (bisa null)
+	InvoiceLink *string    `json:"invoiceLink,omitempty"` // Link invoice, hanya terisi saat settlement
 }
 
 // KafkaBody membungkus tipe data dan data payload yang dikirim dalam pesan Kafka.
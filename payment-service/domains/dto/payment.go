@@ -12,6 +12,7 @@ import (
 type PaymentRequest struct {
 	PaymentLink    string          `json:"paymentLink"`    // Link pembayaran (jika ada)
 	OrderID        string          `json:"orderID"`        // ID unik untuk pesanan
+	VenueID        *uuid.UUID      `json:"venueID"`        // Venue pemilik lapangan yang dipesan (opsional)
 	ExpiredAt      time.Time       `json:"expiredAt"`      // Tanggal dan waktu kadaluarsa pembayaran
 	Amount         float64         `json:"amount"`         // Jumlah total pembayaran
 	Description    *string         `json:"description"`    // Deskripsi opsional tentang pembayaran
@@ -37,10 +38,28 @@ type ItemDetail struct {
 // PaymentRequestParam digunakan untuk menerima parameter query ketika meminta daftar pembayaran,
 // misalnya pada endpoint GET /payments?page=1&limit=10&sortColumn=createdAt&sortOrder=desc
 type PaymentRequestParam struct {
-	Page       int     `form:"page" validate:"required"`  // Halaman saat ini
-	Limit      int     `form:"limit" validate:"required"` // Batas jumlah data per halaman
-	SortColumn *string `form:"sortColumn"`                // Kolom untuk melakukan pengurutan
-	SortOrder  *string `form:"sortOrder"`                 // Urutan pengurutan (ASC/DESC)
+	Page       int                            `form:"page" validate:"required_unless=Pagination cursor"`                                        // Halaman saat ini (mode offset)
+	Limit      int                            `form:"limit" validate:"required"`                                                                // Batas jumlah data per halaman
+	Pagination string                         `form:"pagination" validate:"omitempty,oneof=offset cursor"`                                      // Mode paginasi, default: offset
+	Cursor     *string                        `form:"cursor"`                                                                                   // Cursor dari nextCursor/prevCursor (mode cursor)
+	SkipCount  bool                           `form:"skipCount"`                                                                                // Lewati perhitungan total data
+	SortColumn *string                        `form:"sortColumn" validate:"omitempty,oneof=amount status paidAt expiredAt createdAt updatedAt"` // Kolom untuk melakukan pengurutan
+	SortOrder  *string                        `form:"sortOrder" validate:"omitempty,oneof=asc desc"`                                            // Urutan pengurutan (asc/desc)
+	VenueID    *string                        `form:"venueID" validate:"omitempty,uuid"`                                                        // Filter pembayaran berdasarkan venue
+	OrderID    *string                        `form:"orderID" validate:"omitempty,uuid"`                                                        // Filter pembayaran berdasarkan order
+	Status     *constants.PaymentStatusString `form:"status" validate:"omitempty,oneof=initial pending settlement expire"`                      // Filter berdasarkan status pembayaran
+	Bank       *string                        `form:"bank"`                                                                                     // Filter berdasarkan nama bank
+	StartDate  *string                        `form:"startDate" validate:"omitempty,datetime=2006-01-02"`                                       // Tanggal awal pembayaran dibuat (inklusif)
+	EndDate    *string                        `form:"endDate" validate:"omitempty,datetime=2006-01-02"`                                         // Tanggal akhir pembayaran dibuat (inklusif)
+	MinAmount  *float64                       `form:"minAmount" validate:"omitempty,gte=0"`                                                     // Batas bawah jumlah pembayaran
+	MaxAmount  *float64                       `form:"maxAmount" validate:"omitempty,gte=0"`                                                     // Batas atas jumlah pembayaran
+}
+
+// BatchPaymentRequest digunakan untuk mengambil banyak pembayaran sekaligus
+// berdasarkan UUID pembayaran dan/atau order ID, misalnya oleh order-service lewat gRPC.
+type BatchPaymentRequest struct {
+	UUIDs    []string `json:"uuids" validate:"required_without=OrderIDs,max=100,dive,uuid"` // Daftar UUID pembayaran
+	OrderIDs []string `json:"orderIDs" validate:"required_without=UUIDs,max=100,dive,uuid"` // Daftar order ID
 }
 
 // UpdatePaymentRequest digunakan untuk memperbarui informasi status pembayaran,
@@ -60,6 +79,7 @@ type UpdatePaymentRequest struct {
 type PaymentResponse struct {
 	UUID          uuid.UUID                     `json:"uuid"`                    // UUID unik pembayaran
 	OrderID       uuid.UUID                     `json:"orderID"`                 // ID pesanan
+	VenueID       *uuid.UUID                    `json:"venueID,omitempty"`       // Venue pemilik lapangan
 	Amount        float64                       `json:"amount"`                  // Total jumlah pembayaran
 	Status        constants.PaymentStatusString `json:"status"`                  // Status pembayaran dalam bentuk string
 	PaymentLink   string                        `json:"paymentLink"`             // Link untuk melakukan pembayaran
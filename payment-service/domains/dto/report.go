@@ -0,0 +1,29 @@
+package dto
+
+import "time"
+
+// ReportRequestParam digunakan untuk menerima parameter query pada endpoint laporan,
+// misalnya GET /report/revenue?startDate=2025-01-01&endDate=2025-01-31&period=week&format=csv
+type ReportRequestParam struct {
+	StartDate string  `form:"startDate" validate:"required,datetime=2006-01-02"` // Tanggal awal laporan (inklusif)
+	EndDate   string  `form:"endDate" validate:"required,datetime=2006-01-02"`   // Tanggal akhir laporan (inklusif)
+	Period    string  `form:"period" validate:"omitempty,oneof=day week month"`  // Pengelompokan pendapatan, default: day
+	VenueID   *string `form:"venueID" validate:"omitempty,uuid"`                 // Filter laporan berdasarkan venue
+	Format    string  `form:"format" validate:"omitempty,oneof=json csv"`        // Format keluaran, default: json
+}
+
+// RevenueReportResponse berisi total pendapatan dari pembayaran yang sudah settlement
+// untuk satu periode (hari, minggu, atau bulan).
+type RevenueReportResponse struct {
+	PeriodStart      time.Time `json:"periodStart"`      // Awal periode
+	TotalAmount      float64   `json:"totalAmount"`      // Total pendapatan pada periode ini
+	TotalTransaction int64     `json:"totalTransaction"` // Jumlah transaksi pada periode ini
+}
+
+// PaymentMethodReportResponse berisi rekap pembayaran per bank dan acquirer.
+type PaymentMethodReportResponse struct {
+	Bank             string  `json:"bank"`             // Nama bank, kosong jika tidak tersedia
+	Acquirer         string  `json:"acquirer"`         // Nama acquirer, kosong jika tidak tersedia
+	TotalAmount      float64 `json:"totalAmount"`      // Total pendapatan melalui metode ini
+	TotalTransaction int64   `json:"totalTransaction"` // Jumlah transaksi melalui metode ini
+}
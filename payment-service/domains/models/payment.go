@@ -8,9 +8,10 @@ import (
 )
 
 type Payment struct {
-	ID               uint                     `gom:"primaryKey;autoIncrement"`
+	ID               uint                     `gorm:"primaryKey;autoIncrement"`
 	UUID             uuid.UUID                `gorm:"type:uuid;not null"`
 	OrderID          uuid.UUID                `gorm:"type:uuid;not null"`
+	VenueID          *uuid.UUID               `gorm:"type:uuid;default:null"`
 	Amount           float64                  `gorm:"not null"`
 	Status           *constants.PaymentStatus `gorm:"not null"`
 	PaymentLink      string                   `gorm:"type:varchar(255);not null"`
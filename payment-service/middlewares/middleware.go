@@ -2,22 +2,18 @@ package middlewares
 
 import (
 	"context"
-	"crypto/sha256"
-	"encoding/hex"
-	"fmt"
-	"log"
+	"errors"
 	"net/http"
 	"strings"
 
 	"github.com/anddriii/kita-futsal/payment-service/clients"
+	"github.com/anddriii/kita-futsal/payment-service/common/logger"
 	"github.com/anddriii/kita-futsal/payment-service/common/response"
-	"github.com/anddriii/kita-futsal/payment-service/config"
 	"github.com/anddriii/kita-futsal/payment-service/constants"
 	errCons "github.com/anddriii/kita-futsal/payment-service/constants/error"
 	"github.com/didip/tollbooth"
 	"github.com/didip/tollbooth/limiter"
 	"github.com/gin-gonic/gin"
-	"github.com/sirupsen/logrus"
 )
 
 // HandlePanic menangani panic yang tidak terduga selama request lifecycle.
@@ -26,7 +22,7 @@ func HandlePanic() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		defer func() {
 			if r := recover(); r != nil {
-				logrus.Errorf("Recovered from panic: %v", r)
+				logger.FromContext(ctx.Request.Context()).Errorf("recovered from panic: %v", r)
 				ctx.JSON(http.StatusInternalServerError, response.Response{
 					Status:  constants.Error,
 					Message: errCons.ErrInternalServerError.Error(),
@@ -64,32 +60,13 @@ func responUnauthorized(ctx *gin.Context, message string) {
 	ctx.Abort()
 }
 
-// validateApiKey memvalidasi API Key dari header dengan cara hashing signature key dan membandingkannya.
-// Return error jika tidak valid, nil jika valid.
-func validateApiKey(ctx *gin.Context) error {
-	apiKey := ctx.GetHeader(constants.XApiKey)
-	serviceName := ctx.GetHeader(constants.XServiceName)
-	requestAt := ctx.GetHeader(constants.XRequestAt)
-
-	log.Println("Apikey:", apiKey)
-	log.Println("servicename:", serviceName)
-	log.Println("request at:", requestAt)
-
-	signatureKey := config.Config.SignatureKey
-	validateKey := fmt.Sprintf("%s:%s:%s", serviceName, signatureKey, requestAt)
-
-	hash := sha256.New()
-	hash.Write([]byte(validateKey))
-	resultHash := hex.EncodeToString(hash.Sum(nil))
-
-	if apiKey != resultHash {
-		log.Println("Apikey tidak sesuai dengan hash")
-		log.Println("api key: ", apiKey)
-		log.Println("resultHash: ", resultHash)
-		return errCons.ErrUnauthorized
-	}
-
-	return nil
+// responServiceUnavailable mengirim response 503 saat user-service tidak bisa dihubungi.
+func responServiceUnavailable(ctx *gin.Context) {
+	ctx.JSON(http.StatusServiceUnavailable, response.Response{
+		Status:  constants.Error,
+		Message: errCons.ErrServiceUnavailable.Error(),
+	})
+	ctx.Abort()
 }
 
 // contains mengecek apakah sebuah role terdapat dalam daftar role yang diperbolehkan.
@@ -107,18 +84,22 @@ func contains(roles []string, role string) bool {
 func CheckRole(roles []string, client clients.IClientRegistry) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		user, err := client.GetUser().GetUserByToken(ctx.Request.Context())
-		// fmt.Println("user", user)
 		if err != nil {
-			fmt.Printf("error from get check role %s", err)
+			if errors.Is(err, errCons.ErrServiceUnavailable) {
+				responServiceUnavailable(ctx)
+				return
+			}
+			responUnauthorized(ctx, errCons.ErrUnauthorized.Error())
 			return
 		}
 
 		if !contains(roles, user.Role) {
-			fmt.Printf("unauthorized: user role %s not in %v\n", user.Role, roles)
-			fmt.Println("role user:", user.Role)
 			responUnauthorized(ctx, errCons.ErrUnauthorized.Error())
 			return
 		}
+
+		// UUID user yang login disimpan agar ikut tercatat di setiap log request
+		ctx.Request = ctx.Request.WithContext(context.WithValue(ctx.Request.Context(), constants.UserUUID, user.UUID.String()))
 		ctx.Next()
 	}
 }
@@ -138,13 +119,11 @@ func Authenticate() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		token := c.GetHeader(constants.Authorization)
 		if token == "" {
-			log.Println("error: token kosong")
 			responUnauthorized(c, errCons.ErrUnauthorized.Error())
 			return
 		}
 
-		if err := validateApiKey(c); err != nil {
-			log.Println("error vaidate API KEY")
+		if err := validateSignature(c); err != nil {
 			responUnauthorized(c, err.Error())
 			return
 		}
@@ -160,8 +139,7 @@ func Authenticate() gin.HandlerFunc {
 // AuthenticateWithoutToken hanya memverifikasi API Key tanpa memerlukan Authorization token.
 func AuthenticateWithoutToken() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		if err := validateApiKey(c); err != nil {
-			log.Println("error vaidate API KEY")
+		if err := validateSignature(c); err != nil {
 			responUnauthorized(c, err.Error())
 			return
 		}
@@ -0,0 +1,30 @@
+-- Skema awal, sama dengan hasil AutoMigrate sebelumnya. IF NOT EXISTS membuat database
+-- yang sudah dibuat oleh AutoMigrate bisa langsung memakai migrasi ini.
+CREATE TABLE IF NOT EXISTS payments (
+    id bigserial PRIMARY KEY,
+    uuid uuid NOT NULL,
+    order_id uuid NOT NULL,
+    venue_id uuid DEFAULT NULL,
+    amount decimal NOT NULL,
+    status bigint NOT NULL,
+    payment_link varchar(255) NOT NULL,
+    invoice_link varchar(255) DEFAULT NULL,
+    va_number varchar(50) DEFAULT NULL,
+    bank varchar(100) DEFAULT NULL,
+    acquirer varchar(100) DEFAULT NULL,
+    transaction_id varchar(100) DEFAULT NULL,
+    description text DEFAULT NULL,
+    paid_at timestamptz,
+    expired_at timestamptz,
+    created_at timestamptz,
+    updated_at timestamptz
+);
+
+CREATE TABLE IF NOT EXISTS payment_histories (
+    id bigserial PRIMARY KEY,
+    payment_id bigint NOT NULL,
+    status varchar(50) NOT NULL,
+    created_at timestamptz,
+    updated_at timestamptz,
+    CONSTRAINT fk_payments_payment_histories FOREIGN KEY (payment_id) REFERENCES payments (id) ON UPDATE CASCADE ON DELETE CASCADE
+);
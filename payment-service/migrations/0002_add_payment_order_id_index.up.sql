@@ -0,0 +1,3 @@
+-- Pembayaran dicari berdasarkan order_id setiap kali order-service meminta status
+-- pembayaran dan saat webhook Midtrans diproses.
+CREATE INDEX IF NOT EXISTS idx_payments_order_id ON payments (order_id);
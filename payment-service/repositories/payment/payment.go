@@ -2,7 +2,9 @@ package repositories
 
 import (
 	"context"
+	"time"
 
+	"github.com/anddriii/kita-futsal/payment-service/common/query"
 	"github.com/anddriii/kita-futsal/payment-service/domains/dto"
 	"github.com/anddriii/kita-futsal/payment-service/domains/models"
 	"gorm.io/gorm"
@@ -10,8 +12,12 @@ import (
 
 type IPaymentRepository interface {
 	FindAllWithPagination(ctx context.Context, param *dto.PaymentRequestParam) ([]models.Payment, int64, error)
+	FindAllWithCursor(ctx context.Context, param *dto.PaymentRequestParam, cursor *query.Cursor, desc bool) ([]models.Payment, int64, error)
 	FindByUUID(ctx context.Context, uuid string) (*models.Payment, error)
 	FindByOrderID(ctx context.Context, orderID string) (*models.Payment, error)
+	FindByUUIDsOrOrderIDs(ctx context.Context, uuids, orderIDs []string) ([]models.Payment, error)
 	Create(ctx context.Context, db *gorm.DB, req *dto.PaymentRequest) (*models.Payment, error)
 	Update(ctx context.Context, db *gorm.DB, orderID string, req *dto.UpdatePaymentRequest) (*models.Payment, error)
+	FindRevenueReport(ctx context.Context, period string, startAt, endAt time.Time, venueID *string) ([]dto.RevenueReportResponse, error)
+	FindPaymentMethodReport(ctx context.Context, startAt, endAt time.Time, venueID *string) ([]dto.PaymentMethodReportResponse, error)
 }
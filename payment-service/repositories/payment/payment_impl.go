@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	errWrap "github.com/anddriii/kita-futsal/payment-service/common/error"
+	"github.com/anddriii/kita-futsal/payment-service/common/query"
 	"github.com/anddriii/kita-futsal/payment-service/constants"
 	errConst "github.com/anddriii/kita-futsal/payment-service/constants/error"
 	errPayment "github.com/anddriii/kita-futsal/payment-service/constants/error/payment"
@@ -13,6 +15,7 @@ import (
 	"github.com/anddriii/kita-futsal/payment-service/domains/models"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // PaymentRepository adalah implementasi dari IPaymentRepository
@@ -44,6 +47,7 @@ func (p *PaymentRepository) Create(ctx context.Context, db *gorm.DB, req *dto.Pa
 	Payment := models.Payment{
 		UUID:        uuid.New(), // generate UUID baru untuk Payment
 		OrderID:     orderID,
+		VenueID:     req.VenueID,
 		Amount:      req.Amount,
 		PaymentLink: req.PaymentLink,
 		ExpiredAt:   &req.ExpiredAt,
@@ -59,7 +63,58 @@ func (p *PaymentRepository) Create(ctx context.Context, db *gorm.DB, req *dto.Pa
 	return &Payment, nil
 }
 
-// FindAllWithPagination mengambil daftar pembayaran dari database berdasarkan parameter paginasi dan sort.
+// paymentQuerySpec berisi kolom yang boleh dipakai untuk sorting daftar pembayaran.
+// Key adalah nilai sortColumn dari query parameter, value adalah nama kolom di database.
+var paymentQuerySpec = query.Spec{
+	SortColumns: map[string]string{
+		"amount":    "amount",
+		"status":    "status",
+		"paidAt":    "paid_at",
+		"expiredAt": "expired_at",
+		"createdAt": "created_at",
+		"updatedAt": "updated_at",
+	},
+	DefaultSort: clause.OrderByColumn{Column: clause.Column{Name: "created_at"}, Desc: true},
+}
+
+// filter menerapkan filter venue, order, status, bank, tanggal dibuat dan rentang jumlah pembayaran.
+func (p *PaymentRepository) filter(ctx context.Context, param *dto.PaymentRequestParam) *gorm.DB {
+	var status *constants.PaymentStatus
+	if param.Status != nil {
+		value := param.Status.GetStatusInt()
+		status = &value
+	}
+
+	return query.Apply(p.db.WithContext(ctx).Model(&models.Payment{}),
+		query.Equal("venue_id", param.VenueID),
+		query.Equal("order_id", param.OrderID),
+		query.Equal("status", status),
+		query.Equal("bank", param.Bank),
+		query.DateRange("created_at", param.StartDate, param.EndDate),
+		query.Range("amount", param.MinAmount, param.MaxAmount),
+	)
+}
+
+// count menghitung total data tanpa paginasi, dilewati jika SkipCount bernilai true.
+func (p *PaymentRepository) count(db *gorm.DB, param *dto.PaymentRequestParam) (int64, error) {
+	var total int64
+	if param.SkipCount {
+		return total, nil
+	}
+
+	err := db.
+		Session(&gorm.Session{}).
+		Count(&total).
+		Error
+	if err != nil {
+		return 0, errWrap.WrapError(errConst.ErrSQLError)
+	}
+	return total, nil
+}
+
+// FindAllWithPagination mengambil daftar pembayaran dari database berdasarkan parameter paginasi, sort dan filter.
+// Jika SkipCount bernilai true, total data tidak dihitung dan diambil satu baris tambahan
+// agar service bisa mengetahui apakah masih ada halaman berikutnya.
 // Parameter:
 //   - ctx: context untuk lifecycle
 //   - param: parameter pencarian dan paginasi (limit, page, sort)
@@ -69,25 +124,24 @@ func (p *PaymentRepository) Create(ctx context.Context, db *gorm.DB, req *dto.Pa
 //   - int64: total data (sebelum paginasi)
 //   - error: jika terjadi kesalahan database
 func (p *PaymentRepository) FindAllWithPagination(ctx context.Context, param *dto.PaymentRequestParam) ([]models.Payment, int64, error) {
-	var (
-		fields []models.Payment
-		sort   string
-		total  int64
-	)
+	var fields []models.Payment
 
-	// Atur sorting berdasarkan parameter, default: created_at desc
-	if param.SortColumn != nil {
-		sort = fmt.Sprintf("%s %s", *param.SortColumn, *param.SortOrder)
-	} else {
-		sort = "created_at desc"
+	// Atur sorting berdasarkan kolom yang terdaftar di paymentQuerySpec, default: created_at desc
+	sort, err := paymentQuerySpec.OrderBy(param.SortColumn, param.SortOrder)
+	if err != nil {
+		return nil, 0, err
 	}
 
 	limit := param.Limit
 	offset := (param.Page - 1) * limit
+	if param.SkipCount {
+		limit++
+	}
 
 	// Ambil data dengan paginasi
-	err := p.db.
-		WithContext(ctx).
+	db := p.filter(ctx, param)
+	err = db.
+		Session(&gorm.Session{}).
 		Limit(limit).
 		Offset(offset).
 		Order(sort).
@@ -97,17 +151,39 @@ func (p *PaymentRepository) FindAllWithPagination(ctx context.Context, param *dt
 		return nil, 0, errWrap.WrapError(errConst.ErrSQLError)
 	}
 
-	// Hitung total data (tanpa paginasi)
-	err = p.db.
-		WithContext(ctx).
-		Model(&fields).
-		Count(&total).
+	total, err := p.count(db, param)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return fields, total, nil
+}
+
+// FindAllWithCursor mengambil daftar pembayaran dengan paginasi keyset (cursor).
+// Diambil limit+1 baris untuk mendeteksi halaman berikutnya, lihat query.KeysetPage.
+func (p *PaymentRepository) FindAllWithCursor(
+	ctx context.Context,
+	param *dto.PaymentRequestParam,
+	cursor *query.Cursor,
+	desc bool,
+) ([]models.Payment, int64, error) {
+	var payments []models.Payment
+
+	db := p.filter(ctx, param)
+	err := query.Keyset(db.Session(&gorm.Session{}), cursor, desc).
+		Limit(param.Limit + 1).
+		Find(&payments).
 		Error
 	if err != nil {
 		return nil, 0, errWrap.WrapError(errConst.ErrSQLError)
 	}
 
-	return fields, total, nil
+	total, err := p.count(db, param)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return payments, total, nil
 }
 
 // FindByOrderID mencari data Payment berdasarkan Order ID.
@@ -164,6 +240,35 @@ func (p *PaymentRepository) FindByUUID(ctx context.Context, uuid string) (*model
 	return &payment, nil
 }
 
+// FindByUUIDsOrOrderIDs mengambil banyak data Payment sekaligus berdasarkan UUID dan/atau order ID.
+// Data yang tidak ditemukan tidak dianggap error, cukup tidak ada di hasil.
+// Parameter:
+//   - ctx: context
+//   - uuids: daftar UUID Payment
+//   - orderIDs: daftar ID order
+//
+// Return:
+//   - []models.Payment: data pembayaran yang ditemukan
+//   - error: jika gagal query
+func (p *PaymentRepository) FindByUUIDsOrOrderIDs(ctx context.Context, uuids, orderIDs []string) ([]models.Payment, error) {
+	var payments []models.Payment
+
+	query := p.db.WithContext(ctx).Where("1 = 0")
+	if len(uuids) > 0 {
+		query = query.Or("uuid IN ?", uuids)
+	}
+	if len(orderIDs) > 0 {
+		query = query.Or("order_id IN ?", orderIDs)
+	}
+
+	err := query.Find(&payments).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConst.ErrSQLError)
+	}
+
+	return payments, nil
+}
+
 // Update memperbarui data Payment berdasarkan order ID.
 // Parameter:
 //   - ctx: context
@@ -195,3 +300,61 @@ func (p *PaymentRepository) Update(ctx context.Context, db *gorm.DB, orderID str
 
 	return &payment, nil
 }
+
+// settledPaymentQuery menyiapkan query pembayaran yang sudah settlement dengan paid_at
+// di antara startAt (inklusif) dan endAt (eksklusif), opsional difilter berdasarkan venue.
+func (p *PaymentRepository) settledPaymentQuery(ctx context.Context, startAt, endAt time.Time, venueID *string) *gorm.DB {
+	query := p.db.WithContext(ctx).
+		Model(&models.Payment{}).
+		Where("status = ? AND paid_at >= ? AND paid_at < ?", constants.Settlement, startAt, endAt)
+	if venueID != nil && *venueID != "" {
+		query = query.Where("venue_id = ?", *venueID)
+	}
+	return query
+}
+
+// FindRevenueReport menghitung total pendapatan per periode (day, week, month).
+// Nilai period sudah divalidasi oleh service sehingga aman dipakai di date_trunc.
+func (p *PaymentRepository) FindRevenueReport(
+	ctx context.Context,
+	period string,
+	startAt, endAt time.Time,
+	venueID *string,
+) ([]dto.RevenueReportResponse, error) {
+	var results []dto.RevenueReportResponse
+
+	periodColumn := fmt.Sprintf("date_trunc('%s', paid_at)", period)
+	err := p.settledPaymentQuery(ctx, startAt, endAt, venueID).
+		Select(fmt.Sprintf("%s AS period_start, SUM(amount) AS total_amount, COUNT(*) AS total_transaction", periodColumn)).
+		Group(periodColumn).
+		Order("period_start asc").
+		Scan(&results).
+		Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConst.ErrSQLError)
+	}
+
+	return results, nil
+}
+
+// FindPaymentMethodReport menghitung total pendapatan per bank dan acquirer.
+func (p *PaymentRepository) FindPaymentMethodReport(
+	ctx context.Context,
+	startAt, endAt time.Time,
+	venueID *string,
+) ([]dto.PaymentMethodReportResponse, error) {
+	var results []dto.PaymentMethodReportResponse
+
+	err := p.settledPaymentQuery(ctx, startAt, endAt, venueID).
+		Select("COALESCE(bank, '') AS bank, COALESCE(acquirer, '') AS acquirer, " +
+			"SUM(amount) AS total_amount, COUNT(*) AS total_transaction").
+		Group("COALESCE(bank, ''), COALESCE(acquirer, '')").
+		Order("total_amount desc").
+		Scan(&results).
+		Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConst.ErrSQLError)
+	}
+
+	return results, nil
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/anddriii/kita-futsal/payment-service/clients"
 	controllers "github.com/anddriii/kita-futsal/payment-service/controllers/http"
 	routes "github.com/anddriii/kita-futsal/payment-service/routes/payment"
+	reportRoute "github.com/anddriii/kita-futsal/payment-service/routes/report"
 	"github.com/gin-gonic/gin"
 )
 
@@ -31,8 +32,13 @@ func NewRouteRegistry(
 
 func (r *Registry) Serve() {
 	r.paymentRoute().Run()
+	r.reportRoute().Run()
 }
 
 func (r *Registry) paymentRoute() routes.IPaymentRoute {
 	return routes.NewPaymentRoute(r.group, r.controller, r.client)
 }
+
+func (r *Registry) reportRoute() reportRoute.IReportRoute {
+	return reportRoute.NewReportRoute(r.group, r.controller, r.client)
+}
@@ -0,0 +1,43 @@
+package routes
+
+import (
+	"github.com/anddriii/kita-futsal/payment-service/clients"
+	"github.com/anddriii/kita-futsal/payment-service/constants"
+	controllers "github.com/anddriii/kita-futsal/payment-service/controllers/http"
+	"github.com/anddriii/kita-futsal/payment-service/middlewares"
+	"github.com/gin-gonic/gin"
+)
+
+type ReportRoute struct {
+	controller controllers.IControllerRegistry
+	client     clients.IClientRegistry
+	group      *gin.RouterGroup
+}
+
+type IReportRoute interface {
+	Run()
+}
+
+func NewReportRoute(
+	group *gin.RouterGroup,
+	controller controllers.IControllerRegistry,
+	client clients.IClientRegistry,
+) IReportRoute {
+	return &ReportRoute{
+		controller: controller,
+		client:     client,
+		group:      group,
+	}
+}
+
+func (r *ReportRoute) Run() {
+	// Laporan hanya bisa diakses oleh Admin, gunakan format=csv untuk mengunduh file CSV
+	group := r.group.Group("/report")
+	group.Use(middlewares.Authenticate())
+	group.GET("/revenue", middlewares.CheckRole([]string{
+		constants.Admin,
+	}, r.client), r.controller.GetReport().GetRevenue)
+	group.GET("/payment-method", middlewares.CheckRole([]string{
+		constants.Admin,
+	}, r.client), r.controller.GetReport().GetPaymentMethod)
+}
@@ -10,6 +10,8 @@ import (
 type IPaymentService interface {
 	GetAllWithPagination(ctx context.Context, param *dto.PaymentRequestParam) (*util.PaginationResult, error)
 	GetByUUID(ctx context.Context, uuid string) (*dto.PaymentResponse, error)
+	GetBatch(ctx context.Context, req *dto.BatchPaymentRequest) ([]dto.PaymentResponse, error)
 	Create(ctx context.Context, req *dto.PaymentRequest) (*dto.PaymentResponse, error)
 	WebHook(ctx context.Context, req *dto.Webhook) error
+	Expire(ctx context.Context, orderID string) (*dto.PaymentResponse, error)
 }
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
 	"math/rand"
 	"os"
 	"strings"
@@ -13,6 +12,9 @@ import (
 
 	clients "github.com/anddriii/kita-futsal/payment-service/clients/midtrans"
 	"github.com/anddriii/kita-futsal/payment-service/common/gcs"
+	"github.com/anddriii/kita-futsal/payment-service/common/logger"
+	"github.com/anddriii/kita-futsal/payment-service/common/metrics"
+	"github.com/anddriii/kita-futsal/payment-service/common/query"
 	"github.com/anddriii/kita-futsal/payment-service/common/util"
 	config2 "github.com/anddriii/kita-futsal/payment-service/config"
 	"github.com/anddriii/kita-futsal/payment-service/constants"
@@ -52,13 +54,14 @@ func (p *PaymentService) Create(ctx context.Context, req *dto.PaymentRequest) (*
 			return errPayment.ErrExpireArInvalid
 		}
 
-		midtrans, txErr = p.midtrans.CreatePaymentLink(req)
+		midtrans, txErr = p.midtrans.CreatePaymentLink(ctx, req)
 		if txErr != nil {
 			return txErr
 		}
 
 		paymentRequest := &dto.PaymentRequest{
 			OrderID:     req.OrderID,
+			VenueID:     req.VenueID,
 			Amount:      req.Amount,
 			Description: req.Description,
 			ExpiredAt:   req.ExpiredAt,
@@ -84,6 +87,7 @@ func (p *PaymentService) Create(ctx context.Context, req *dto.PaymentRequest) (*
 	response = &dto.PaymentResponse{
 		UUID:        payment.UUID,
 		OrderID:     payment.OrderID,
+		VenueID:     payment.VenueID,
 		Amount:      payment.Amount,
 		Status:      payment.Status.GetStatusString(),
 		PaymentLink: payment.PaymentLink,
@@ -94,22 +98,86 @@ func (p *PaymentService) Create(ctx context.Context, req *dto.PaymentRequest) (*
 
 // GetAllWithPagination implements IPaymentService.
 // Fungsi ini mengambil semua data pembayaran dari database dengan fitur pagination.
-// Data yang diambil kemudian dikonversi ke bentuk DTO PaymentResponse.
+// Mendukung dua mode: offset (page/limit) dan cursor (keyset) jika pagination=cursor.
 // Hasilnya dibungkus dalam objek PaginationResult agar mendukung pagination di sisi client.
 func (p *PaymentService) GetAllWithPagination(
 	ctx context.Context,
 	param *dto.PaymentRequestParam,
 ) (*util.PaginationResult, error) {
+	if param.Pagination == query.CursorPagination {
+		return p.getAllWithCursor(ctx, param)
+	}
+
 	payments, total, err := p.repository.GetPayment().FindAllWithPagination(ctx, param)
 	if err != nil {
 		return nil, err
 	}
+
+	// Baris tambahan hanya diambil saat SkipCount, dipakai untuk menentukan halaman berikutnya
+	hasNext := param.SkipCount && len(payments) > param.Limit
+	if hasNext {
+		payments = payments[:param.Limit]
+	}
+
+	// Buat parameter pagination untuk hasil akhir
+	paginationParam := util.PaginationParam{
+		Page:      param.Page,
+		Limit:     param.Limit,
+		Count:     total,
+		Data:      p.toPaymentResponses(payments),
+		SkipCount: param.SkipCount,
+		HasNext:   hasNext,
+	}
+
+	// Generate response pagination
+	response := util.GeneratePagination(paginationParam)
+	return &response, nil
+}
+
+// getAllWithCursor mengambil data pembayaran dengan paginasi keyset berdasarkan (created_at, id).
+func (p *PaymentService) getAllWithCursor(ctx context.Context, param *dto.PaymentRequestParam) (*util.PaginationResult, error) {
+	var cursor *query.Cursor
+	desc, err := query.CursorDesc(param.SortColumn, param.SortOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	if param.Cursor != nil && *param.Cursor != "" {
+		cursor, err = query.DecodeCursor(*param.Cursor)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	payments, total, err := p.repository.GetPayment().FindAllWithCursor(ctx, param, cursor, desc)
+	if err != nil {
+		return nil, err
+	}
+
+	payments, nextCursor, prevCursor := query.KeysetPage(payments, param.Limit, cursor, func(payment models.Payment) query.Cursor {
+		return query.Cursor{CreatedAt: *payment.CreatedAt, ID: payment.ID}
+	})
+
+	response := util.GenerateCursorPagination(util.CursorPaginationParam{
+		Count:      total,
+		Limit:      param.Limit,
+		SkipCount:  param.SkipCount,
+		NextCursor: nextCursor,
+		PrevCursor: prevCursor,
+		Data:       p.toPaymentResponses(payments),
+	})
+	return &response, nil
+}
+
+// toPaymentResponses mengonversi daftar model Payment ke bentuk DTO PaymentResponse.
+func (p *PaymentService) toPaymentResponses(payments []models.Payment) []dto.PaymentResponse {
 	paymentResults := make([]dto.PaymentResponse, 0, len(payments))
 	for _, payment := range payments {
 		paymentResults = append(paymentResults, dto.PaymentResponse{
 			UUID:          payment.UUID,
 			TransactionID: payment.TransactionID,
 			OrderID:       payment.OrderID,
+			VenueID:       payment.VenueID,
 			Amount:        payment.Amount,
 			Status:        payment.Status.GetStatusString(),
 			PaymentLink:   payment.PaymentLink,
@@ -122,18 +190,7 @@ func (p *PaymentService) GetAllWithPagination(
 			UpdatedAt:     payment.UpdatedAt,
 		})
 	}
-
-	// Buat parameter pagination untuk hasil akhir
-	paginationParam := util.PaginationParam{
-		Page:  param.Page,
-		Limit: param.Limit,
-		Count: total,
-		Data:  paymentResults,
-	}
-
-	// Generate response pagination
-	response := util.GeneratePagination(paginationParam)
-	return &response, nil
+	return paymentResults
 }
 
 // GetByUUID implements IPaymentService.
@@ -147,6 +204,7 @@ func (p *PaymentService) GetByUUID(ctx context.Context, uuid string) (*dto.Payme
 		UUID:          payment.UUID,
 		TransactionID: payment.TransactionID,
 		OrderID:       payment.OrderID,
+		VenueID:       payment.VenueID,
 		Amount:        payment.Amount,
 		Status:        payment.Status.GetStatusString(),
 		PaymentLink:   payment.PaymentLink,
@@ -160,6 +218,17 @@ func (p *PaymentService) GetByUUID(ctx context.Context, uuid string) (*dto.Payme
 	}, nil
 }
 
+// GetBatch implements IPaymentService.
+// Mengambil banyak pembayaran sekaligus agar service lain tidak memanggil GetByUUID satu per satu.
+func (p *PaymentService) GetBatch(ctx context.Context, req *dto.BatchPaymentRequest) ([]dto.PaymentResponse, error) {
+	payments, err := p.repository.GetPayment().FindByUUIDsOrOrderIDs(ctx, req.UUIDs, req.OrderIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.toPaymentResponses(payments), nil
+}
+
 // Fungsi untuk mengkonversi nama bulan dari bahasa Inggris ke Indonesia
 func (p *PaymentService) convertToIndonesianMonth(englishMonth string) string {
 	// Peta (map) yang berisi mapping nama bulan Inggris-Indonesia
@@ -257,7 +326,7 @@ func (p *PaymentService) mapTransactionStatusToEvent(status constants.PaymentSta
 }
 
 // Fungsi untuk memproduksi message ke Kafka
-func (p *PaymentService) produceToKafka(req *dto.Webhook, payment *models.Payment, paidAt *time.Time) error {
+func (p *PaymentService) produceToKafka(ctx context.Context, req *dto.Webhook, payment *models.Payment, paidAt *time.Time, invoiceLink string) error {
 	// Membuat struktur event Kafka
 	event := dto.KafkaEvent{
 		Name: p.mapTransactionStatusToEvent(req.TransactionStatus),
@@ -285,6 +354,9 @@ func (p *PaymentService) produceToKafka(req *dto.Webhook, payment *models.Paymen
 			ExpiredAt: expiredAt,                      // Waktu kadaluarsa
 		},
 	}
+	if invoiceLink != "" {
+		body.Data.InvoiceLink = &invoiceLink // Link invoice untuk notifikasi pembayaran berhasil
+	}
 
 	// Membuat struktur message Kafka lengkap
 	kafkaMessage := dto.KafkaMessage{
@@ -299,7 +371,7 @@ func (p *PaymentService) produceToKafka(req *dto.Webhook, payment *models.Paymen
 	kafkaMessageJSON, _ := json.Marshal(kafkaMessage)
 
 	// Memproduksi message ke Kafka
-	err := p.kafka.GetKafkaProducer().ProduceMessage(topic, kafkaMessageJSON)
+	err := p.kafka.GetKafkaProducer().ProduceMessage(ctx, topic, kafkaMessageJSON)
 	if err != nil {
 		return err
 	}
@@ -418,21 +490,19 @@ func (p *PaymentService) WebHook(ctx context.Context, req *dto.Webhook) error {
 			// Generate PDF invoice
 			pdf, txErr = p.generatePDF(invoiceRequest)
 			if txErr != nil {
-				log.Printf("Failed to generate PDF: %v", txErr)
+				logger.FromContext(ctx).Errorf("failed to generate PDF: %v", txErr)
 				return txErr
 			}
 
 			//Upload invoice ke lokal
 			invoiceLink, txErr = util.InvoiceLocal(pdf, invoiceNumber)
 			if txErr != nil {
-				log.Printf("Failed to upload invoice locally: %v", txErr)
+				logger.FromContext(ctx).Errorf("failed to upload invoice locally: %v", txErr)
 				return txErr
 			}
 
 			invoiceLink = constants.BuildInvoiceURL(invoiceLink)
 
-			fmt.Println("Invoice Link: ", invoiceLink)
-
 			// // Upload invoice ke GCS
 			// invoiceLink, txErr = p.uploadToGCS(ctx, invoiceNumber, pdf)
 			// if txErr != nil {
@@ -454,8 +524,16 @@ func (p *PaymentService) WebHook(ctx context.Context, req *dto.Webhook) error {
 		return err
 	}
 
+	// Mencatat pembayaran yang selesai atau kedaluwarsa setelah transaksi berhasil disimpan
+	switch req.TransactionStatus {
+	case constants.SettlementString:
+		metrics.PaymentsSettled.Inc()
+	case constants.ExpireString:
+		metrics.PaymentsExpired.Inc()
+	}
+
 	// Memproduksi message ke Kafka
-	err = p.produceToKafka(req, paymentAfterUpdate, paidAt)
+	err = p.produceToKafka(ctx, req, paymentAfterUpdate, paidAt, invoiceLink)
 	if err != nil {
 		return fmt.Errorf("failed to produce message to kafka: %w", err)
 	}
@@ -463,6 +541,53 @@ func (p *PaymentService) WebHook(ctx context.Context, req *dto.Webhook) error {
 	return nil
 }
 
+// Expire menutup pembayaran milik order yang dibatalkan agar payment link-nya tidak
+// bisa dibayar lagi. Transaksi di Midtrans ditutup lebih dulu; jika Midtrans menolak
+// karena sudah dibayar, status lokal tidak diubah. Pembayaran yang sudah settlement
+// mengembalikan ErrPaymentSettled, sedangkan yang sudah expire dikembalikan apa adanya.
+// Tidak ada event Kafka yang dikirim karena pemanggilnya adalah order-service sendiri.
+func (p *PaymentService) Expire(ctx context.Context, orderID string) (*dto.PaymentResponse, error) {
+	payment, err := p.repository.GetPayment().FindByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch *payment.Status {
+	case constants.Settlement:
+		return nil, errPayment.ErrPaymentSettled
+	case constants.Expire:
+		response := p.toPaymentResponses([]models.Payment{*payment})
+		return &response[0], nil
+	}
+
+	err = p.midtrans.ExpirePayment(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	status := constants.Expire
+	err = p.repository.GetTx().Transaction(func(tx *gorm.DB) error {
+		_, txErr := p.repository.GetPayment().Update(ctx, tx, orderID, &dto.UpdatePaymentRequest{
+			Status: &status,
+		})
+		if txErr != nil {
+			return txErr
+		}
+
+		return p.repository.GetPaymentHistory().Create(ctx, tx, &dto.PaymentHistoryRequest{
+			PaymentID: payment.ID,
+			Status:    status.GetStatusString(),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	payment.Status = &status
+	response := p.toPaymentResponses([]models.Payment{*payment})
+	return &response[0], nil
+}
+
 // Constructor untuk PaymentService
 func NewPaymentService(repository repositories.IRepositoryRegistry, gcs gcs.IGCSClient, kafka kafka.IKafkaRegistry, midtrans clients.IMidtransClient) IPaymentService {
 	return &PaymentService{
@@ -6,6 +6,7 @@ import (
 	"github.com/anddriii/kita-futsal/payment-service/controllers/kafka"
 	"github.com/anddriii/kita-futsal/payment-service/repositories"
 	services "github.com/anddriii/kita-futsal/payment-service/service/payment"
+	reportService "github.com/anddriii/kita-futsal/payment-service/service/report"
 )
 
 type Registry struct {
@@ -17,6 +18,7 @@ type Registry struct {
 
 type IServiceRegistry interface {
 	GetPayment() services.IPaymentService
+	GetReport() reportService.IReportService
 }
 
 func NewServiceRegistry(
@@ -36,3 +38,7 @@ func NewServiceRegistry(
 func (r *Registry) GetPayment() services.IPaymentService {
 	return services.NewPaymentService(r.repository, r.gcs, r.kafka, r.midtrans)
 }
+
+func (r *Registry) GetReport() reportService.IReportService {
+	return reportService.NewReportService(r.repository)
+}
@@ -0,0 +1,12 @@
+package service
+
+import (
+	"context"
+
+	"github.com/anddriii/kita-futsal/payment-service/domains/dto"
+)
+
+type IReportService interface {
+	GetRevenue(ctx context.Context, param *dto.ReportRequestParam) ([]dto.RevenueReportResponse, error)
+	GetPaymentMethod(ctx context.Context, param *dto.ReportRequestParam) ([]dto.PaymentMethodReportResponse, error)
+}
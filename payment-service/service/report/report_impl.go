@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	errReport "github.com/anddriii/kita-futsal/payment-service/constants/error/report"
+	"github.com/anddriii/kita-futsal/payment-service/domains/dto"
+	"github.com/anddriii/kita-futsal/payment-service/repositories"
+)
+
+const defaultPeriod = "day"
+
+// periods adalah daftar periode yang boleh dipakai untuk pengelompokan pendapatan.
+var periods = map[string]bool{
+	"day":   true,
+	"week":  true,
+	"month": true,
+}
+
+type ReportService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+func NewReportService(repository repositories.IRepositoryRegistry) IReportService {
+	return &ReportService{repository: repository}
+}
+
+// parseDateRange mengubah startDate dan endDate (format YYYY-MM-DD) menjadi rentang waktu
+// [startAt, endAt) sehingga seluruh transaksi pada tanggal akhir ikut terhitung.
+func (r *ReportService) parseDateRange(param *dto.ReportRequestParam) (time.Time, time.Time, error) {
+	startAt, err := time.ParseInLocation(time.DateOnly, param.StartDate, time.Local)
+	if err != nil {
+		return time.Time{}, time.Time{}, errReport.ErrInvalidDateRange
+	}
+
+	endAt, err := time.ParseInLocation(time.DateOnly, param.EndDate, time.Local)
+	if err != nil {
+		return time.Time{}, time.Time{}, errReport.ErrInvalidDateRange
+	}
+
+	if startAt.After(endAt) {
+		return time.Time{}, time.Time{}, errReport.ErrInvalidDateRange
+	}
+
+	return startAt, endAt.AddDate(0, 0, 1), nil
+}
+
+// GetRevenue implements IReportService.
+// Menghitung pendapatan dari pembayaran settlement yang dikelompokkan per hari, minggu, atau bulan.
+func (r *ReportService) GetRevenue(ctx context.Context, param *dto.ReportRequestParam) ([]dto.RevenueReportResponse, error) {
+	startAt, endAt, err := r.parseDateRange(param)
+	if err != nil {
+		return nil, err
+	}
+
+	period := param.Period
+	if period == "" {
+		period = defaultPeriod
+	}
+	if !periods[period] {
+		return nil, errReport.ErrInvalidPeriod
+	}
+
+	results, err := r.repository.GetPayment().FindRevenueReport(ctx, period, startAt, endAt, param.VenueID)
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// GetPaymentMethod implements IReportService.
+// Menghitung rekap pembayaran settlement per bank dan acquirer.
+func (r *ReportService) GetPaymentMethod(ctx context.Context, param *dto.ReportRequestParam) ([]dto.PaymentMethodReportResponse, error) {
+	startAt, endAt, err := r.parseDateRange(param)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := r.repository.GetPayment().FindPaymentMethodReport(ctx, startAt, endAt, param.VenueID)
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
@@ -0,0 +1,24 @@
+#!/usr/bin/env sh
+# Regenerates the Go stubs of every service from the contracts in this
+# directory. Requires protoc, protoc-gen-go and protoc-gen-go-grpc on PATH.
+set -e
+
+cd "$(dirname "$0")"
+
+generate() {
+  service=$1
+  shift
+  for name in "$@"; do
+    protoc -I . \
+      --go_out="../$service" --go_opt=module="github.com/anddriii/kita-futsal/$service" \
+      --go_opt="M$name/$name.proto=github.com/anddriii/kita-futsal/$service/proto/$name" \
+      --go-grpc_out="../$service" --go-grpc_opt=module="github.com/anddriii/kita-futsal/$service" \
+      --go-grpc_opt="M$name/$name.proto=github.com/anddriii/kita-futsal/$service/proto/$name" \
+      "$name/$name.proto"
+  done
+}
+
+generate user-service user
+generate field-service field
+generate payment-service payment
+generate order-service user field payment
@@ -1,18 +1,28 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"net/http"
 	"time"
 
+	"github.com/anddriii/kita-futsal/user-service/common/health"
+	"github.com/anddriii/kita-futsal/user-service/common/lifecycle"
+	"github.com/anddriii/kita-futsal/user-service/common/logger"
+	"github.com/anddriii/kita-futsal/user-service/common/messaging"
+	"github.com/anddriii/kita-futsal/user-service/common/metrics"
+	"github.com/anddriii/kita-futsal/user-service/common/oidc"
+	"github.com/anddriii/kita-futsal/user-service/common/otp"
 	"github.com/anddriii/kita-futsal/user-service/common/response"
+	"github.com/anddriii/kita-futsal/user-service/common/signature"
+	"github.com/anddriii/kita-futsal/user-service/common/tracing"
 	"github.com/anddriii/kita-futsal/user-service/config"
 	"github.com/anddriii/kita-futsal/user-service/constants"
 	"github.com/anddriii/kita-futsal/user-service/controllers"
+	grpcController "github.com/anddriii/kita-futsal/user-service/controllers/grpc"
 	"github.com/anddriii/kita-futsal/user-service/database/seeders"
-	"github.com/anddriii/kita-futsal/user-service/domain/models"
 	"github.com/anddriii/kita-futsal/user-service/middlewares"
+	pb "github.com/anddriii/kita-futsal/user-service/proto/user"
 	"github.com/anddriii/kita-futsal/user-service/repositories"
 	"github.com/anddriii/kita-futsal/user-service/routes"
 	"github.com/anddriii/kita-futsal/user-service/services"
@@ -20,9 +30,28 @@ import (
 	"github.com/didip/tollbooth/limiter"
 	"github.com/gin-gonic/gin"
 	"github.com/joho/godotenv"
+	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
 )
 
+// shutdownTimeout membatasi waktu yang diberikan ke request yang sedang berjalan
+// untuk selesai setelah SIGTERM.
+const shutdownTimeout = 30 * time.Second
+
+// oidcTimeout membatasi durasi setiap request ke provider OIDC.
+const oidcTimeout = 10 * time.Second
+
+// Nilai default OTP jika tidak diatur di konfigurasi.
+const (
+	defaultOTPCodeTTL        = 5 * time.Minute
+	defaultOTPMaxAttempts    = 5
+	defaultOTPResendCooldown = time.Minute
+)
+
+// probeTimeout membatasi durasi setiap pemeriksaan dependency pada endpoint readiness.
+const probeTimeout = 2 * time.Second
+
 // command adalah objek Cobra untuk menjalankan perintah "serve"
 var command = cobra.Command{
 	Use:   "serve",
@@ -31,7 +60,19 @@ var command = cobra.Command{
 		// Memuat variabel lingkungan dari file .env
 		_ = godotenv.Load()
 		config.Init()
-		fmt.Printf("Config setelah Init: %+v\n", config.Config)
+		// Logger JSON dipasang paling awal supaya semua log berikutnya sudah terstruktur
+		logger.Init(config.Config.AppName)
+
+		// Tracer dipasang sebelum database supaya query pertama ikut tercatat
+		shutdownTracing, err := tracing.Init(
+			config.Config.AppName,
+			config.Config.Tracing.Exporter,
+			config.Config.Tracing.Endpoint,
+			config.Config.Tracing.Insecure,
+		)
+		if err != nil {
+			panic(err)
+		}
 
 		// Inisialisasi koneksi database
 		db, err := config.InitDB()
@@ -39,6 +80,18 @@ var command = cobra.Command{
 			panic(err)
 		}
 
+		// Mencatat durasi setiap query GORM ke metric Prometheus
+		err = db.Use(metrics.GormPlugin{})
+		if err != nil {
+			panic(err)
+		}
+
+		// Mencatat setiap query GORM sebagai span di trace request
+		err = db.Use(tracing.GormPlugin{})
+		if err != nil {
+			panic(err)
+		}
+
 		// Mengatur zona waktu lokal ke "Asia/Jakarta"
 		loc, err := time.LoadLocation("Asia/Jakarta")
 		if err != nil {
@@ -46,25 +99,67 @@ var command = cobra.Command{
 		}
 		time.Local = loc
 
-		// Migrasi database untuk model Role dan User
-		err = db.AutoMigrate(
-			&models.Role{},
-			&models.User{},
-		)
+		// Menjalankan migrasi SQL yang belum diterapkan sebelum seeder mengisi data awal.
+		// Replica yang start bersamaan menunggu lewat advisory lock
+		err = runMigrations(context.Background(), loadMigrator(db))
 		if err != nil {
-			panic(err)
+			logrus.Fatalf("error in migrate %s", err)
 		}
 
 		// Menjalankan seeder untuk mengisi data awal database
 		seeders.NewSeederRegistry(db).Run()
 
+		// Nonce request antar service disimpan di Redis untuk menolak replay
+		rdb := config.NewRedisClient()
+		middlewares.SetNonceStore(signature.NewRedisNonceStore(rdb))
+
 		// Inisialisasi repository, service, dan controller
 		repository := repositories.NewRepoRegistry(db)
-		service := services.NewServiceRegistry(repository)
+		// Login OIDC menyimpan state, nonce, dan code verifier PKCE di Redis
+		oidcClient := oidc.NewClient(config.Config.OIDC, oidc.NewRedisSessionStore(rdb), &http.Client{Timeout: oidcTimeout})
+		// Kode OTP verifikasi nomor telepon dan login tanpa password disimpan di Redis
+		sender, err := messaging.NewSender(config.Config.OTP.Driver)
+		if err != nil {
+			panic(err)
+		}
+		otpManager := otp.NewManager(rdb, sender, config.Config.JwtSecretKey, otpConfig())
+		service := services.NewServiceRegistry(repository, oidcClient, otpManager)
 		controller := controllers.NewControllerRegistry(service)
 
+		// Token selalu dicocokkan dengan data user terbaru, jadi akun yang dinonaktifkan
+		// langsung ditolak walaupun tokennya belum kedaluwarsa
+		middlewares.SetUserLoader(service.GetUser())
+
+		sqlDB, err := db.DB()
+		if err != nil {
+			logrus.Fatalf("error in get sql db %s", err)
+		}
+
+		// Readiness memeriksa database dan Redis yang dipakai untuk nonce
+		checker := health.NewChecker(probeTimeout,
+			health.Check{Name: "postgres", Probe: sqlDB.PingContext},
+			health.Check{Name: "redis", Probe: func(ctx context.Context) error {
+				return rdb.Ping(ctx).Err()
+			}},
+		)
+
 		// Membuat instance router Gin
-		router := gin.Default()
+		router := gin.New()
+		// Controller meneruskan *gin.Context ke service sebagai context.Context, jadi
+		// nilai di context request (span, user login) harus bisa dibaca lewat gin.Context
+		router.ContextWithFallback = true
+
+		// Middleware untuk memberi setiap request ID yang ikut ke log dan ke service lain
+		router.Use(middlewares.RequestID())
+
+		// Middleware untuk membuat span setiap request, menyambung trace dari header traceparent
+		router.Use(tracing.Middleware())
+
+		// Middleware untuk menulis access log JSON, menggantikan logger bawaan gin
+		router.Use(middlewares.Logger())
+
+		// Middleware untuk mencatat durasi dan status setiap request ke metric Prometheus
+		router.Use(middlewares.Metrics())
 
 		// Middleware untuk menangani panic dan mengembalikan response yang sesuai
 		router.Use(middlewares.HandlePanic())
@@ -85,12 +180,18 @@ var command = cobra.Command{
 			})
 		})
 
+		// Endpoint liveness dan readiness untuk probe Kubernetes
+		router.GET("/healthz", health.Liveness)
+		router.GET("/readyz", checker.Readiness)
+		router.GET("/metrics", gin.WrapH(metrics.Handler()))
+
 		// Middleware untuk menangani CORS (Cross-Origin Resource Sharing)
 		router.Use(func(ctx *gin.Context) {
 			// FIX TYPO: 'Access', bukan 'Acces'
 			ctx.Writer.Header().Set("Access-Control-Allow-Origin", "*")
-			ctx.Writer.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-			ctx.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, x-service-name, x-api-key, x-request-at")
+			ctx.Writer.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+			ctx.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, x-service-name, x-request-at, x-nonce, x-signature, x-request-id, traceparent, tracestate, baggage")
+			ctx.Writer.Header().Set("Access-Control-Expose-Headers", "X-Request-ID")
 
 			// TANGANI PREFLIGHT REQUEST
 			// Kalau method-nya OPTIONS, kasih response sukses 204 dan STOP di sini.
@@ -114,19 +215,67 @@ var command = cobra.Command{
 		route := routes.NewRouteRegistry(controller, group)
 		route.Serve()
 
-		// Menjalankan server pada port yang telah dikonfigurasi
-		port := fmt.Sprintf(":%d", config.Config.Port)
-		router.Run(port)
+		// Komponen dihentikan dengan urutan terbalik: HTTP dan gRPC berhenti menerima
+		// request lebih dulu, baru kemudian Redis dan database ditutup
+		app := lifecycle.New(shutdownTimeout)
+		app.AddCloser("tracer", shutdownTracing)
+		app.AddCloser("database", sqlDB.Close)
+		app.AddCloser("redis", rdb.Close)
+		app.Add(lifecycle.GrpcServer(grpcServer(service), fmt.Sprintf(":%d", config.Config.GrpcPort)))
+		app.Add(lifecycle.HTTPServer(&http.Server{
+			Addr:    fmt.Sprintf(":%d", config.Config.Port),
+			Handler: router,
+		}))
+
+		err = app.Run()
+		if err != nil {
+			logrus.Fatalf("error in shutdown %s", err)
+		}
+		logrus.Info("server stopped")
 	},
 }
 
+// grpcServer membuat server gRPC untuk API internal. Kredensial antar service
+// divalidasi oleh interceptor dengan skema yang sama seperti header HTTP, setelah
+// span panggilan dibuat.
+func grpcServer(service services.IServiceRegistry) *grpc.Server {
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		tracing.UnaryServerInterceptor(),
+		middlewares.GrpcAuthenticate(),
+	))
+	pb.RegisterUserServiceServer(server, grpcController.NewUserServer(service))
+	return server
+}
+
+// otpConfig membaca konfigurasi OTP dan mengisi nilai default untuk field yang kosong.
+func otpConfig() otp.Config {
+	cfg := otp.Config{
+		CodeTTL:        time.Duration(config.Config.OTP.CodeTTLSecond) * time.Second,
+		MaxAttempts:    config.Config.OTP.MaxAttempts,
+		ResendCooldown: time.Duration(config.Config.OTP.ResendCooldownSecond) * time.Second,
+		Channel:        messaging.Channel(config.Config.OTP.Channel),
+	}
+	if cfg.CodeTTL <= 0 {
+		cfg.CodeTTL = defaultOTPCodeTTL
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = defaultOTPMaxAttempts
+	}
+	if cfg.ResendCooldown <= 0 {
+		cfg.ResendCooldown = defaultOTPResendCooldown
+	}
+	if cfg.Channel == "" {
+		cfg.Channel = messaging.SMS
+	}
+	return cfg
+}
+
 // Run menjalankan command "serve" untuk memulai server
 func Run() {
 	err := command.Execute()
 	if err != nil {
 		panic(err)
 	}
-	log.Println("Server running on port 8001")
 }
 
 /*
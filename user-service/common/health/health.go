@@ -0,0 +1,132 @@
+package health
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/anddriii/kita-futsal/user-service/common/response"
+	"github.com/anddriii/kita-futsal/user-service/constants"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	StatusUp       = "up"
+	StatusDegraded = "degraded"
+	StatusDown     = "down"
+)
+
+// Check adalah satu dependency yang diperiksa oleh endpoint readiness.
+type Check struct {
+	Name string
+	// Optional menandai dependency yang hanya dilaporkan tanpa membuat service tidak ready,
+	// agar gangguan di service lain tidak ikut mengeluarkan service ini dari load balancer.
+	Optional bool
+	Probe    func(ctx context.Context) error
+}
+
+// Dependency berisi status dan latency hasil pemeriksaan satu dependency.
+type Dependency struct {
+	Status   string `json:"status"`
+	Latency  string `json:"latency"`
+	Optional bool   `json:"optional,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// Report berisi status keseluruhan beserta status setiap dependency.
+type Report struct {
+	Status       string                `json:"status"`
+	Dependencies map[string]Dependency `json:"dependencies"`
+}
+
+type Checker struct {
+	timeout time.Duration
+	checks  []Check
+}
+
+// NewChecker membatasi setiap pemeriksaan dengan timeout agar satu dependency yang
+// menggantung tidak melewati batas waktu probe dari orchestrator.
+func NewChecker(timeout time.Duration, checks ...Check) *Checker {
+	return &Checker{timeout: timeout, checks: checks}
+}
+
+func (c *Checker) Add(check Check) {
+	c.checks = append(c.checks, check)
+}
+
+// Run memeriksa semua dependency secara bersamaan. Status down jika ada dependency
+// wajib yang gagal, dan degraded jika yang gagal hanya dependency optional.
+func (c *Checker) Run(ctx context.Context) Report {
+	dependencies := make([]Dependency, len(c.checks))
+	var wg sync.WaitGroup
+	for i, check := range c.checks {
+		wg.Add(1)
+		go func(i int, check Check) {
+			defer wg.Done()
+			dependencies[i] = c.probe(ctx, check)
+		}(i, check)
+	}
+	wg.Wait()
+
+	report := Report{Status: StatusUp, Dependencies: make(map[string]Dependency, len(c.checks))}
+	for i, check := range c.checks {
+		dependency := dependencies[i]
+		report.Dependencies[check.Name] = dependency
+		if dependency.Status == StatusUp {
+			continue
+		}
+		if !check.Optional {
+			report.Status = StatusDown
+		} else if report.Status == StatusUp {
+			report.Status = StatusDegraded
+		}
+	}
+	return report
+}
+
+func (c *Checker) probe(ctx context.Context, check Check) Dependency {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := check.Probe(ctx)
+	dependency := Dependency{
+		Status:   StatusUp,
+		Latency:  time.Since(start).String(),
+		Optional: check.Optional,
+	}
+	if err != nil {
+		dependency.Status = StatusDown
+		dependency.Error = err.Error()
+	}
+	return dependency
+}
+
+// Liveness hanya menandakan proses masih melayani HTTP. Dependency sengaja tidak
+// diperiksa agar gangguan database tidak membuat semua pod di-restart.
+func Liveness(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, response.Response{
+		Status:  constants.Succes,
+		Message: http.StatusText(http.StatusOK),
+	})
+}
+
+// Readiness mengembalikan 503 selama ada dependency wajib yang down sehingga pod
+// dikeluarkan dari load balancer sampai pulih.
+func (c *Checker) Readiness(ctx *gin.Context) {
+	report := c.Run(ctx.Request.Context())
+
+	code := http.StatusOK
+	status := constants.Succes
+	if report.Status == StatusDown {
+		code = http.StatusServiceUnavailable
+		status = constants.Error
+	}
+
+	ctx.JSON(code, response.Response{
+		Status:  status,
+		Message: http.StatusText(code),
+		Data:    report,
+	})
+}
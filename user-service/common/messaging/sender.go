@@ -0,0 +1,41 @@
+package messaging
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/anddriii/kita-futsal/user-service/common/logger"
+)
+
+// Channel adalah media pengiriman pesan ke nomor telepon.
+type Channel string
+
+const (
+	SMS      Channel = "sms"
+	WhatsApp Channel = "whatsapp"
+)
+
+// Sender mengirim pesan teks ke nomor telepon berformat E.164. Gateway SMS atau
+// WhatsApp cukup mengimplementasikan interface ini lalu didaftarkan di NewSender.
+type Sender interface {
+	Send(ctx context.Context, channel Channel, to, message string) error
+}
+
+// NewSender memilih implementasi Sender berdasarkan driver di konfigurasi.
+func NewSender(driver string) (Sender, error) {
+	switch driver {
+	case "", "log":
+		return logSender{}, nil
+	default:
+		return nil, fmt.Errorf("unknown message sender driver %q", driver)
+	}
+}
+
+// logSender hanya menulis pesan ke log dan dipakai untuk development. Isi pesan
+// (termasuk kode OTP) ikut tertulis, jadi jangan dipakai di production.
+type logSender struct{}
+
+func (logSender) Send(ctx context.Context, channel Channel, to, message string) error {
+	logger.FromContext(ctx).Infof("mengirim pesan %s ke %s: %s", channel, to, message)
+	return nil
+}
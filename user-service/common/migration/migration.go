@@ -0,0 +1,228 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// lockID adalah key advisory lock Postgres yang dipegang selama satu migrasi berjalan,
+// sehingga beberapa replica yang start bersamaan tidak menjalankan migrasi yang sama.
+const lockID = 7_341_226_001
+
+// filePattern mencocokkan nama file seperti 0002_rename_field_longitude.up.sql.
+var filePattern = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+// Migration adalah satu perubahan skema beserta SQL untuk membatalkannya.
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Status adalah migrasi beserta waktu diterapkannya. AppliedAt bernilai nil jika
+// migrasi belum dijalankan.
+type Status struct {
+	Migration
+	AppliedAt *time.Time
+}
+
+type schemaMigration struct {
+	Version   int64  `gorm:"primaryKey"`
+	Name      string `gorm:"type:varchar(255);not null"`
+	AppliedAt time.Time
+}
+
+func (schemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+// Migrator menjalankan file SQL bernomor versi secara berurutan dan mencatat versi
+// yang sudah diterapkan di tabel schema_migrations.
+type Migrator struct {
+	db         *gorm.DB
+	migrations []Migration
+}
+
+// New membaca semua file migrasi di fsys. Setiap versi wajib punya file up dan down.
+func New(db *gorm.DB, fsys fs.FS) (*Migrator, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[int64]*Migration{}
+	for _, entry := range entries {
+		match := filePattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		content, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		}
+		if migration.Name != match[2] {
+			return nil, fmt.Errorf("migration %d has different names: %s and %s", version, migration.Name, match[2])
+		}
+		if match[3] == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("migration %d_%s must have both up and down files", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+// Up menjalankan semua migrasi yang belum diterapkan dan mengembalikan migrasi yang
+// baru dijalankan. Setiap migrasi berjalan di transaksinya sendiri, jadi migrasi yang
+// gagal tidak meninggalkan perubahan setengah jalan.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	err := m.ensureTable(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var applied []Migration
+	for _, migration := range m.migrations {
+		ran, err := m.apply(ctx, migration, true)
+		if err != nil {
+			return applied, fmt.Errorf("migration %d_%s up: %w", migration.Version, migration.Name, err)
+		}
+		if ran {
+			applied = append(applied, migration)
+		}
+	}
+	return applied, nil
+}
+
+// Down membatalkan sejumlah steps migrasi terakhir yang sudah diterapkan, dimulai dari
+// versi terbesar.
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var reverted []Migration
+	for i := len(statuses) - 1; i >= 0 && len(reverted) < steps; i-- {
+		if statuses[i].AppliedAt == nil {
+			continue
+		}
+
+		migration := statuses[i].Migration
+		ran, err := m.apply(ctx, migration, false)
+		if err != nil {
+			return reverted, fmt.Errorf("migration %d_%s down: %w", migration.Version, migration.Name, err)
+		}
+		if ran {
+			reverted = append(reverted, migration)
+		}
+	}
+	return reverted, nil
+}
+
+// Status mengembalikan semua migrasi yang dikenal beserta waktu diterapkannya.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	err := m.ensureTable(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []schemaMigration
+	err = m.db.WithContext(ctx).Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	appliedAt := make(map[int64]time.Time, len(rows))
+	for _, row := range rows {
+		appliedAt[row.Version] = row.AppliedAt
+	}
+
+	statuses := make([]Status, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		status := Status{Migration: migration}
+		if at, ok := appliedAt[migration.Version]; ok {
+			status.AppliedAt = &at
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+func (m *Migrator) ensureTable(ctx context.Context) error {
+	return m.db.WithContext(ctx).Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+		version bigint PRIMARY KEY,
+		name varchar(255) NOT NULL,
+		applied_at timestamptz NOT NULL DEFAULT now()
+	)`).Error
+}
+
+// apply menjalankan SQL up atau down dalam satu transaksi. Status versi diperiksa ulang
+// setelah lock didapat, karena replica lain mungkin sudah menjalankannya lebih dulu.
+func (m *Migrator) apply(ctx context.Context, migration Migration, up bool) (bool, error) {
+	ran := false
+	err := m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec("SELECT pg_advisory_xact_lock(?)", lockID).Error
+		if err != nil {
+			return err
+		}
+
+		var count int64
+		err = tx.Model(&schemaMigration{}).Where("version = ?", migration.Version).Count(&count).Error
+		if err != nil {
+			return err
+		}
+		if (count > 0) == up {
+			return nil
+		}
+
+		if up {
+			err = tx.Exec(migration.Up).Error
+			if err == nil {
+				err = tx.Create(&schemaMigration{Version: migration.Version, Name: migration.Name, AppliedAt: time.Now()}).Error
+			}
+		} else {
+			err = tx.Exec(migration.Down).Error
+			if err == nil {
+				err = tx.Where("version = ?", migration.Version).Delete(&schemaMigration{}).Error
+			}
+		}
+		if err != nil {
+			return err
+		}
+
+		ran = true
+		return nil
+	})
+	return ran, err
+}
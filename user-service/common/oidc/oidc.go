@@ -0,0 +1,139 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"net/http"
+	"time"
+
+	"github.com/anddriii/kita-futsal/user-service/common/logger"
+	errConst "github.com/anddriii/kita-futsal/user-service/constants/error"
+	"golang.org/x/oauth2"
+)
+
+// sessionTTL adalah batas waktu user menyelesaikan login di halaman provider.
+const sessionTTL = 10 * time.Minute
+
+// Client menjalankan flow authorization code dengan PKCE untuk semua provider yang
+// dikonfigurasi.
+type Client struct {
+	providers map[string]*Provider
+	sessions  SessionStore
+}
+
+// NewClient membuat Client dari konfigurasi provider, dengan nama provider (misalnya
+// "google") sebagai key. HTTP client dipakai untuk semua request ke provider.
+func NewClient(configs map[string]Config, sessions SessionStore, httpClient *http.Client) *Client {
+	providers := make(map[string]*Provider, len(configs))
+	for name, config := range configs {
+		providers[name] = newProvider(name, config, httpClient)
+	}
+	return &Client{providers: providers, sessions: sessions}
+}
+
+// Authorization adalah hasil Authorize. Binding adalah rahasia milik client yang
+// memulai login; client menyimpannya di sesinya sendiri dan mengirimkannya lagi saat
+// callback. State saja tidak cukup karena ikut terkirim di URL redirect provider,
+// sehingga penyerang bisa memancing browser korban menyelesaikan login miliknya.
+type Authorization struct {
+	URL     string
+	State   string
+	Binding string
+}
+
+// Authorize memulai login: membuat state, nonce, code verifier, dan binding, menyimpannya
+// sebagai sesi, lalu mengembalikan URL halaman login provider beserta state dan
+// binding-nya. linkUser diisi UUID user yang sedang login saat menghubungkan provider ke
+// akunnya, dan dikosongkan untuk login biasa.
+func (c *Client) Authorize(ctx context.Context, providerName, linkUser string) (*Authorization, error) {
+	provider, ok := c.providers[providerName]
+	if !ok {
+		return nil, errConst.ErrOIDCProviderNotFound
+	}
+
+	state, err := randomString()
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := randomString()
+	if err != nil {
+		return nil, err
+	}
+	binding, err := randomString()
+	if err != nil {
+		return nil, err
+	}
+	verifier := oauth2.GenerateVerifier()
+
+	url, err := provider.authCodeURL(ctx, state, nonce, verifier)
+	if err != nil {
+		logger.FromContext(ctx).Errorf("gagal mengambil konfigurasi provider %s: %v", providerName, err)
+		return nil, errConst.ErrOIDCLoginFailed
+	}
+
+	session := Session{
+		Provider:    providerName,
+		Verifier:    verifier,
+		Nonce:       nonce,
+		LinkUser:    linkUser,
+		BindingHash: hashBinding(binding),
+	}
+	err = c.sessions.Save(ctx, state, session, sessionTTL)
+	if err != nil {
+		logger.FromContext(ctx).Errorf("gagal menyimpan sesi login %s: %v", providerName, err)
+		return nil, errConst.ErrOIDCLoginFailed
+	}
+
+	return &Authorization{URL: url, State: state, Binding: binding}, nil
+}
+
+// Callback menyelesaikan login dengan authorization code dari provider dan
+// mengembalikan identitas user yang sudah diverifikasi. binding harus sama dengan yang
+// dikembalikan Authorize kepada client yang memulai login, dan linkUser harus sama
+// dengan nilai saat Authorize, sehingga state login biasa tidak bisa dipakai untuk
+// menghubungkan provider dan sebaliknya.
+func (c *Client) Callback(ctx context.Context, providerName, code, state, binding, linkUser string) (*Identity, error) {
+	provider, ok := c.providers[providerName]
+	if !ok {
+		return nil, errConst.ErrOIDCProviderNotFound
+	}
+
+	session, err := c.sessions.Take(ctx, state)
+	if err != nil {
+		logger.FromContext(ctx).Errorf("gagal mengambil sesi login %s: %v", providerName, err)
+		return nil, errConst.ErrOIDCLoginFailed
+	}
+	if session == nil || session.Provider != providerName || session.LinkUser != linkUser {
+		return nil, errConst.ErrOIDCInvalidState
+	}
+	if subtle.ConstantTimeCompare([]byte(session.BindingHash), []byte(hashBinding(binding))) != 1 {
+		return nil, errConst.ErrOIDCInvalidState
+	}
+
+	identity, err := provider.exchange(ctx, code, session.Verifier, session.Nonce)
+	if err != nil {
+		logger.FromContext(ctx).Warnf("login dengan provider %s ditolak: %v", providerName, err)
+		return nil, errConst.ErrOIDCLoginFailed
+	}
+
+	return identity, nil
+}
+
+// hashBinding menyimpan binding hanya sebagai hash, sehingga isi Redis tidak cukup untuk
+// menyelesaikan login.
+func hashBinding(binding string) string {
+	sum := sha256.Sum256([]byte(binding))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func randomString() (string, error) {
+	buffer := make([]byte, 32)
+	_, err := rand.Read(buffer)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buffer), nil
+}
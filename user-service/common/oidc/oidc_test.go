@@ -0,0 +1,181 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/anddriii/kita-futsal/user-service/common/oidc/oidctest"
+	errConst "github.com/anddriii/kita-futsal/user-service/constants/error"
+	"github.com/redis/go-redis/v9"
+)
+
+func newTestClient(t *testing.T) (*Client, *oidctest.Server) {
+	t.Helper()
+
+	issuer := oidctest.NewServer("user-service")
+	t.Cleanup(issuer.Close)
+
+	rdb := redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+
+	config := Config{
+		IssuerURL:   issuer.URL,
+		ClientID:    issuer.ClientID,
+		RedirectURL: "http://localhost/callback",
+	}
+	client := NewClient(map[string]Config{"google": config, "microsoft": config}, NewRedisSessionStore(rdb), http.DefaultClient)
+	return client, issuer
+}
+
+// authorize memulai login dan mengembalikan hasil Authorize beserta nonce dan code
+// challenge dari URL halaman login provider.
+func authorize(t *testing.T, client *Client, provider string) (*Authorization, string, string) {
+	t.Helper()
+
+	authorization, err := client.Authorize(context.Background(), provider, "")
+	if err != nil {
+		t.Fatalf("Authorize: %v", err)
+	}
+	parsed, err := url.Parse(authorization.URL)
+	if err != nil {
+		t.Fatalf("parse authorize url: %v", err)
+	}
+	query := parsed.Query()
+	if query.Get("state") != authorization.State {
+		t.Fatalf("state in url = %q, want %q", query.Get("state"), authorization.State)
+	}
+	if authorization.Binding == "" || strings.Contains(authorization.URL, authorization.Binding) {
+		t.Fatalf("binding %q is empty or leaked into the authorize url", authorization.Binding)
+	}
+	if query.Get("code_challenge_method") != "S256" {
+		t.Fatalf("code_challenge_method = %q, want S256", query.Get("code_challenge_method"))
+	}
+	return authorization, query.Get("nonce"), query.Get("code_challenge")
+}
+
+func TestCallbackSendsPKCEVerifier(t *testing.T) {
+	client, issuer := newTestClient(t)
+	auth, nonce, challenge := authorize(t, client, "google")
+	issuer.Issue("code", challenge, oidctest.Claims{
+		Subject:       "subject-1",
+		Email:         " Budi@Example.com ",
+		EmailVerified: "true",
+		Name:          "Budi",
+		Nonce:         nonce,
+	})
+
+	identity, err := client.Callback(context.Background(), "google", "code", auth.State, auth.Binding, "")
+	if err != nil {
+		t.Fatalf("Callback: %v", err)
+	}
+
+	if verifiers := issuer.Verifiers(); len(verifiers) != 1 || verifiers[0] == "" {
+		t.Fatalf("token endpoint received verifiers %q, want one", verifiers)
+	}
+	want := Identity{Provider: "google", Subject: "subject-1", Email: "budi@example.com", EmailVerified: true, Name: "Budi"}
+	if *identity != want {
+		t.Fatalf("identity = %+v, want %+v", *identity, want)
+	}
+}
+
+func TestCallbackRejectsNonceMismatch(t *testing.T) {
+	client, issuer := newTestClient(t)
+	auth, _, challenge := authorize(t, client, "google")
+	issuer.Issue("code", challenge, oidctest.Claims{Subject: "subject-1", Nonce: "nonce-lain"})
+
+	_, err := client.Callback(context.Background(), "google", "code", auth.State, auth.Binding, "")
+	if !errors.Is(err, errConst.ErrOIDCLoginFailed) {
+		t.Fatalf("Callback error = %v, want %v", err, errConst.ErrOIDCLoginFailed)
+	}
+}
+
+func TestCallbackRejectsUnknownState(t *testing.T) {
+	client, issuer := newTestClient(t)
+	auth, nonce, challenge := authorize(t, client, "google")
+	issuer.Issue("code", challenge, oidctest.Claims{Subject: "subject-1", Nonce: nonce})
+
+	_, err := client.Callback(context.Background(), "google", "code", "state-tidak-dikenal", auth.Binding, "")
+	if !errors.Is(err, errConst.ErrOIDCInvalidState) {
+		t.Fatalf("Callback error = %v, want %v", err, errConst.ErrOIDCInvalidState)
+	}
+	if verifiers := issuer.Verifiers(); len(verifiers) != 0 {
+		t.Fatalf("token endpoint was called with unknown state")
+	}
+}
+
+func TestCallbackRejectsReusedState(t *testing.T) {
+	client, issuer := newTestClient(t)
+	auth, nonce, challenge := authorize(t, client, "google")
+	issuer.Issue("code", challenge, oidctest.Claims{Subject: "subject-1", Nonce: nonce})
+
+	_, err := client.Callback(context.Background(), "google", "code", auth.State, auth.Binding, "")
+	if err != nil {
+		t.Fatalf("first Callback: %v", err)
+	}
+
+	issuer.Issue("code-2", challenge, oidctest.Claims{Subject: "subject-1", Nonce: nonce})
+	_, err = client.Callback(context.Background(), "google", "code-2", auth.State, auth.Binding, "")
+	if !errors.Is(err, errConst.ErrOIDCInvalidState) {
+		t.Fatalf("second Callback error = %v, want %v", err, errConst.ErrOIDCInvalidState)
+	}
+}
+
+func TestCallbackRejectsStateOfOtherProvider(t *testing.T) {
+	client, issuer := newTestClient(t)
+	auth, nonce, challenge := authorize(t, client, "google")
+	issuer.Issue("code", challenge, oidctest.Claims{Subject: "subject-1", Nonce: nonce})
+
+	_, err := client.Callback(context.Background(), "microsoft", "code", auth.State, auth.Binding, "")
+	if !errors.Is(err, errConst.ErrOIDCInvalidState) {
+		t.Fatalf("Callback error = %v, want %v", err, errConst.ErrOIDCInvalidState)
+	}
+}
+
+func TestAuthorizeUnknownProvider(t *testing.T) {
+	client, _ := newTestClient(t)
+
+	_, err := client.Authorize(context.Background(), "github", "")
+	if !errors.Is(err, errConst.ErrOIDCProviderNotFound) {
+		t.Fatalf("Authorize error = %v, want %v", err, errConst.ErrOIDCProviderNotFound)
+	}
+}
+
+func TestCallbackRejectsLoginStateForLink(t *testing.T) {
+	client, issuer := newTestClient(t)
+	auth, nonce, challenge := authorize(t, client, "google")
+	issuer.Issue("code", challenge, oidctest.Claims{Subject: "subject-1", Nonce: nonce})
+
+	_, err := client.Callback(context.Background(), "google", "code", auth.State, auth.Binding, "user-uuid")
+	if !errors.Is(err, errConst.ErrOIDCInvalidState) {
+		t.Fatalf("Callback error = %v, want %v", err, errConst.ErrOIDCInvalidState)
+	}
+}
+
+func TestCallbackRejectsOtherBinding(t *testing.T) {
+	client, issuer := newTestClient(t)
+
+	// browser korban hanya punya binding dari login miliknya sendiri (atau tidak punya
+	// sama sekali), bukan binding dari login yang dimulai penyerang
+	for _, name := range []string{"binding lain", "tanpa binding"} {
+		attacker, nonce, challenge := authorize(t, client, "google")
+		victim, _, _ := authorize(t, client, "google")
+		issuer.Issue("code", challenge, oidctest.Claims{Subject: "subject-1", Nonce: nonce})
+
+		binding := victim.Binding
+		if name == "tanpa binding" {
+			binding = ""
+		}
+		_, err := client.Callback(context.Background(), "google", "code", attacker.State, binding, "")
+		if !errors.Is(err, errConst.ErrOIDCInvalidState) {
+			t.Fatalf("%s: Callback error = %v, want %v", name, err, errConst.ErrOIDCInvalidState)
+		}
+	}
+	if verifiers := issuer.Verifiers(); len(verifiers) != 0 {
+		t.Fatalf("token endpoint was called without the initiating client's binding")
+	}
+}
@@ -0,0 +1,163 @@
+// Package oidctest menyediakan issuer OpenID Connect palsu untuk pengujian: dokumen
+// discovery, JWKS, dan token endpoint yang menandatangani ID token dengan RS256.
+package oidctest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const keyID = "oidctest"
+
+// Claims adalah isi ID token yang dikembalikan untuk satu authorization code.
+// Nonce harus diambil dari URL Authorize agar lolos verifikasi.
+type Claims struct {
+	Subject       string
+	Email         string
+	EmailVerified any
+	Name          string
+	Nonce         string
+}
+
+// Server adalah issuer palsu yang berjalan di httptest.Server.
+type Server struct {
+	*httptest.Server
+	ClientID string
+
+	key *rsa.PrivateKey
+
+	mu         sync.Mutex
+	codes      map[string]Claims
+	challenges map[string]string
+	verifiers  []string
+}
+
+// NewServer menjalankan issuer palsu untuk clientID. Panggil Close setelah selesai.
+func NewServer(clientID string) *Server {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+
+	s := &Server{
+		ClientID:   clientID,
+		key:        key,
+		codes:      make(map[string]Claims),
+		challenges: make(map[string]string),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", s.discovery)
+	mux.HandleFunc("/jwks", s.jwks)
+	mux.HandleFunc("/token", s.token)
+	s.Server = httptest.NewServer(mux)
+	return s
+}
+
+// Issue mendaftarkan authorization code yang akan ditukar dengan ID token berisi
+// claims. challenge adalah code_challenge dari URL Authorize; token endpoint hanya
+// menerima code_verifier yang cocok dengannya.
+func (s *Server) Issue(code, challenge string, claims Claims) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.codes[code] = claims
+	s.challenges[code] = challenge
+}
+
+// Verifiers mengembalikan semua code_verifier yang diterima token endpoint.
+func (s *Server) Verifiers() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.verifiers...)
+}
+
+func (s *Server) discovery(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"issuer":                                s.URL,
+		"authorization_endpoint":                s.URL + "/authorize",
+		"token_endpoint":                        s.URL + "/token",
+		"jwks_uri":                              s.URL + "/jwks",
+		"response_types_supported":              []string{"code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+		"code_challenge_methods_supported":      []string{"S256"},
+	})
+}
+
+func (s *Server) jwks(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": keyID,
+			"use": "sig",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(s.key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(s.key.E)).Bytes()),
+		}},
+	})
+}
+
+func (s *Server) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+	code := r.PostForm.Get("code")
+	verifier := r.PostForm.Get("code_verifier")
+
+	s.mu.Lock()
+	claims, ok := s.codes[code]
+	challenge := s.challenges[code]
+	delete(s.codes, code)
+	delete(s.challenges, code)
+	s.verifiers = append(s.verifiers, verifier)
+	s.mu.Unlock()
+
+	sum := sha256.Sum256([]byte(verifier))
+	if !ok || base64.RawURLEncoding.EncodeToString(sum[:]) != challenge {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	now := time.Now()
+	idToken := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":            s.URL,
+		"aud":            s.ClientID,
+		"sub":            claims.Subject,
+		"email":          claims.Email,
+		"email_verified": claims.EmailVerified,
+		"name":           claims.Name,
+		"nonce":          claims.Nonce,
+		"iat":            now.Unix(),
+		"exp":            now.Add(time.Hour).Unix(),
+	})
+	idToken.Header["kid"] = keyID
+	signed, err := idToken.SignedString(s.key)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "server_error"})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token": "oidctest-access-token",
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+		"id_token":     signed,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,160 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	gooidc "github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+)
+
+// discoveryTimeout membatasi waktu mengambil dokumen discovery dari provider.
+const discoveryTimeout = 10 * time.Second
+
+// defaultScopes dipakai jika scope provider tidak diatur di konfigurasi.
+var defaultScopes = []string{gooidc.ScopeOpenID, "email", "profile"}
+
+// Config adalah konfigurasi satu provider OpenID Connect. IssuerURL boleh berupa URL
+// http lokal, sehingga flow login bisa diuji dengan mock OIDC server.
+type Config struct {
+	IssuerURL    string   `json:"issuerURL"`
+	ClientID     string   `json:"clientID"`
+	ClientSecret string   `json:"clientSecret"`
+	RedirectURL  string   `json:"redirectURL"`
+	Scopes       []string `json:"scopes"`
+}
+
+// Identity adalah identitas user yang sudah diverifikasi dari ID token provider.
+type Identity struct {
+	Provider      string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+// Provider membungkus satu provider OIDC. Dokumen discovery baru diambil saat
+// pertama kali dipakai, jadi provider yang sedang down tidak menggagalkan startup
+// dan akan dicoba lagi pada request berikutnya.
+type Provider struct {
+	name   string
+	config Config
+	client *http.Client
+
+	mu       sync.Mutex
+	provider *gooidc.Provider
+}
+
+func newProvider(name string, config Config, client *http.Client) *Provider {
+	if len(config.Scopes) == 0 {
+		config.Scopes = defaultScopes
+	}
+	return &Provider{name: name, config: config, client: client}
+}
+
+func (p *Provider) discover(ctx context.Context) (*gooidc.Provider, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.provider != nil {
+		return p.provider, nil
+	}
+
+	ctx, cancel := context.WithTimeout(gooidc.ClientContext(ctx, p.client), discoveryTimeout)
+	defer cancel()
+
+	provider, err := gooidc.NewProvider(ctx, p.config.IssuerURL)
+	if err != nil {
+		return nil, err
+	}
+	p.provider = provider
+	return provider, nil
+}
+
+func (p *Provider) oauth2Config(provider *gooidc.Provider) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     p.config.ClientID,
+		ClientSecret: p.config.ClientSecret,
+		RedirectURL:  p.config.RedirectURL,
+		Endpoint:     provider.Endpoint(),
+		Scopes:       p.config.Scopes,
+	}
+}
+
+// authCodeURL membuat URL halaman login provider dengan code challenge PKCE (S256).
+func (p *Provider) authCodeURL(ctx context.Context, state, nonce, verifier string) (string, error) {
+	provider, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return p.oauth2Config(provider).AuthCodeURL(state,
+		gooidc.Nonce(nonce),
+		oauth2.S256ChallengeOption(verifier),
+	), nil
+}
+
+// exchange menukar authorization code dengan token lalu memverifikasi ID token:
+// signature, issuer, audience, masa berlaku, dan nonce dari sesi login.
+func (p *Provider) exchange(ctx context.Context, code, verifier, nonce string) (*Identity, error) {
+	provider, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := p.oauth2Config(provider).Exchange(
+		context.WithValue(ctx, oauth2.HTTPClient, p.client),
+		code,
+		oauth2.VerifierOption(verifier),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		return nil, errors.New("token response does not contain id_token")
+	}
+
+	idToken, err := provider.Verifier(&gooidc.Config{ClientID: p.config.ClientID}).Verify(ctx, rawIDToken)
+	if err != nil {
+		return nil, err
+	}
+	if idToken.Nonce != nonce {
+		return nil, errors.New("id token nonce does not match")
+	}
+
+	var claims struct {
+		Email         string `json:"email"`
+		EmailVerified any    `json:"email_verified"`
+		Name          string `json:"name"`
+	}
+	err = idToken.Claims(&claims)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Identity{
+		Provider:      p.name,
+		Subject:       idToken.Subject,
+		Email:         strings.ToLower(strings.TrimSpace(claims.Email)),
+		EmailVerified: isTrue(claims.EmailVerified),
+		Name:          claims.Name,
+	}, nil
+}
+
+// isTrue membaca claim boolean. Sebagian provider mengirim email_verified sebagai
+// string "true" alih-alih boolean.
+func isTrue(value any) bool {
+	switch v := value.(type) {
+	case bool:
+		return v
+	case string:
+		return v == "true"
+	default:
+		return false
+	}
+}
@@ -0,0 +1,65 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Session menyimpan data yang dibuat saat login dimulai dan dibutuhkan lagi saat
+// callback: code verifier PKCE, nonce ID token, dan hash binding client yang memulai
+// login. LinkUser berisi UUID user yang menghubungkan provider dari sesi yang sudah
+// login, dan kosong untuk login biasa.
+type Session struct {
+	Provider    string `json:"provider"`
+	Verifier    string `json:"verifier"`
+	Nonce       string `json:"nonce"`
+	LinkUser    string `json:"linkUser,omitempty"`
+	BindingHash string `json:"bindingHash"`
+}
+
+// SessionStore menyimpan sesi login berdasarkan parameter state.
+type SessionStore interface {
+	Save(ctx context.Context, state string, session Session, ttl time.Duration) error
+	// Take mengambil lalu menghapus sesi, sehingga satu state hanya bisa dipakai sekali.
+	// Mengembalikan nil jika state tidak dikenal atau sudah kedaluwarsa.
+	Take(ctx context.Context, state string) (*Session, error)
+}
+
+type redisSessionStore struct {
+	client *redis.Client
+}
+
+// NewRedisSessionStore menyimpan sesi login di Redis agar callback bisa diterima
+// oleh replika mana pun.
+func NewRedisSessionStore(client *redis.Client) SessionStore {
+	return &redisSessionStore{client: client}
+}
+
+func (s *redisSessionStore) Save(ctx context.Context, state string, session Session, ttl time.Duration) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+	return s.client.Set(ctx, "oidc:state:"+state, data, ttl).Err()
+}
+
+func (s *redisSessionStore) Take(ctx context.Context, state string) (*Session, error) {
+	data, err := s.client.GetDel(ctx, "oidc:state:"+state).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var session Session
+	err = json.Unmarshal(data, &session)
+	if err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
@@ -0,0 +1,148 @@
+package otp
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/anddriii/kita-futsal/user-service/common/messaging"
+	errConst "github.com/anddriii/kita-futsal/user-service/constants/error"
+	"github.com/redis/go-redis/v9"
+)
+
+// Purpose membedakan OTP untuk kebutuhan berbeda, sehingga kode verifikasi nomor tidak
+// bisa dipakai untuk login dan sebaliknya.
+type Purpose string
+
+const (
+	VerifyPhone Purpose = "verify"
+	Login       Purpose = "login"
+)
+
+// codeDigits adalah panjang kode OTP.
+const codeDigits = 6
+
+// Config mengatur masa berlaku kode, batas percobaan, dan jeda kirim ulang.
+type Config struct {
+	CodeTTL        time.Duration
+	MaxAttempts    int
+	ResendCooldown time.Duration
+	Channel        messaging.Channel
+}
+
+// verifyScript memeriksa kode dan menambah jumlah percobaan secara atomik, sehingga
+// request paralel tidak bisa melewati batas percobaan. Kode dihapus setelah berhasil
+// atau setelah percobaan habis.
+//
+// Hasil: 1 kode benar, 0 kode salah, -1 kode tidak ada atau kedaluwarsa,
+// -2 percobaan habis.
+var verifyScript = redis.NewScript(`
+local hash = redis.call('HGET', KEYS[1], 'hash')
+if not hash then
+	return -1
+end
+local attempts = redis.call('HINCRBY', KEYS[1], 'attempts', 1)
+if hash == ARGV[1] then
+	redis.call('DEL', KEYS[1])
+	return 1
+end
+if attempts >= tonumber(ARGV[2]) then
+	redis.call('DEL', KEYS[1])
+	return -2
+end
+return 0
+`)
+
+// Manager membuat, mengirim, dan memverifikasi kode OTP. Kode disimpan di Redis
+// dalam bentuk HMAC, tidak pernah dalam bentuk asli.
+type Manager struct {
+	redis  *redis.Client
+	sender messaging.Sender
+	secret []byte
+	config Config
+}
+
+func NewManager(rdb *redis.Client, sender messaging.Sender, secret string, config Config) *Manager {
+	return &Manager{redis: rdb, sender: sender, secret: []byte(secret), config: config}
+}
+
+// Send membuat kode baru untuk nomor dan purpose tersebut lalu mengirimkannya. Kode
+// lama otomatis tidak berlaku. Permintaan ulang sebelum jeda kirim ulang habis ditolak
+// dengan ErrOTPTooManyRequests.
+func (m *Manager) Send(ctx context.Context, purpose Purpose, phoneNumber string) error {
+	allowed, err := m.redis.SetNX(ctx, m.key("cooldown", purpose, phoneNumber), 1, m.config.ResendCooldown).Result()
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return errConst.ErrOTPTooManyRequests
+	}
+
+	code, err := generateCode()
+	if err != nil {
+		return err
+	}
+
+	key := m.key("code", purpose, phoneNumber)
+	_, err = m.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, key)
+		pipe.HSet(ctx, key, "hash", m.hash(purpose, phoneNumber, code), "attempts", 0)
+		pipe.Expire(ctx, key, m.config.CodeTTL)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	message := fmt.Sprintf("Kode OTP Kita Futsal kamu: %s. Berlaku %d menit. Jangan berikan kode ini kepada siapa pun.",
+		code, int(m.config.CodeTTL.Minutes()))
+	return m.sender.Send(ctx, m.config.Channel, phoneNumber, message)
+}
+
+// Verify mencocokkan kode dengan kode terakhir yang dikirim ke nomor tersebut.
+func (m *Manager) Verify(ctx context.Context, purpose Purpose, phoneNumber, code string) error {
+	result, err := verifyScript.Run(ctx, m.redis,
+		[]string{m.key("code", purpose, phoneNumber)},
+		m.hash(purpose, phoneNumber, code), m.config.MaxAttempts,
+	).Int()
+	if err != nil {
+		return err
+	}
+
+	switch result {
+	case 1:
+		return nil
+	case -2:
+		return errConst.ErrOTPTooManyAttempts
+	default:
+		return errConst.ErrOTPInvalid
+	}
+}
+
+func (m *Manager) key(kind string, purpose Purpose, phoneNumber string) string {
+	return fmt.Sprintf("otp:%s:%s:%s", kind, purpose, phoneNumber)
+}
+
+func (m *Manager) hash(purpose Purpose, phoneNumber, code string) string {
+	mac := hmac.New(sha256.New, m.secret)
+	mac.Write([]byte(string(purpose) + ":" + phoneNumber + ":" + code))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func generateCode() (string, error) {
+	max := big.NewInt(1)
+	for i := 0; i < codeDigits; i++ {
+		max.Mul(max, big.NewInt(10))
+	}
+
+	n, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%0*d", codeDigits, n.Int64()), nil
+}
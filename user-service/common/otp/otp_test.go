@@ -0,0 +1,170 @@
+package otp
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/anddriii/kita-futsal/user-service/common/messaging"
+	errConst "github.com/anddriii/kita-futsal/user-service/constants/error"
+	"github.com/redis/go-redis/v9"
+)
+
+const testPhone = "+6281234567890"
+
+var codePattern = regexp.MustCompile(`\b[0-9]{6}\b`)
+
+// fakeSender menyimpan kode terakhir yang dikirim ke setiap nomor.
+type fakeSender struct {
+	mu    sync.Mutex
+	codes map[string]string
+}
+
+func (s *fakeSender) Send(_ context.Context, _ messaging.Channel, to, message string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.codes[to] = codePattern.FindString(message)
+	return nil
+}
+
+func (s *fakeSender) code(to string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.codes[to]
+}
+
+func newTestManager(t *testing.T) (*Manager, *fakeSender, *miniredis.Miniredis) {
+	t.Helper()
+
+	server := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+
+	sender := &fakeSender{codes: map[string]string{}}
+	manager := NewManager(rdb, sender, "test-secret", Config{
+		CodeTTL:        5 * time.Minute,
+		MaxAttempts:    3,
+		ResendCooldown: time.Minute,
+		Channel:        messaging.SMS,
+	})
+	return manager, sender, server
+}
+
+func send(t *testing.T, manager *Manager, sender *fakeSender, purpose Purpose) string {
+	t.Helper()
+
+	err := manager.Send(context.Background(), purpose, testPhone)
+	if err != nil {
+		t.Fatalf("Send(%s): %v", purpose, err)
+	}
+	code := sender.code(testPhone)
+	if code == "" {
+		t.Fatal("no code in sent message")
+	}
+	return code
+}
+
+func wrongCode(code string) string {
+	if code == "000000" {
+		return "111111"
+	}
+	return "000000"
+}
+
+func TestVerifyLocksOutAfterMaxAttempts(t *testing.T) {
+	manager, sender, _ := newTestManager(t)
+	ctx := context.Background()
+	code := send(t, manager, sender, Login)
+
+	for attempt := 1; attempt < manager.config.MaxAttempts; attempt++ {
+		err := manager.Verify(ctx, Login, testPhone, wrongCode(code))
+		if !errors.Is(err, errConst.ErrOTPInvalid) {
+			t.Fatalf("attempt %d error = %v, want %v", attempt, err, errConst.ErrOTPInvalid)
+		}
+	}
+
+	err := manager.Verify(ctx, Login, testPhone, wrongCode(code))
+	if !errors.Is(err, errConst.ErrOTPTooManyAttempts) {
+		t.Fatalf("last attempt error = %v, want %v", err, errConst.ErrOTPTooManyAttempts)
+	}
+
+	// kode yang benar pun tidak berlaku lagi setelah percobaan habis
+	err = manager.Verify(ctx, Login, testPhone, code)
+	if !errors.Is(err, errConst.ErrOTPInvalid) {
+		t.Fatalf("verify after lockout error = %v, want %v", err, errConst.ErrOTPInvalid)
+	}
+}
+
+func TestVerifyCodeIsSingleUse(t *testing.T) {
+	manager, sender, _ := newTestManager(t)
+	ctx := context.Background()
+	code := send(t, manager, sender, VerifyPhone)
+
+	err := manager.Verify(ctx, VerifyPhone, testPhone, code)
+	if err != nil {
+		t.Fatalf("first Verify: %v", err)
+	}
+
+	err = manager.Verify(ctx, VerifyPhone, testPhone, code)
+	if !errors.Is(err, errConst.ErrOTPInvalid) {
+		t.Fatalf("second Verify error = %v, want %v", err, errConst.ErrOTPInvalid)
+	}
+}
+
+func TestVerifyPurposesDoNotCross(t *testing.T) {
+	manager, sender, _ := newTestManager(t)
+	ctx := context.Background()
+	loginCode := send(t, manager, sender, Login)
+
+	err := manager.Verify(ctx, VerifyPhone, testPhone, loginCode)
+	if !errors.Is(err, errConst.ErrOTPInvalid) {
+		t.Fatalf("login code used for verify: error = %v, want %v", err, errConst.ErrOTPInvalid)
+	}
+
+	verifyCode := send(t, manager, sender, VerifyPhone)
+	if verifyCode != loginCode {
+		err = manager.Verify(ctx, Login, testPhone, verifyCode)
+		if !errors.Is(err, errConst.ErrOTPInvalid) {
+			t.Fatalf("verify code used for login: error = %v, want %v", err, errConst.ErrOTPInvalid)
+		}
+	}
+
+	err = manager.Verify(ctx, Login, testPhone, loginCode)
+	if err != nil {
+		t.Fatalf("Verify login code: %v", err)
+	}
+	err = manager.Verify(ctx, VerifyPhone, testPhone, verifyCode)
+	if err != nil {
+		t.Fatalf("Verify verify code: %v", err)
+	}
+}
+
+func TestSendRespectsCooldown(t *testing.T) {
+	manager, sender, server := newTestManager(t)
+	ctx := context.Background()
+	send(t, manager, sender, Login)
+
+	err := manager.Send(ctx, Login, testPhone)
+	if !errors.Is(err, errConst.ErrOTPTooManyRequests) {
+		t.Fatalf("resend error = %v, want %v", err, errConst.ErrOTPTooManyRequests)
+	}
+
+	server.FastForward(manager.config.ResendCooldown)
+	send(t, manager, sender, Login)
+}
+
+func TestVerifyExpiredCode(t *testing.T) {
+	manager, sender, server := newTestManager(t)
+	code := send(t, manager, sender, Login)
+
+	server.FastForward(manager.config.CodeTTL)
+
+	err := manager.Verify(context.Background(), Login, testPhone, code)
+	if !errors.Is(err, errConst.ErrOTPInvalid) {
+		t.Fatalf("Verify error = %v, want %v", err, errConst.ErrOTPInvalid)
+	}
+}
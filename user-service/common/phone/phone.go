@@ -0,0 +1,41 @@
+package phone
+
+import (
+	"regexp"
+	"strings"
+
+	errConst "github.com/anddriii/kita-futsal/user-service/constants/error"
+)
+
+// e164Pattern adalah format nomor E.164: tanda +, kode negara, lalu nomor pelanggan,
+// maksimal 15 digit.
+var e164Pattern = regexp.MustCompile(`^\+[1-9][0-9]{7,14}$`)
+
+// separators adalah karakter pemisah yang biasa diketik user dan dibuang saat normalisasi.
+var separators = strings.NewReplacer(" ", "", "-", "", ".", "", "(", "", ")", "")
+
+// Normalize mengubah nomor telepon ke format E.164. Nomor lokal yang diawali 0 memakai
+// defaultCountryCode (tanpa tanda +, misalnya "62"), jadi "0812-3456-789" menjadi
+// "+628123456789". Nomor yang tidak valid mengembalikan ErrInvalidPhoneNumber.
+func Normalize(raw, defaultCountryCode string) (string, error) {
+	number := separators.Replace(strings.TrimSpace(raw))
+
+	switch {
+	case strings.HasPrefix(number, "+"):
+	case strings.HasPrefix(number, "00"):
+		number = "+" + number[2:]
+	case strings.HasPrefix(number, "0"):
+		if defaultCountryCode == "" {
+			return "", errConst.ErrInvalidPhoneNumber
+		}
+		number = "+" + strings.TrimPrefix(defaultCountryCode, "+") + number[1:]
+	default:
+		// nomor tanpa awalan dianggap sudah diawali kode negara, misalnya 628123456789
+		number = "+" + number
+	}
+
+	if !e164Pattern.MatchString(number) {
+		return "", errConst.ErrInvalidPhoneNumber
+	}
+	return number, nil
+}
@@ -0,0 +1,42 @@
+package phone
+
+import (
+	"errors"
+	"testing"
+
+	errConst "github.com/anddriii/kita-futsal/user-service/constants/error"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name        string
+		raw         string
+		countryCode string
+		want        string
+		wantErr     error
+	}{
+		{name: "awalan 0 lokal", raw: "0812-3456-7890", countryCode: "62", want: "+6281234567890"},
+		{name: "awalan 0 dengan kode negara ber-plus", raw: "(0812) 3456.7890", countryCode: "+62", want: "+6281234567890"},
+		{name: "awalan 00", raw: "0062812345678", countryCode: "62", want: "+62812345678"},
+		{name: "awalan +", raw: " +62 812 3456 7890 ", countryCode: "62", want: "+6281234567890"},
+		{name: "tanpa awalan dianggap berkode negara", raw: "628123456789", countryCode: "62", want: "+628123456789"},
+		{name: "awalan 0 tanpa kode negara default", raw: "08123456789", wantErr: errConst.ErrInvalidPhoneNumber},
+		{name: "terlalu pendek", raw: "12", countryCode: "62", wantErr: errConst.ErrInvalidPhoneNumber},
+		{name: "terlalu panjang", raw: "+6281234567890123", countryCode: "62", wantErr: errConst.ErrInvalidPhoneNumber},
+		{name: "huruf", raw: "abc", countryCode: "62", wantErr: errConst.ErrInvalidPhoneNumber},
+		{name: "kode negara 0", raw: "+0123456789", countryCode: "62", wantErr: errConst.ErrInvalidPhoneNumber},
+		{name: "kosong", raw: "", countryCode: "62", wantErr: errConst.ErrInvalidPhoneNumber},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Normalize(tt.raw, tt.countryCode)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Normalize(%q) error = %v, want %v", tt.raw, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Fatalf("Normalize(%q) = %q, want %q", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package signature
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// NonceStore menyimpan nonce yang sudah pernah diterima.
+type NonceStore interface {
+	// Claim mencatat nonce selama ttl dan mengembalikan true jika nonce belum pernah dipakai.
+	Claim(ctx context.Context, nonce string, ttl time.Duration) (bool, error)
+}
+
+type redisNonceStore struct {
+	client *redis.Client
+}
+
+// NewRedisNonceStore menyimpan nonce di Redis agar semua replika service
+// melihat kumpulan nonce yang sama.
+func NewRedisNonceStore(client *redis.Client) NonceStore {
+	return &redisNonceStore{client: client}
+}
+
+func (s *redisNonceStore) Claim(ctx context.Context, nonce string, ttl time.Duration) (bool, error) {
+	return s.client.SetNX(ctx, "signature:nonce:"+nonce, 1, ttl).Result()
+}
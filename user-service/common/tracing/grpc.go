@@ -0,0 +1,53 @@
+package tracing
+
+import (
+	"context"
+
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor membuat span server untuk setiap panggilan gRPC dan
+// menyambungkannya ke trace yang dikirim pemanggil lewat metadata. Pasang sebagai
+// interceptor pertama supaya pemeriksaan kredensial ikut tercatat.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		ctx = Extract(ctx, metadataCarrier(md))
+		ctx, span := Tracer().Start(ctx, info.FullMethod,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(semconv.RPCSystemGRPC),
+		)
+
+		resp, err := handler(ctx, req)
+		span.SetAttributes(semconv.RPCGRPCStatusCodeKey.Int(int(status.Code(err))))
+		End(span, err)
+		return resp, err
+	}
+}
+
+// metadataCarrier membuat propagator bisa membaca dan menulis metadata gRPC.
+type metadataCarrier metadata.MD
+
+func (m metadataCarrier) Get(key string) string {
+	values := metadata.MD(m).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func (m metadataCarrier) Set(key, value string) {
+	metadata.MD(m).Set(key, value)
+}
+
+func (m metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
@@ -1,7 +1,7 @@
 package util
 
 import (
-	"fmt"
+	"math"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -13,6 +13,57 @@ import (
 	_ "github.com/spf13/viper/remote"
 )
 
+// PaginationParam merepresentasikan parameter untuk paginasi.
+type PaginationParam struct {
+	Count int64 `json:"count"` // Total jumlah data
+	Page  int   `json:"page"`  // Halaman saat ini
+	Limit int   `json:"limit"` // Jumlah item per halaman
+	Data  any   `json:"data"`  // Data yang akan dipaginasi
+}
+
+// PaginationResult merepresentasikan hasil dari proses paginasi.
+type PaginationResult struct {
+	TotalPage    int   `json:"totalPage"`    // Total jumlah halaman
+	TotalData    int64 `json:"totalData"`    // Total jumlah data
+	NextPage     *int  `json:"nextPage"`     // Halaman berikutnya (jika ada)
+	PreviousPage *int  `json:"previousPage"` // Halaman sebelumnya (jika ada)
+	Page         int   `json:"page"`         // Halaman saat ini
+	Limit        int   `json:"limit"`        // Jumlah item per halaman
+	Data         any   `json:"data"`         // Data yang dipaginasi
+}
+
+// GeneratePagination menghasilkan hasil paginasi berdasarkan parameter yang diberikan.
+func GeneratePagination(params PaginationParam) PaginationResult {
+	totalPage := int(math.Ceil(float64(params.Count) / float64(params.Limit)))
+
+	var (
+		nextPage     *int
+		previousPage *int
+	)
+
+	// Menentukan halaman berikutnya jika masih ada
+	if params.Page < totalPage {
+		n := params.Page + 1
+		nextPage = &n
+	}
+
+	// Menentukan halaman sebelumnya jika lebih dari 1
+	if params.Page > 1 {
+		p := params.Page - 1
+		previousPage = &p
+	}
+
+	return PaginationResult{
+		TotalPage:    totalPage,
+		TotalData:    params.Count,
+		NextPage:     nextPage,
+		PreviousPage: previousPage,
+		Page:         params.Page,
+		Limit:        params.Limit,
+		Data:         params.Data,
+	}
+}
+
 // digunakan untuk membaca file JSON dari server cloud atau dari Consul (penyimpanan konfigurasi berbasis key-value).
 
 // BindFromJson membaca konfigurasi dari file JSON dan mengikatnya ke struct tujuan.
@@ -32,9 +83,6 @@ func BindFromJson(dest any, filename, path string) error {
 		v.AddConfigPath(".") // Pakai direktori saat ini jika path kosong
 	}
 
-	// Log untuk debug
-	fmt.Printf("Loading config: %s.json from %s\n", configName, path)
-
 	// Baca file konfigurasi
 	if err := v.ReadInConfig(); err != nil {
 		logrus.Errorf("Failed to read config file: %v", err)
@@ -47,7 +95,6 @@ func BindFromJson(dest any, filename, path string) error {
 		return err
 	}
 
-	fmt.Printf("Config loaded successfully: %+v\n", dest)
 	return nil
 }
 
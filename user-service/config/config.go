@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 
+	"github.com/anddriii/kita-futsal/user-service/common/oidc"
 	"github.com/anddriii/kita-futsal/user-service/common/util"
 	"github.com/sirupsen/logrus"
 	_ "github.com/spf13/viper/remote"
@@ -11,15 +12,48 @@ import (
 var Config AppConfig
 
 type AppConfig struct {
-	Port                  int      `json:"port"`
-	AppName               string   `json:"appName"`
-	AppEnv                string   `json:"appEnv"`
-	SignatureKey          string   `json:"signatureKey"`
-	Database              database `json:"database"`
-	RateLimiterMaxRequest float64  `json:"rateLimiterMaxRequest"`
-	RateLimiterTimeSecond int      `json:"rateLimiterTimeSecond"`
-	JwtSecretKey          string   `json:"jwtSecretKey"`
-	JwtExpirationTime     int      `json:"jwtExpirationTime"`
+	Port                  int         `json:"port"`
+	GrpcPort              int         `json:"grpcPort"`
+	AppName               string      `json:"appName"`
+	AppEnv                string      `json:"appEnv"`
+	SignatureKey          string      `json:"signatureKey"`
+	Database              database    `json:"database"`
+	Redis                 redisClient `json:"redis"`
+	RateLimiterMaxRequest float64     `json:"rateLimiterMaxRequest"`
+	RateLimiterTimeSecond int         `json:"rateLimiterTimeSecond"`
+	JwtSecretKey          string      `json:"jwtSecretKey"`
+	JwtExpirationTime     int         `json:"jwtExpirationTime"`
+	Tracing               tracing     `json:"tracing"`
+	// OIDC berisi provider login OpenID Connect dengan nama provider sebagai key,
+	// misalnya "google". Provider yang tidak diatur tidak bisa dipakai untuk login.
+	OIDC map[string]oidc.Config `json:"oidc"`
+	// PhoneCountryCode adalah kode negara untuk nomor lokal yang diawali 0, misalnya "62"
+	PhoneCountryCode string `json:"phoneCountryCode"`
+	OTP              otp    `json:"otp"`
+}
+
+type otp struct {
+	// Driver pengirim pesan OTP, saat ini hanya "log" (untuk development)
+	Driver string `json:"driver"`
+	// Channel berisi "sms" atau "whatsapp"
+	Channel              string `json:"channel"`
+	CodeTTLSecond        int    `json:"codeTTLSecond"`
+	MaxAttempts          int    `json:"maxAttempts"`
+	ResendCooldownSecond int    `json:"resendCooldownSecond"`
+}
+
+type redisClient struct {
+	Addr     string `json:"addr"`
+	Password string `json:"password"`
+	DB       int    `json:"db"`
+}
+
+type tracing struct {
+	// Exporter berisi "otlp", "stdout", atau kosong jika span tidak diekspor
+	Exporter string `json:"exporter"`
+	// Endpoint adalah host:port collector OTLP gRPC
+	Endpoint string `json:"endpoint"`
+	Insecure bool   `json:"insecure"`
 }
 
 type database struct {
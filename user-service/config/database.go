@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"time"
 
+	"github.com/redis/go-redis/v9"
 "gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
@@ -14,8 +15,6 @@ func InitDB() (*gorm.DB, error) {
 	encodedPassword := url.QueryEscape(config.Database.Password)
 	uri := fmt.Sprintf("postgresql://%s:%s@%s:%d/%s?sslmode=disable", config.Database.UserName, encodedPassword, config.Database.Host, config.Database.Port, config.Database.Name)
 
-	fmt.Printf("Database Config: %+v\n", config.Database)
-
 	db, err := gorm.Open(postgres.Open(uri), &gorm.Config{})
 	if err != nil {
 		return nil, err
@@ -51,3 +50,12 @@ func InitDB() (*gorm.DB, error) {
 	return db, nil
 
 }
+
+// NewRedisClient membuat klien Redis dari konfigurasi service.
+func NewRedisClient() *redis.Client {
+	return redis.NewClient(&redis.Options{
+		Addr:     Config.Redis.Addr,
+		Password: Config.Redis.Password,
+		DB:       Config.Redis.DB,
+	})
+}
@@ -4,6 +4,8 @@ package error
 func ErrMapping(err error) bool {
 	allErrors := make([]error, 0)
 	allErrors = append(GeneralErrors[:], UserErrors[:]...) // Merging general and user errors
+	allErrors = append(allErrors, OIDCErrors[:]...)
+	allErrors = append(allErrors, OTPErrors[:]...)
 
 	for _, item := range allErrors {
 		if err.Error() == item.Error() {
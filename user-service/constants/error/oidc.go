@@ -0,0 +1,21 @@
+package error
+
+import "errors"
+
+var (
+	ErrOIDCProviderNotFound = errors.New("login provider not found")
+	ErrOIDCInvalidState     = errors.New("invalid or expired login state")
+	ErrOIDCLoginFailed      = errors.New("login with provider failed")
+	ErrOIDCEmailNotVerified = errors.New("email is not verified by the login provider")
+	ErrOIDCAccountExists    = errors.New("an account with this email already exists, log in with your password to link this provider")
+	ErrOIDCIdentityLinked   = errors.New("this provider account is already linked to another user")
+)
+
+var OIDCErrors = []error{
+	ErrOIDCProviderNotFound,
+	ErrOIDCInvalidState,
+	ErrOIDCLoginFailed,
+	ErrOIDCEmailNotVerified,
+	ErrOIDCAccountExists,
+	ErrOIDCIdentityLinked,
+}
@@ -0,0 +1,21 @@
+package error
+
+import "errors"
+
+var (
+	ErrInvalidPhoneNumber   = errors.New("invalid phone number")
+	ErrPhoneNumberExist     = errors.New("phone number is already verified by another account")
+	ErrPhoneAlreadyVerified = errors.New("phone number is already verified")
+	ErrOTPInvalid           = errors.New("invalid or expired otp code")
+	ErrOTPTooManyAttempts   = errors.New("too many otp attempts, please request a new code")
+	ErrOTPTooManyRequests   = errors.New("please wait before requesting another otp code")
+)
+
+var OTPErrors = []error{
+	ErrInvalidPhoneNumber,
+	ErrPhoneNumberExist,
+	ErrPhoneAlreadyVerified,
+	ErrOTPInvalid,
+	ErrOTPTooManyAttempts,
+	ErrOTPTooManyRequests,
+}
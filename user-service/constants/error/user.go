@@ -8,11 +8,20 @@ var (
 	ErrUsernameExist        = errors.New("username already exists")
 	ErrEmailExist           = errors.New("email already exists")
 	ErrPasswordDoesNotMatch = errors.New("password does not match")
+	ErrUserDeactivated      = errors.New("user account is deactivated")
+	ErrCurrentPassword      = errors.New("current password is required to change password")
+	ErrCannotManageSelf     = errors.New("cannot change your own role or status")
+	ErrRoleNotFound         = errors.New("role not found")
 )
 
 var UserErrors = []error{
 	ErrUserNotFound,
 	ErrPasswordIncorrect,
 	ErrUsernameExist,
+	ErrEmailExist,
 	ErrPasswordDoesNotMatch,
+	ErrUserDeactivated,
+	ErrCurrentPassword,
+	ErrCannotManageSelf,
+	ErrRoleNotFound,
 }
@@ -2,6 +2,14 @@ package constants
 
 // Role identifiers
 const (
-	Admin    = 1
-	Customer = 2
+	Admin        = 1
+	Customer     = 2
+	VenueManager = 3
+)
+
+// Role codes seperti yang tersimpan di token (kode role dalam huruf kecil)
+const (
+	AdminCode        = "admin"
+	CustomerCode     = "customer"
+	VenueManagerCode = "venue_manager"
 )
@@ -0,0 +1,25 @@
+package grpc
+
+import (
+	"errors"
+
+	errCons "github.com/anddriii/kita-futsal/user-service/constants/error"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// toStatus mengubah error dari service menjadi status gRPC. Sama seperti response HTTP,
+// hanya pesan error yang terdaftar di ErrMapping yang diteruskan ke pemanggil.
+func toStatus(err error) error {
+	switch {
+	case errors.Is(err, errCons.ErrUserNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, errCons.ErrUnauthorized), errors.Is(err, errCons.ErrInvalidToken):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, errCons.ErrForbidden):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errCons.ErrMapping(err):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return status.Error(codes.Internal, errCons.ErrInternalServerError.Error())
+}
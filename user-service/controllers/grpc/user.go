@@ -0,0 +1,78 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/anddriii/kita-futsal/user-service/constants"
+	errCons "github.com/anddriii/kita-futsal/user-service/constants/error"
+	"github.com/anddriii/kita-futsal/user-service/domain/dto"
+	pb "github.com/anddriii/kita-futsal/user-service/proto/user"
+	"github.com/anddriii/kita-futsal/user-service/services"
+	"github.com/go-playground/validator/v10"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UserServer melayani API internal user-service lewat gRPC, berjalan berdampingan dengan API HTTP Gin.
+type UserServer struct {
+	pb.UnimplementedUserServiceServer
+	service services.IServiceRegistry
+}
+
+func NewUserServer(service services.IServiceRegistry) *UserServer {
+	return &UserServer{service: service}
+}
+
+// GetUserByToken mengembalikan user pemilik token pada metadata authorization.
+func (u *UserServer) GetUserByToken(ctx context.Context, _ *pb.GetUserByTokenRequest) (*pb.User, error) {
+	if _, ok := ctx.Value(constants.UserLogin).(*dto.UserResponse); !ok {
+		return nil, status.Error(codes.Unauthenticated, errCons.ErrUnauthorized.Error())
+	}
+
+	user, err := u.service.GetUser().GetUserLogin(ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toUser(user), nil
+}
+
+func (u *UserServer) GetUserByUUID(ctx context.Context, req *pb.GetUserByUUIDRequest) (*pb.User, error) {
+	user, err := u.service.GetUser().GetUserUUID(ctx, req.GetUuid())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toUser(user), nil
+}
+
+func (u *UserServer) GetUsersByUUIDs(ctx context.Context, req *pb.GetUsersByUUIDsRequest) (*pb.GetUsersByUUIDsResponse, error) {
+	request := dto.BatchUserRequest{UUIDs: req.GetUuids()}
+	err := validator.New().Struct(request)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	users, err := u.service.GetUser().GetUsersByUUIDs(ctx, &request)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	response := &pb.GetUsersByUUIDsResponse{Users: make([]*pb.User, 0, len(users))}
+	for i := range users {
+		response.Users = append(response.Users, toUser(&users[i]))
+	}
+
+	return response, nil
+}
+
+func toUser(user *dto.UserResponse) *pb.User {
+	return &pb.User{
+		Uuid:        user.UUID.String(),
+		Name:        user.Name,
+		Username:    user.Username,
+		Email:       user.Email,
+		Role:        user.Role,
+		PhoneNumber: user.PhoneNumber,
+	}
+}
@@ -5,7 +5,18 @@ import "github.com/gin-gonic/gin"
 type IUserController interface {
 	Login(ctx *gin.Context)
 	Register(ctx *gin.Context)
-	Update(ctx *gin.Context)
+	OIDCAuthorize(ctx *gin.Context)
+	OIDCCallback(ctx *gin.Context)
+	OIDCLinkAuthorize(ctx *gin.Context)
+	OIDCLink(ctx *gin.Context)
+	SendPhoneVerification(ctx *gin.Context)
+	VerifyPhone(ctx *gin.Context)
+	RequestLoginOTP(ctx *gin.Context)
+	LoginWithOTP(ctx *gin.Context)
+	UpdateProfile(ctx *gin.Context)
 	GetUserLogin(ctx *gin.Context)
 	GetUserUUID(ctx *gin.Context)
+	GetAllWithPagination(ctx *gin.Context)
+	UpdateRole(ctx *gin.Context)
+	UpdateStatus(ctx *gin.Context)
 }
@@ -1,7 +1,6 @@
 package controllers
 
 import (
-	"log"
 	"net/http"
 
 	errWrap "github.com/anddriii/kita-futsal/user-service/common/error"
@@ -32,7 +31,6 @@ func (u *UserControllers) Login(ctx *gin.Context) {
 			Err:  err,
 			Gin:  ctx,
 		})
-		log.Println("Error saat bind json di controller:", err)
 		return
 	}
 
@@ -48,7 +46,6 @@ func (u *UserControllers) Login(ctx *gin.Context) {
 			Err:     err,
 			Gin:     ctx,
 		})
-		log.Println("Error saat validate di controller:", err)
 		return
 	}
 
@@ -59,7 +56,301 @@ func (u *UserControllers) Login(ctx *gin.Context) {
 			Err:  err,
 			Gin:  ctx,
 		})
-		log.Println("Error saat get user di controller:", err)
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code:  http.StatusOK,
+		Data:  user.User,
+		Token: &user.Token,
+		Gin:   ctx,
+	})
+}
+
+// OIDCAuthorize implements IUserController.
+// Frontend menyimpan binding di sesi browser, mengarahkan user ke authorizationUrl, lalu
+// meneruskan code serta state dari redirect provider bersama binding tersebut ke
+// OIDCCallback.
+func (u *UserControllers) OIDCAuthorize(ctx *gin.Context) {
+	result, err := u.UserService.GetUser().OIDCAuthorize(ctx, ctx.Param("provider"))
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  ctx,
+	})
+}
+
+// OIDCCallback implements IUserController.
+// Response-nya sama dengan Login: data user beserta token JWT.
+func (u *UserControllers) OIDCCallback(ctx *gin.Context) {
+	request := &dto.OIDCCallbackRequest{}
+
+	err := ctx.ShouldBindJSON(request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.WrapError(err)
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	user, err := u.UserService.GetUser().OIDCLogin(ctx, ctx.Param("provider"), request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code:  http.StatusOK,
+		Data:  user.User,
+		Token: &user.Token,
+		Gin:   ctx,
+	})
+}
+
+// OIDCLinkAuthorize implements IUserController.
+// Dipakai user yang sudah login untuk menghubungkan provider ke akunnya; code dan state
+// dari redirect provider diteruskan ke OIDCLink, bukan ke OIDCCallback.
+func (u *UserControllers) OIDCLinkAuthorize(ctx *gin.Context) {
+	result, err := u.UserService.GetUser().OIDCLinkAuthorize(ctx, ctx.Param("provider"))
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  ctx,
+	})
+}
+
+// OIDCLink implements IUserController.
+func (u *UserControllers) OIDCLink(ctx *gin.Context) {
+	request := &dto.OIDCCallbackRequest{}
+
+	err := ctx.ShouldBindJSON(request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.WrapError(err)
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	err = u.UserService.GetUser().OIDCLink(ctx, ctx.Param("provider"), request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Gin:  ctx,
+	})
+}
+
+// SendPhoneVerification implements IUserController.
+// Kode OTP dikirim ke nomor telepon yang tersimpan di profil user yang sedang login.
+func (u *UserControllers) SendPhoneVerification(ctx *gin.Context) {
+	err := u.UserService.GetUser().SendPhoneVerification(ctx)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Gin:  ctx,
+	})
+}
+
+// VerifyPhone implements IUserController.
+func (u *UserControllers) VerifyPhone(ctx *gin.Context) {
+	request := &dto.VerifyPhoneRequest{}
+
+	err := ctx.ShouldBindJSON(request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.WrapError(err)
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	user, err := u.UserService.GetUser().VerifyPhone(ctx, request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: user,
+		Gin:  ctx,
+	})
+}
+
+// RequestLoginOTP implements IUserController.
+// Response selalu sukses untuk nomor yang valid, terdaftar maupun tidak.
+func (u *UserControllers) RequestLoginOTP(ctx *gin.Context) {
+	request := &dto.OTPRequest{}
+
+	err := ctx.ShouldBindJSON(request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.WrapError(err)
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	err = u.UserService.GetUser().RequestLoginOTP(ctx, request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Gin:  ctx,
+	})
+}
+
+// LoginWithOTP implements IUserController.
+// Response-nya sama dengan Login: data user beserta token JWT.
+func (u *UserControllers) LoginWithOTP(ctx *gin.Context) {
+	request := &dto.OTPLoginRequest{}
+
+	err := ctx.ShouldBindJSON(request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.WrapError(err)
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	user, err := u.UserService.GetUser().LoginWithOTP(ctx, request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
 		return
 	}
 
@@ -117,10 +408,9 @@ func (u *UserControllers) Register(ctx *gin.Context) {
 	})
 }
 
-// Update implements IUserController.
-func (u *UserControllers) Update(ctx *gin.Context) {
+// UpdateProfile implements IUserController.
+func (u *UserControllers) UpdateProfile(ctx *gin.Context) {
 	request := &dto.UpdateRequest{}
-	uuid := ctx.Param("uuid")
 
 	err := ctx.ShouldBindJSON(request)
 	if err != nil {
@@ -129,7 +419,6 @@ func (u *UserControllers) Update(ctx *gin.Context) {
 			Err:  err,
 			Gin:  ctx,
 		})
-		log.Println("gagal bind json dari controller : ", err)
 		return
 	}
 
@@ -145,18 +434,16 @@ func (u *UserControllers) Update(ctx *gin.Context) {
 			Err:     err,
 			Gin:     ctx,
 		})
-		log.Println("gagal validasi dari controller : ", err)
 		return
 	}
 
-	user, err := u.UserService.GetUser().Update(ctx, request, uuid)
+	user, err := u.UserService.GetUser().UpdateProfile(ctx, request)
 	if err != nil {
 		response.HTTPResponse(response.ParamHTTPResp{
 			Code: http.StatusBadRequest,
 			Err:  err,
 			Gin:  ctx,
 		})
-		log.Println("gagal update ke service : ", err)
 		return
 	}
 
@@ -204,3 +491,141 @@ func (u *UserControllers) GetUserLogin(ctx *gin.Context) {
 		Gin:  ctx,
 	})
 }
+
+// GetAllWithPagination implements IUserController.
+func (u *UserControllers) GetAllWithPagination(ctx *gin.Context) {
+	var params dto.UserRequestParam
+
+	err := ctx.ShouldBindQuery(&params)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(params)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.WrapError(err)
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	result, err := u.UserService.GetUser().GetAllWithPagination(ctx, &params)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  ctx,
+	})
+}
+
+// UpdateRole implements IUserController.
+func (u *UserControllers) UpdateRole(ctx *gin.Context) {
+	request := &dto.UpdateRoleRequest{}
+
+	err := ctx.ShouldBindJSON(request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.WrapError(err)
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	user, err := u.UserService.GetUser().UpdateRole(ctx, ctx.Param("uuid"), request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: user,
+		Gin:  ctx,
+	})
+}
+
+// UpdateStatus implements IUserController.
+func (u *UserControllers) UpdateStatus(ctx *gin.Context) {
+	request := &dto.UpdateStatusRequest{}
+
+	err := ctx.ShouldBindJSON(request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.WrapError(err)
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	user, err := u.UserService.GetUser().UpdateStatus(ctx, ctx.Param("uuid"), request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: user,
+		Gin:  ctx,
+	})
+}
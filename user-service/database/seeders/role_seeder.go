@@ -16,6 +16,10 @@ func RunRoleSeeder(db *gorm.DB) {
 			Code: "CUSTOMER",
 			Name: "customer",
 		},
+		{
+			Code: "VENUE_MANAGER",
+			Name: "Venue Manager",
+		},
 	}
 
 	for _, role := range roles {
@@ -19,6 +19,7 @@ func RunUserSeeder(db *gorm.DB) {
 		PhoneNumber: "08311111111",
 		Email:       "admin@gmail.com",
 		RoleId:      constants.Admin,
+		IsActive:    true,
 	}
 
 	err := db.FirstOrCreate(&user, models.User{Username: user.Username}).Error
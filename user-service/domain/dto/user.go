@@ -8,12 +8,14 @@ type LoginRequest struct {
 }
 
 type UserResponse struct {
-	UUID        uuid.UUID `json:"uuid"`
-	Name        string    `json:"name"`
-	Username    string    `json:"username"`
-	Email       string    `json:"email"`
-	Role        string    `json:"role,omitempty"`
-	PhoneNumber string    `json:"phoneNumber"`
+	UUID          uuid.UUID `json:"uuid"`
+	Name          string    `json:"name"`
+	Username      string    `json:"username"`
+	Email         string    `json:"email"`
+	Role          string    `json:"role,omitempty"`
+	PhoneNumber   string    `json:"phoneNumber"`
+	PhoneVerified bool      `json:"phoneVerified"`
+	IsActive      *bool     `json:"isActive,omitempty"`
 }
 
 type LoginResponse struct {
@@ -35,12 +37,65 @@ type RegisterResponse struct {
 	User UserResponse `json:"user"`
 }
 
+// UpdateRequest dipakai user untuk mengubah profilnya sendiri. Password hanya diganti
+// jika Password dikirim, dan wajib disertai CurrentPassword yang benar.
 type UpdateRequest struct {
 	Name            string  `json:"name" validate:"required"`
 	Username        string  `json:"username" validate:"required"`
-	Password        *string `json:"password,omitempty"`
+	CurrentPassword *string `json:"currentPassword,omitempty"`
+	Password        *string `json:"password,omitempty" validate:"omitempty,min=1"`
 	ConfirmPassword *string `json:"confirmPassword,omitempty"`
 	Email           string  `json:"email" validate:"required,email"`
 	PhoneNumber     string  `json:"phoneNumber" validate:"required"`
-	RoleId          uint
+}
+
+// UserRequestParam adalah filter daftar user untuk admin. Search mencari di nama,
+// username, dan email.
+type UserRequestParam struct {
+	Page   int     `form:"page" validate:"required,min=1"`
+	Limit  int     `form:"limit" validate:"required,min=1,max=100"`
+	Search *string `form:"search"`
+	Role   *string `form:"role" validate:"omitempty,oneof=admin customer venue_manager"`
+	Active *bool   `form:"active"`
+}
+
+type UpdateRoleRequest struct {
+	Role string `json:"role" validate:"required,oneof=admin customer venue_manager"`
+}
+
+type UpdateStatusRequest struct {
+	Active *bool `json:"active" validate:"required"`
+}
+
+// OIDCAuthorizeResponse berisi URL login provider. Binding disimpan frontend di sesi
+// browser yang memulai login (bukan di URL) dan dikirim lagi bersama code dan state.
+type OIDCAuthorizeResponse struct {
+	AuthorizationURL string `json:"authorizationUrl"`
+	State            string `json:"state"`
+	Binding          string `json:"binding"`
+}
+
+// OIDCCallbackRequest berisi parameter code dan state yang dikirim provider ke
+// redirect URL setelah user login, beserta binding dari OIDCAuthorizeResponse.
+type OIDCCallbackRequest struct {
+	Code    string `json:"code" validate:"required"`
+	State   string `json:"state" validate:"required"`
+	Binding string `json:"binding" validate:"required"`
+}
+
+type VerifyPhoneRequest struct {
+	Code string `json:"code" validate:"required,len=6,numeric"`
+}
+
+type OTPRequest struct {
+	PhoneNumber string `json:"phoneNumber" validate:"required"`
+}
+
+type OTPLoginRequest struct {
+	PhoneNumber string `json:"phoneNumber" validate:"required"`
+	Code        string `json:"code" validate:"required,len=6,numeric"`
+}
+
+type BatchUserRequest struct {
+	UUIDs []string `json:"uuids" validate:"required,min=1,max=100,dive,uuid"`
 }
@@ -12,10 +12,16 @@ type User struct {
 	Name        string    `gorm:"type:varchar(100);not null"`
 	Username    string    `gorm:"type:varchar(20);not null;unique"`
 	Password    string    `gorm:"type:varchar(255);not null"`
-	PhoneNumber string    `gorm:"type:varchar(15);not null"`
+	PhoneNumber string    `gorm:"type:varchar(16);not null"`
 	Email       string    `gorm:"type:varchar(100);not null"`
 	RoleId      uint      `gorm:"type:uint;not null"`
-	CreatedAt   *time.Time
-	UpdatedAt   *time.Time
-	Role        Role `gorm:"foreignKey:role_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	IsActive    bool      `gorm:"not null;default:true"`
+	// PhoneVerifiedAt terisi setelah user memasukkan OTP yang dikirim ke PhoneNumber
+	PhoneVerifiedAt *time.Time
+	// EmailVerifiedAt terisi jika Email sudah dibuktikan oleh provider OIDC; email dari
+	// registrasi atau ubah profil belum terverifikasi
+	EmailVerifiedAt *time.Time
+	CreatedAt       *time.Time
+	UpdatedAt       *time.Time
+	Role            Role `gorm:"foreignKey:role_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 }
@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// UserIdentity menghubungkan user dengan akun di provider OpenID Connect. Satu user
+// boleh punya beberapa identitas, tetapi satu identitas (provider + subject) hanya
+// milik satu user.
+type UserIdentity struct {
+	ID        uint   `gorm:"primaryKey;autoIncrement;not null"`
+	UserID    uint   `gorm:"not null"`
+	Provider  string `gorm:"type:varchar(50);not null"`
+	Subject   string `gorm:"type:varchar(255);not null"`
+	Email     string `gorm:"type:varchar(100);not null"`
+	CreatedAt *time.Time
+	UpdatedAt *time.Time
+	User      User `gorm:"foreignKey:user_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
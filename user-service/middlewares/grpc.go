@@ -0,0 +1,77 @@
+package middlewares
+
+import (
+	"context"
+	"errors"
+
+	"github.com/anddriii/kita-futsal/user-service/common/logger"
+	"github.com/anddriii/kita-futsal/user-service/common/signature"
+	"github.com/anddriii/kita-futsal/user-service/constants"
+	errCons "github.com/anddriii/kita-futsal/user-service/constants/error"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// grpcMethod adalah method HTTP/2 yang dipakai gRPC untuk setiap panggilan unary.
+const grpcMethod = "POST"
+
+// GrpcAuthenticate adalah padanan Authenticate untuk server gRPC.
+// Kredensial antar service (x-service-name, x-request-at, x-nonce, x-signature) dibaca
+// dari metadata dan diverifikasi dengan skema yang sama seperti header HTTP; path yang
+// ditandatangani adalah nama method lengkap dan body-nya adalah request protobuf.
+// Jika metadata authorization dikirim, user yang login dimuat dari database dan disimpan
+// ke context seperti pada middleware HTTP. Request ID dari pemanggil juga diteruskan ke context untuk logger.
+func GrpcAuthenticate() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		ctx = context.WithValue(ctx, constants.RequestID, logger.RequestID(metadataValue(md, constants.XRequestID)))
+
+		message, ok := req.(proto.Message)
+		if !ok {
+			return nil, status.Error(codes.Internal, errCons.ErrInternalServerError.Error())
+		}
+		body, err := proto.MarshalOptions{Deterministic: true}.Marshal(message)
+		if err != nil {
+			return nil, status.Error(codes.Internal, errCons.ErrInternalServerError.Error())
+		}
+
+		err = checkSignature(ctx, signature.Request{
+			ServiceName: metadataValue(md, constants.XServiceName),
+			Method:      grpcMethod,
+			Path:        info.FullMethod,
+			Body:        body,
+			Timestamp:   metadataValue(md, constants.XRequestAt),
+			Nonce:       metadataValue(md, constants.XNonce),
+		}, metadataValue(md, constants.XSignature))
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, errCons.ErrUnauthorized.Error())
+		}
+
+		token := metadataValue(md, constants.Authorization)
+		if token != "" {
+			user, err := authenticateToken(ctx, token)
+			if err != nil {
+				if errors.Is(err, errCons.ErrUserDeactivated) {
+					return nil, status.Error(codes.Unauthenticated, err.Error())
+				}
+				return nil, status.Error(codes.Unauthenticated, errCons.ErrUnauthorized.Error())
+			}
+			ctx = context.WithValue(ctx, constants.UserLogin, user)
+			ctx = context.WithValue(ctx, constants.UserUUID, user.UUID.String())
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// metadataValue mengambil nilai pertama dari metadata, key tidak case sensitive.
+func metadataValue(md metadata.MD, key string) string {
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
@@ -0,0 +1,54 @@
+package middlewares
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/anddriii/kita-futsal/user-service/common/logger"
+	"github.com/anddriii/kita-futsal/user-service/constants"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// RequestID memakai X-Request-ID dari pemanggil atau membuat yang baru, menyimpannya
+// di context request untuk logger dan client, lalu mengembalikannya di response.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := logger.RequestID(c.GetHeader(constants.XRequestID))
+		c.Writer.Header().Set(constants.XRequestID, requestID)
+		c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), constants.RequestID, requestID))
+		c.Next()
+	}
+}
+
+// Logger menulis satu baris log terstruktur untuk setiap request. Middleware ini
+// menggantikan logger bawaan gin supaya access log juga membawa request ID dan user.
+func Logger() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+		status := c.Writer.Status()
+		entry := logger.FromContext(c.Request.Context()).WithFields(logrus.Fields{
+			"method":     c.Request.Method,
+			"route":      route,
+			"status":     status,
+			"latency_ms": time.Since(start).Milliseconds(),
+			"client_ip":  c.ClientIP(),
+		})
+		if err := c.Errors.Last(); err != nil {
+			entry = entry.WithError(err)
+		}
+
+		if status >= http.StatusInternalServerError {
+			entry.Error("request failed")
+			return
+		}
+		entry.Info("request completed")
+	}
+}
@@ -0,0 +1,24 @@
+package middlewares
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/anddriii/kita-futsal/user-service/common/metrics"
+	"github.com/gin-gonic/gin"
+)
+
+// Metrics mencatat durasi dan status setiap request. Request yang tidak cocok dengan
+// route mana pun memakai satu label yang sama agar path asing tidak membanjiri metric.
+func Metrics() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		start := time.Now()
+		ctx.Next()
+
+		route := ctx.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+		metrics.ObserveHTTPRequest(ctx.Request.Method, route, strconv.Itoa(ctx.Writer.Status()), time.Since(start))
+	}
+}
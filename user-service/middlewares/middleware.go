@@ -2,31 +2,29 @@ package middlewares
 
 import (
 	"context"
-	"crypto/sha256"
-	"encoding/hex"
-	"fmt"
-	"log"
+	"errors"
 	"net/http"
+	"slices"
 	"strings"
-	"time"
 
+	"github.com/anddriii/kita-futsal/user-service/common/logger"
 	"github.com/anddriii/kita-futsal/user-service/common/response"
 	"github.com/anddriii/kita-futsal/user-service/config"
 	"github.com/anddriii/kita-futsal/user-service/constants"
 	errCons "github.com/anddriii/kita-futsal/user-service/constants/error"
+	"github.com/anddriii/kita-futsal/user-service/domain/dto"
 	services "github.com/anddriii/kita-futsal/user-service/services/user"
 	"github.com/didip/tollbooth"
 	"github.com/didip/tollbooth/limiter"
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
-	"github.com/sirupsen/logrus"
 )
 
 func HandlePanic() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		defer func() {
 			if r := recover(); r != nil {
-				logrus.Errorf("Recovered from panic: %v", r)
+				logger.FromContext(ctx.Request.Context()).Errorf("recovered from panic: %v", r)
 				ctx.JSON(http.StatusInternalServerError, response.Response{
 					Status:  constants.Error,
 					Message: errCons.ErrInternalServerError.Error(),
@@ -69,48 +67,69 @@ func responUnauthorized(ctx *gin.Context, message string) {
 	ctx.Abort()
 }
 
-func validateApiKey(ctx *gin.Context) error {
-	// Ambil nilai API Key dan metadata dari request header
-	apiKey := ctx.GetHeader(constants.XApiKey)           // API Key yang dikirim oleh client
-	serviceName := ctx.GetHeader(constants.XServiceName) // Nama layanan yang melakukan request
-	requestAt := ctx.GetHeader(constants.XRequestAt)     // Timestamp saat request dikirim
-	log.Println("Apikey:", apiKey)
-	log.Println("servicename:", serviceName)
-	log.Println("request at:", requestAt)
-
-	// Ambil Signature Key dari konfigurasi server
-	signatureKey := config.Config.SignatureKey
-
-	// Buat string validasi dengan format "serviceName:signatureKey:requestAt"
-	validateKey := fmt.Sprintf("%s:%s:%s", serviceName, signatureKey, requestAt)
-
-	// Buat hash SHA-256 dari validateKey
-	hash := sha256.New()
-	hash.Write([]byte(validateKey))
-	resultHash := hex.EncodeToString(hash.Sum(nil)) // Konversi hash ke format string heksadesimal
-
-	// Bandingkan API Key dari request dengan hasil hash yang dihasilkan
-	if apiKey != resultHash {
-		// Jika tidak cocok, kembalikan error Unauthorized
-		log.Println("Apikey tidak sesuai dengan hash")
-		log.Println("api key: ", apiKey)
-		log.Println("resultHash: ", resultHash)
-		return errCons.ErrUnauthorized
-	}
+// UserLoader memuat data terbaru user pemilik token dan menolak user yang sudah
+// dinonaktifkan.
+type UserLoader interface {
+	GetActiveUser(ctx context.Context, uuid string) (*dto.UserResponse, error)
+}
 
-	// Jika validasi sukses, kembalikan nil (tidak ada error)
-	return nil
+// users dipakai untuk memuat ulang user pemilik token di setiap request.
+var users UserLoader
+
+// SetUserLoader memasang sumber data user untuk validasi token. Dipanggil sekali saat
+// service dijalankan, sebelum server menerima request.
+func SetUserLoader(loader UserLoader) {
+	users = loader
 }
 
 // validasi bearer token JWT
 func validateBearerToken(c *gin.Context, token string) error {
+	user, err := authenticateToken(c.Request.Context(), token)
+	if err != nil {
+		return err
+	}
+
+	userCtx := context.WithValue(c.Request.Context(), constants.UserLogin, user)
+	userCtx = context.WithValue(userCtx, constants.UserUUID, user.UUID.String())
+	c.Request = c.Request.WithContext(userCtx)
+	c.Set(constants.Token, token)
+	return nil
+}
+
+// authenticateToken memvalidasi token lalu memuat user pemiliknya dari database.
+// Data user di token bisa sudah usang (role diganti atau akun dinonaktifkan), jadi
+// yang disimpan ke context adalah data dari database.
+func authenticateToken(ctx context.Context, token string) (*dto.UserResponse, error) {
+	claims, err := parseBearerToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	if users == nil {
+		logger.FromContext(ctx).Error("user loader belum dipasang, token ditolak")
+		return nil, errCons.ErrUnauthorized
+	}
+
+	user, err := users.GetActiveUser(ctx, claims.User.UUID.String())
+	if err != nil {
+		if errors.Is(err, errCons.ErrUserDeactivated) {
+			return nil, err
+		}
+		return nil, errCons.ErrUnauthorized
+	}
+
+	return user, nil
+}
+
+// parseBearerToken memvalidasi token JWT "Bearer <token>" dan mengembalikan claims-nya.
+func parseBearerToken(token string) (*services.Claims, error) {
 	if !strings.Contains(token, "Bearer") {
-		return errCons.ErrUnauthorized
+		return nil, errCons.ErrUnauthorized
 	}
 
 	tokenString := extraBearertoken(token)
 	if tokenString == "" {
-		return errCons.ErrUnauthorized
+		return nil, errCons.ErrUnauthorized
 	}
 
 	claims := &services.Claims{}
@@ -123,18 +142,14 @@ func validateBearerToken(c *gin.Context, token string) error {
 		jwtSecret := []byte(config.Config.JwtSecretKey)
 		return jwtSecret, nil
 	})
-	log.Println("Token Expiration (from claims):", claims.ExpiresAt)
-	log.Println("Current Time:", time.Now().Unix())
-
 	if err != nil || !tokenJwt.Valid {
-		log.Println("error dari tokenJWT")
-		return err
+		if err == nil {
+			err = errCons.ErrInvalidToken
+		}
+		return nil, err
 	}
 
-	userLogin := c.Request.WithContext(context.WithValue(c.Request.Context(), constants.UserLogin, claims.User))
-	c.Request = userLogin
-	c.Set(constants.Token, token)
-	return nil
+	return claims, nil
 }
 
 func Authenticate() gin.HandlerFunc {
@@ -142,21 +157,18 @@ func Authenticate() gin.HandlerFunc {
 		var err error
 		token := c.GetHeader(constants.Authorization)
 		if token == "" {
-			log.Println("error: token kosong")
 			responUnauthorized(c, errCons.ErrUnauthorized.Error())
 			return
 		}
 
 		err = validateBearerToken(c, token)
 		if err != nil {
-			log.Println("error vaidate bearer token")
 			responUnauthorized(c, err.Error())
 			return
 		}
 
-		err = validateApiKey(c)
+		err = validateSignature(c)
 		if err != nil {
-			log.Println("error vaidate API KEY")
 			responUnauthorized(c, err.Error())
 			return
 		}
@@ -164,3 +176,20 @@ func Authenticate() gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// CheckRole membatasi route untuk role tertentu. Harus dipasang setelah Authenticate.
+func CheckRole(roles ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user, ok := c.Request.Context().Value(constants.UserLogin).(*dto.UserResponse)
+		if !ok || !slices.Contains(roles, user.Role) {
+			c.JSON(http.StatusForbidden, response.Response{
+				Status:  constants.Error,
+				Message: errCons.ErrForbidden.Error(),
+			})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,24 @@
+-- Skema awal, sama dengan hasil AutoMigrate sebelumnya. IF NOT EXISTS membuat database
+-- yang sudah dibuat oleh AutoMigrate bisa langsung memakai migrasi ini.
+CREATE TABLE IF NOT EXISTS roles (
+    id bigserial PRIMARY KEY,
+    code varchar(15) NOT NULL,
+    name varchar(20) NOT NULL,
+    created_at timestamptz,
+    updated_at timestamptz
+);
+
+CREATE TABLE IF NOT EXISTS users (
+    id bigserial PRIMARY KEY,
+    uuid uuid NOT NULL,
+    name varchar(100) NOT NULL,
+    username varchar(20) NOT NULL,
+    password varchar(255) NOT NULL,
+    phone_number varchar(15) NOT NULL,
+    email varchar(100) NOT NULL,
+    role_id bigint NOT NULL,
+    created_at timestamptz,
+    updated_at timestamptz,
+    CONSTRAINT uni_users_username UNIQUE (username),
+    CONSTRAINT fk_users_role FOREIGN KEY (role_id) REFERENCES roles (id) ON UPDATE CASCADE ON DELETE CASCADE
+);
@@ -0,0 +1,2 @@
+-- User yang dinonaktifkan admin tidak bisa login dan token lamanya ikut ditolak.
+ALTER TABLE users ADD COLUMN IF NOT EXISTS is_active boolean NOT NULL DEFAULT true;
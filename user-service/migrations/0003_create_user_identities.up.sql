@@ -0,0 +1,14 @@
+-- Identitas dari provider OpenID Connect (misalnya Google) yang terhubung ke user.
+CREATE TABLE IF NOT EXISTS user_identities (
+    id bigserial PRIMARY KEY,
+    user_id bigint NOT NULL,
+    provider varchar(50) NOT NULL,
+    subject varchar(255) NOT NULL,
+    email varchar(100) NOT NULL,
+    created_at timestamptz,
+    updated_at timestamptz,
+    CONSTRAINT fk_user_identities_user FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_user_identities_provider_subject ON user_identities (provider, subject);
+CREATE INDEX IF NOT EXISTS idx_user_identities_user_id ON user_identities (user_id);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_users_verified_phone_number;
+ALTER TABLE users DROP COLUMN IF EXISTS phone_verified_at;
+ALTER TABLE users ALTER COLUMN phone_number TYPE varchar(15);
@@ -0,0 +1,7 @@
+-- Nomor telepon disimpan dalam format E.164 (maksimal 16 karakter termasuk tanda +).
+ALTER TABLE users ALTER COLUMN phone_number TYPE varchar(16);
+ALTER TABLE users ADD COLUMN IF NOT EXISTS phone_verified_at timestamptz;
+
+-- Satu nomor terverifikasi hanya boleh dimiliki satu user, supaya login OTP selalu
+-- menemukan tepat satu akun.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_verified_phone_number ON users (phone_number) WHERE phone_verified_at IS NOT NULL;
@@ -0,0 +1,10 @@
+-- email_verified_at terisi jika email user sudah dibuktikan oleh provider OIDC. Email dari
+-- registrasi biasa tidak pernah diverifikasi, jadi hanya user dengan kolom ini yang boleh
+-- otomatis dihubungkan ke identitas provider berdasarkan email.
+ALTER TABLE users ADD COLUMN IF NOT EXISTS email_verified_at timestamptz;
+
+-- user yang dibuat atau dihubungkan lewat provider sebelum kolom ini ada memakai email
+-- yang sudah diverifikasi provider
+UPDATE users SET email_verified_at = NOW()
+WHERE email_verified_at IS NULL
+  AND EXISTS (SELECT 1 FROM user_identities WHERE user_identities.user_id = users.id AND user_identities.email = users.email);
@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/anddriii/kita-futsal/user-service/domain/models"
+)
+
+type IIdentityRepo interface {
+	FindUser(ctx context.Context, provider, subject string) (*models.User, error)
+	Create(ctx context.Context, identity *models.UserIdentity) error
+	CreateWithUser(ctx context.Context, user *models.User, identity *models.UserIdentity) error
+}
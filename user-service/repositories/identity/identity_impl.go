@@ -0,0 +1,71 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	errWrap "github.com/anddriii/kita-futsal/user-service/common/error"
+	"github.com/anddriii/kita-futsal/user-service/common/logger"
+	errConstant "github.com/anddriii/kita-futsal/user-service/constants/error"
+	"github.com/anddriii/kita-futsal/user-service/domain/models"
+	"gorm.io/gorm"
+)
+
+type IdentityRepoImpl struct {
+	db *gorm.DB
+}
+
+func NewIdentityRepo(db *gorm.DB) IIdentityRepo {
+	return &IdentityRepoImpl{db: db}
+}
+
+// FindUser implements IIdentityRepo.
+// Mengembalikan ErrUserNotFound jika identitas belum terhubung ke user mana pun.
+func (i *IdentityRepoImpl) FindUser(ctx context.Context, provider, subject string) (*models.User, error) {
+	var identity models.UserIdentity
+
+	err := i.db.WithContext(ctx).Preload("User.Role").
+		Where("provider = ? AND subject = ?", provider, subject).
+		First(&identity).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errConstant.ErrUserNotFound
+		}
+		logger.FromContext(ctx).Errorf("gagal mencari identitas user: %v", err)
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return &identity.User, nil
+}
+
+// Create implements IIdentityRepo.
+func (i *IdentityRepoImpl) Create(ctx context.Context, identity *models.UserIdentity) error {
+	err := i.db.WithContext(ctx).Omit("User").Create(identity).Error
+	if err != nil {
+		logger.FromContext(ctx).Errorf("gagal menyimpan identitas user: %v", err)
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return nil
+}
+
+// CreateWithUser implements IIdentityRepo.
+// User baru dan identitasnya disimpan dalam satu transaksi supaya tidak ada user dari
+// login provider yang tersimpan tanpa identitas.
+func (i *IdentityRepoImpl) CreateWithUser(ctx context.Context, user *models.User, identity *models.UserIdentity) error {
+	err := i.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Omit("Role").Create(user).Error
+		if err != nil {
+			return err
+		}
+
+		identity.UserID = user.ID
+		return tx.Omit("User").Create(identity).Error
+	})
+	if err != nil {
+		logger.FromContext(ctx).Errorf("gagal menyimpan user dari login provider: %v", err)
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	identityRepo "github.com/anddriii/kita-futsal/user-service/repositories/identity"
 	repositories "github.com/anddriii/kita-futsal/user-service/repositories/user"
 	"gorm.io/gorm"
 )
@@ -11,6 +12,7 @@ type Registry struct {
 
 type IRepoRegistry interface {
 	GetUser() repositories.IUserRepo
+	GetIdentity() identityRepo.IIdentityRepo
 }
 
 func NewRepoRegistry(db *gorm.DB) IRepoRegistry {
@@ -21,3 +23,8 @@ func NewRepoRegistry(db *gorm.DB) IRepoRegistry {
 func (r *Registry) GetUser() repositories.IUserRepo {
 	return repositories.NewUserRepo(r.db)
 }
+
+// GetIdentity implements IRepoRegistry.
+func (r *Registry) GetIdentity() identityRepo.IIdentityRepo {
+	return identityRepo.NewIdentityRepo(r.db)
+}
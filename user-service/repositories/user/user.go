@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/anddriii/kita-futsal/user-service/domain/dto"
 	"github.com/anddriii/kita-futsal/user-service/domain/models"
@@ -13,4 +14,12 @@ type IUserRepo interface {
 	FindByUsername(context.Context, string) (*models.User, error)
 	FindByEmail(context.Context, string) (*models.User, error)
 	FindByUUID(context.Context, string) (*models.User, error)
+	FindByUUIDs(context.Context, []string) ([]models.User, error)
+	FindAllWithPagination(ctx context.Context, param *dto.UserRequestParam) ([]models.User, int64, error)
+	FindRoleByCode(ctx context.Context, code string) (*models.Role, error)
+	UpdateRole(ctx context.Context, uuid string, roleID uint) error
+	UpdateStatus(ctx context.Context, uuid string, active bool) error
+	FindByVerifiedPhone(ctx context.Context, phone string) (*models.User, error)
+	UpdatePhoneVerification(ctx context.Context, uuid, phone string, verifiedAt *time.Time) error
+	UpdateEmailVerification(ctx context.Context, uuid string, verifiedAt *time.Time) error
 }
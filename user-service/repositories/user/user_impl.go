@@ -3,9 +3,11 @@ package repositories
 import (
 	"context"
 	"errors"
-	"log"
+	"strings"
+	"time"
 
 	errWrap "github.com/anddriii/kita-futsal/user-service/common/error"
+	"github.com/anddriii/kita-futsal/user-service/common/logger"
 	errConstant "github.com/anddriii/kita-futsal/user-service/constants/error"
 	"github.com/anddriii/kita-futsal/user-service/domain/dto"
 	"github.com/anddriii/kita-futsal/user-service/domain/models"
@@ -31,11 +33,12 @@ func (u *UserRepoImpl) Register(ctx context.Context, req *dto.RegisterRequest) (
 		PhoneNumber: req.PhoneNumber,
 		Email:       req.Email,
 		RoleId:      req.RoleId,
+		IsActive:    true,
 	}
 
 	err := u.db.WithContext(ctx).Create(&user).Error
 	if err != nil {
-		log.Println("Error inserting user to DB:", err)
+		logger.FromContext(ctx).Errorf("gagal menyimpan user: %v", err)
 		return nil, errWrap.WrapError(errConstant.ErrSQLError) // jika terjadi error akan memanipulasi log error agar tidak menampilkan "Query error" nya
 	}
 
@@ -43,14 +46,17 @@ func (u *UserRepoImpl) Register(ctx context.Context, req *dto.RegisterRequest) (
 }
 
 // Update implements UserRepo.
+// Password hanya diubah jika req.Password berisi hash password baru.
 func (u *UserRepoImpl) Update(ctx context.Context, req *dto.UpdateRequest, uuid string) (*models.User, error) {
 	user := models.User{
 		Name:        req.Name,
 		Username:    req.Username,
-		Password:    *req.Password,
 		Email:       req.Email,
 		PhoneNumber: req.PhoneNumber,
 	}
+	if req.Password != nil {
+		user.Password = *req.Password
+	}
 
 	err := u.db.WithContext(ctx).Where("uuid = ?", uuid).Updates(&user).Error
 	if err != nil {
@@ -82,16 +88,30 @@ func (u *UserRepoImpl) FindByUUID(ctx context.Context, uuid string) (*models.Use
 
 	err := u.db.WithContext(ctx).Preload("Role").Where("uuid = ?", uuid).First(&user).Error
 	if err != nil {
-		log.Println("Error sql query : ", err)
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, errConstant.ErrUserNotFound
 		}
+		logger.FromContext(ctx).Errorf("gagal mencari user berdasarkan uuid: %v", err)
 		return nil, errWrap.WrapError(errConstant.ErrSQLError)
 	}
 
 	return &user, nil
 }
 
+// FindByUUIDs implements UserRepo.
+// UUID yang tidak ditemukan tidak dianggap error, cukup tidak ada di hasil.
+func (u *UserRepoImpl) FindByUUIDs(ctx context.Context, uuids []string) ([]models.User, error) {
+	var users []models.User
+
+	err := u.db.WithContext(ctx).Preload("Role").Where("uuid IN ?", uuids).Find(&users).Error
+	if err != nil {
+		logger.FromContext(ctx).Errorf("gagal mencari user berdasarkan daftar uuid: %v", err)
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return users, nil
+}
+
 // FindByUsername implements UserRepo.
 func (u *UserRepoImpl) FindByUsername(ctx context.Context, username string) (*models.User, error) {
 	var user models.User
@@ -101,9 +121,141 @@ func (u *UserRepoImpl) FindByUsername(ctx context.Context, username string) (*mo
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, errConstant.ErrUserNotFound
 		}
-		log.Println("error repositories:", err)
+		logger.FromContext(ctx).Errorf("gagal mencari user berdasarkan username: %v", err)
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return &user, nil
+}
+
+// FindAllWithPagination implements UserRepo.
+func (u *UserRepoImpl) FindAllWithPagination(ctx context.Context, param *dto.UserRequestParam) ([]models.User, int64, error) {
+	var (
+		users []models.User
+		total int64
+	)
+
+	db := u.db.WithContext(ctx).Model(&models.User{})
+	if param.Search != nil && *param.Search != "" {
+		pattern := "%" + escapeLike(*param.Search) + "%"
+		db = db.Where("name ILIKE ? OR username ILIKE ? OR email ILIKE ?", pattern, pattern, pattern)
+	}
+	if param.Role != nil {
+		db = db.Where("role_id IN (SELECT id FROM roles WHERE code = ?)", strings.ToUpper(*param.Role))
+	}
+	if param.Active != nil {
+		db = db.Where("is_active = ?", *param.Active)
+	}
+
+	err := db.Session(&gorm.Session{}).
+		Preload("Role").
+		Order("id ASC").
+		Limit(param.Limit).
+		Offset((param.Page - 1) * param.Limit).
+		Find(&users).Error
+	if err != nil {
+		logger.FromContext(ctx).Errorf("gagal mengambil daftar user: %v", err)
+		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	// hitung total data TANPA limit & offset
+	err = db.Session(&gorm.Session{}).Count(&total).Error
+	if err != nil {
+		logger.FromContext(ctx).Errorf("gagal menghitung jumlah user: %v", err)
+		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return users, total, nil
+}
+
+// FindRoleByCode implements UserRepo.
+// Kode role di database tersimpan dalam huruf besar, misalnya VENUE_MANAGER.
+func (u *UserRepoImpl) FindRoleByCode(ctx context.Context, code string) (*models.Role, error) {
+	var role models.Role
+
+	err := u.db.WithContext(ctx).Where("code = ?", strings.ToUpper(code)).First(&role).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errConstant.ErrRoleNotFound
+		}
+		logger.FromContext(ctx).Errorf("gagal mencari role: %v", err)
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return &role, nil
+}
+
+// UpdateRole implements UserRepo.
+func (u *UserRepoImpl) UpdateRole(ctx context.Context, uuid string, roleID uint) error {
+	err := u.db.WithContext(ctx).Model(&models.User{}).Where("uuid = ?", uuid).Update("role_id", roleID).Error
+	if err != nil {
+		logger.FromContext(ctx).Errorf("gagal mengubah role user: %v", err)
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return nil
+}
+
+// UpdateStatus implements UserRepo.
+// Memakai Update per kolom karena nilai false akan dilewati oleh Updates dengan struct.
+func (u *UserRepoImpl) UpdateStatus(ctx context.Context, uuid string, active bool) error {
+	err := u.db.WithContext(ctx).Model(&models.User{}).Where("uuid = ?", uuid).Update("is_active", active).Error
+	if err != nil {
+		logger.FromContext(ctx).Errorf("gagal mengubah status user: %v", err)
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return nil
+}
+
+// FindByVerifiedPhone implements UserRepo.
+// Hanya nomor yang sudah diverifikasi yang dicari, nomor yang belum diverifikasi bisa dimiliki lebih dari satu user.
+func (u *UserRepoImpl) FindByVerifiedPhone(ctx context.Context, phone string) (*models.User, error) {
+	var user models.User
+
+	err := u.db.WithContext(ctx).Preload("Role").
+		Where("phone_number = ? AND phone_verified_at IS NOT NULL", phone).
+		First(&user).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errConstant.ErrUserNotFound
+		}
+		logger.FromContext(ctx).Errorf("gagal mencari user berdasarkan nomor telepon: %v", err)
 		return nil, errWrap.WrapError(errConstant.ErrSQLError)
 	}
 
 	return &user, nil
 }
+
+// UpdatePhoneVerification implements UserRepo.
+// verifiedAt bernilai nil untuk menghapus status verifikasi saat nomor telepon diganti.
+func (u *UserRepoImpl) UpdatePhoneVerification(ctx context.Context, uuid, phone string, verifiedAt *time.Time) error {
+	err := u.db.WithContext(ctx).Model(&models.User{}).Where("uuid = ?", uuid).Updates(map[string]any{
+		"phone_number":      phone,
+		"phone_verified_at": verifiedAt,
+	}).Error
+	if err != nil {
+		logger.FromContext(ctx).Errorf("gagal mengubah verifikasi nomor telepon: %v", err)
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return nil
+}
+
+// UpdateEmailVerification implements UserRepo.
+// verifiedAt bernilai nil untuk menghapus status verifikasi saat email diganti.
+func (u *UserRepoImpl) UpdateEmailVerification(ctx context.Context, uuid string, verifiedAt *time.Time) error {
+	err := u.db.WithContext(ctx).Model(&models.User{}).Where("uuid = ?", uuid).
+		Update("email_verified_at", verifiedAt).Error
+	if err != nil {
+		logger.FromContext(ctx).Errorf("gagal mengubah verifikasi email: %v", err)
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return nil
+}
+
+// escapeLike meng-escape karakter wildcard LIKE supaya kata kunci pencarian dicari apa adanya.
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
@@ -1,6 +1,7 @@
 package routes
 
 import (
+	"github.com/anddriii/kita-futsal/user-service/constants"
 	"github.com/anddriii/kita-futsal/user-service/controllers"
 	"github.com/anddriii/kita-futsal/user-service/middlewares"
 	"github.com/gin-gonic/gin"
@@ -26,5 +27,21 @@ func (u *UserRoute) Run() {
 	group.GET("/:uuid", middlewares.Authenticate(), u.controller.GetUserController().GetUserUUID)
 	group.POST("/login", u.controller.GetUserController().Login)
 	group.POST("/register", u.controller.GetUserController().Register)
-	group.PUT("/:uuid", middlewares.Authenticate(), u.controller.GetUserController().Update)
+	group.GET("/oidc/:provider/authorize", u.controller.GetUserController().OIDCAuthorize)
+	group.POST("/oidc/:provider/callback", u.controller.GetUserController().OIDCCallback)
+	group.PUT("/me", middlewares.Authenticate(), u.controller.GetUserController().UpdateProfile)
+	group.GET("/me/oidc/:provider/authorize", middlewares.Authenticate(), u.controller.GetUserController().OIDCLinkAuthorize)
+	group.POST("/me/oidc/:provider/link", middlewares.Authenticate(), u.controller.GetUserController().OIDCLink)
+
+	// verifikasi nomor telepon dan login tanpa password lewat OTP
+	group.POST("/me/phone/otp", middlewares.Authenticate(), u.controller.GetUserController().SendPhoneVerification)
+	group.POST("/me/phone/verify", middlewares.Authenticate(), u.controller.GetUserController().VerifyPhone)
+	group.POST("/otp/request", u.controller.GetUserController().RequestLoginOTP)
+	group.POST("/otp/login", u.controller.GetUserController().LoginWithOTP)
+
+	// manajemen user hanya untuk admin
+	admin := group.Group("/admin/users", middlewares.Authenticate(), middlewares.CheckRole(constants.AdminCode))
+	admin.GET("", u.controller.GetUserController().GetAllWithPagination)
+	admin.PATCH("/:uuid/role", u.controller.GetUserController().UpdateRole)
+	admin.PATCH("/:uuid/status", u.controller.GetUserController().UpdateStatus)
 }
@@ -1,23 +1,27 @@
 package services
 
 import (
+	"github.com/anddriii/kita-futsal/user-service/common/oidc"
+	"github.com/anddriii/kita-futsal/user-service/common/otp"
 	"github.com/anddriii/kita-futsal/user-service/repositories"
 	service "github.com/anddriii/kita-futsal/user-service/services/user"
 )
 
 type Registry struct {
 	repository repositories.IRepoRegistry
+	oidc       *oidc.Client
+	otp        *otp.Manager
 }
 
 type IServiceRegistry interface {
 	GetUser() service.IUserService
 }
 
-func NewServiceRegistry(repository repositories.IRepoRegistry) IServiceRegistry {
-	return &Registry{repository: repository}
+func NewServiceRegistry(repository repositories.IRepoRegistry, oidc *oidc.Client, otp *otp.Manager) IServiceRegistry {
+	return &Registry{repository: repository, oidc: oidc, otp: otp}
 }
 
 // GetUser implements IServiceRegistry.
 func (r *Registry) GetUser() service.IUserService {
-	return service.NewUserService(r.repository)
+	return service.NewUserService(r.repository, r.oidc, r.otp)
 }
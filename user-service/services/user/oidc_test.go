@@ -0,0 +1,346 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/anddriii/kita-futsal/user-service/common/oidc"
+	"github.com/anddriii/kita-futsal/user-service/common/oidc/oidctest"
+	"github.com/anddriii/kita-futsal/user-service/config"
+	"github.com/anddriii/kita-futsal/user-service/constants"
+	errConst "github.com/anddriii/kita-futsal/user-service/constants/error"
+	"github.com/anddriii/kita-futsal/user-service/domain/dto"
+	"github.com/anddriii/kita-futsal/user-service/domain/models"
+	identityRepo "github.com/anddriii/kita-futsal/user-service/repositories/identity"
+	userRepo "github.com/anddriii/kita-futsal/user-service/repositories/user"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// fakeUserRepo hanya mengimplementasikan method yang dipakai flow login; method lain
+// panic karena interface yang di-embed bernilai nil.
+type fakeUserRepo struct {
+	userRepo.IUserRepo
+	users []*models.User
+}
+
+func (f *fakeUserRepo) FindByUsername(_ context.Context, username string) (*models.User, error) {
+	for _, user := range f.users {
+		if user.Username == username {
+			return user, nil
+		}
+	}
+	return nil, errConst.ErrUserNotFound
+}
+
+func (f *fakeUserRepo) FindByUUID(_ context.Context, uuid string) (*models.User, error) {
+	for _, user := range f.users {
+		if user.UUID.String() == uuid {
+			return user, nil
+		}
+	}
+	return nil, errConst.ErrUserNotFound
+}
+
+func (f *fakeUserRepo) UpdateEmailVerification(_ context.Context, uuid string, verifiedAt *time.Time) error {
+	user, err := f.FindByUUID(context.Background(), uuid)
+	if err != nil {
+		return err
+	}
+	user.EmailVerifiedAt = verifiedAt
+	return nil
+}
+
+func (f *fakeUserRepo) FindByEmail(_ context.Context, email string) (*models.User, error) {
+	for _, user := range f.users {
+		if user.Email == email {
+			return user, nil
+		}
+	}
+	return nil, errConst.ErrUserNotFound
+}
+
+type fakeIdentityRepo struct {
+	identityRepo.IIdentityRepo
+	identities map[string]*models.User
+	created    []models.UserIdentity
+}
+
+func (f *fakeIdentityRepo) FindUser(_ context.Context, provider, subject string) (*models.User, error) {
+	user, ok := f.identities[provider+"|"+subject]
+	if !ok {
+		return nil, errConst.ErrUserNotFound
+	}
+	return user, nil
+}
+
+func (f *fakeIdentityRepo) Create(_ context.Context, identity *models.UserIdentity) error {
+	f.created = append(f.created, *identity)
+	return nil
+}
+
+func (f *fakeIdentityRepo) CreateWithUser(_ context.Context, _ *models.User, identity *models.UserIdentity) error {
+	f.created = append(f.created, *identity)
+	return nil
+}
+
+type fakeRepoRegistry struct {
+	user     *fakeUserRepo
+	identity *fakeIdentityRepo
+}
+
+func (f *fakeRepoRegistry) GetUser() userRepo.IUserRepo             { return f.user }
+func (f *fakeRepoRegistry) GetIdentity() identityRepo.IIdentityRepo { return f.identity }
+
+type oidcFixture struct {
+	service  IUserService
+	issuer   *oidctest.Server
+	repo     *fakeRepoRegistry
+	customer *models.User
+}
+
+const customerPassword = "rahasia123"
+
+func newOIDCFixture(t *testing.T) *oidcFixture {
+	t.Helper()
+
+	config.Config.JwtSecretKey = "test-secret"
+
+	issuer := oidctest.NewServer("user-service")
+	t.Cleanup(issuer.Close)
+
+	rdb := redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+
+	client := oidc.NewClient(map[string]oidc.Config{
+		"google": {IssuerURL: issuer.URL, ClientID: issuer.ClientID, RedirectURL: "http://localhost/callback"},
+	}, oidc.NewRedisSessionStore(rdb), http.DefaultClient)
+
+	hashed, err := bcrypt.GenerateFromPassword([]byte(customerPassword), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	customer := &models.User{
+		ID:       1,
+		UUID:     uuid.New(),
+		Name:     "Budi",
+		Username: "budi",
+		Password: string(hashed),
+		Email:    "budi@example.com",
+		IsActive: true,
+		Role:     models.Role{Code: "CUSTOMER"},
+	}
+
+	repo := &fakeRepoRegistry{
+		user:     &fakeUserRepo{users: []*models.User{customer}},
+		identity: &fakeIdentityRepo{identities: map[string]*models.User{}},
+	}
+
+	return &oidcFixture{
+		service:  NewUserService(repo, client, nil),
+		issuer:   issuer,
+		repo:     repo,
+		customer: customer,
+	}
+}
+
+// issue menyiapkan authorization code "code" di issuer palsu untuk sesi dari authorize
+// dan mengembalikan request callback dari browser yang memulai login.
+func (f *oidcFixture) issue(t *testing.T, authorize *dto.OIDCAuthorizeResponse, err error, claims oidctest.Claims) *dto.OIDCCallbackRequest {
+	t.Helper()
+
+	if err != nil {
+		t.Fatalf("authorize: %v", err)
+	}
+	parsed, err := url.Parse(authorize.AuthorizationURL)
+	if err != nil {
+		t.Fatalf("parse authorize url: %v", err)
+	}
+
+	claims.Nonce = parsed.Query().Get("nonce")
+	f.issuer.Issue("code", parsed.Query().Get("code_challenge"), claims)
+	return &dto.OIDCCallbackRequest{Code: "code", State: authorize.State, Binding: authorize.Binding}
+}
+
+// login menjalankan flow OIDC lengkap dengan issuer palsu yang mengembalikan claims.
+func (f *oidcFixture) login(t *testing.T, claims oidctest.Claims) (*dto.LoginResponse, error) {
+	t.Helper()
+
+	ctx := context.Background()
+	authorize, err := f.service.OIDCAuthorize(ctx, "google")
+	callback := f.issue(t, authorize, err, claims)
+
+	return f.service.OIDCLogin(ctx, "google", callback)
+}
+
+// loggedIn mengembalikan context request dari user yang sudah login.
+func loggedIn(user *models.User) context.Context {
+	return context.WithValue(context.Background(), constants.UserLogin, &dto.UserResponse{UUID: user.UUID})
+}
+
+// link menjalankan flow menghubungkan provider dari sesi user yang sudah login.
+func (f *oidcFixture) link(t *testing.T, user *models.User, claims oidctest.Claims) error {
+	t.Helper()
+
+	ctx := loggedIn(user)
+	authorize, err := f.service.OIDCLinkAuthorize(ctx, "google")
+	callback := f.issue(t, authorize, err, claims)
+
+	return f.service.OIDCLink(ctx, "google", callback)
+}
+
+func parseClaims(t *testing.T, token string) *Claims {
+	t.Helper()
+
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(*jwt.Token) (any, error) {
+		return []byte(config.Config.JwtSecretKey), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+	if err != nil {
+		t.Fatalf("parse token: %v", err)
+	}
+	return claims
+}
+
+func TestOIDCLoginDoesNotLinkUnverifiedEmail(t *testing.T) {
+	f := newOIDCFixture(t)
+
+	_, err := f.login(t, oidctest.Claims{Subject: "subject-1", Email: f.customer.Email, EmailVerified: false})
+	if !errors.Is(err, errConst.ErrOIDCEmailNotVerified) {
+		t.Fatalf("OIDCLogin error = %v, want %v", err, errConst.ErrOIDCEmailNotVerified)
+	}
+	if len(f.repo.identity.created) != 0 {
+		t.Fatalf("identity was linked: %+v", f.repo.identity.created)
+	}
+}
+
+func TestOIDCLoginDoesNotLinkUnverifiedLocalEmail(t *testing.T) {
+	f := newOIDCFixture(t)
+
+	_, err := f.login(t, oidctest.Claims{Subject: "subject-1", Email: f.customer.Email, EmailVerified: true})
+	if !errors.Is(err, errConst.ErrOIDCAccountExists) {
+		t.Fatalf("OIDCLogin error = %v, want %v", err, errConst.ErrOIDCAccountExists)
+	}
+	if len(f.repo.identity.created) != 0 {
+		t.Fatalf("identity was linked: %+v", f.repo.identity.created)
+	}
+}
+
+func TestOIDCLoginLinksVerifiedEmail(t *testing.T) {
+	f := newOIDCFixture(t)
+	verifiedAt := time.Now()
+	f.customer.EmailVerifiedAt = &verifiedAt
+
+	response, err := f.login(t, oidctest.Claims{Subject: "subject-1", Email: f.customer.Email, EmailVerified: true})
+	if err != nil {
+		t.Fatalf("OIDCLogin: %v", err)
+	}
+	if response.User.UUID != f.customer.UUID {
+		t.Fatalf("logged in as %s, want %s", response.User.UUID, f.customer.UUID)
+	}
+	want := models.UserIdentity{UserID: f.customer.ID, Provider: "google", Subject: "subject-1", Email: f.customer.Email}
+	if len(f.repo.identity.created) != 1 || f.repo.identity.created[0] != want {
+		t.Fatalf("created identities = %+v, want [%+v]", f.repo.identity.created, want)
+	}
+}
+
+func TestOIDCLoginExistingIdentityIssuesSameToken(t *testing.T) {
+	f := newOIDCFixture(t)
+	f.repo.identity.identities["google|subject-1"] = f.customer
+
+	oidcResponse, err := f.login(t, oidctest.Claims{Subject: "subject-1", Email: "lain@example.com", EmailVerified: true})
+	if err != nil {
+		t.Fatalf("OIDCLogin: %v", err)
+	}
+	passwordResponse, err := f.service.Login(context.Background(), &dto.LoginRequest{Username: f.customer.Username, Password: customerPassword})
+	if err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+
+	if len(f.repo.identity.created) != 0 {
+		t.Fatalf("existing identity was linked again: %+v", f.repo.identity.created)
+	}
+
+	oidcClaims := parseClaims(t, oidcResponse.Token)
+	passwordClaims := parseClaims(t, passwordResponse.Token)
+	if *oidcClaims.User != *passwordClaims.User {
+		t.Fatalf("token user = %+v, want %+v", *oidcClaims.User, *passwordClaims.User)
+	}
+	if diff := passwordClaims.ExpiresAt.Sub(oidcClaims.ExpiresAt.Time); diff < 0 || diff > 2*time.Second {
+		t.Fatalf("token expiry differs by %s", diff)
+	}
+}
+
+func TestOIDCLoginRejectsDeactivatedUser(t *testing.T) {
+	f := newOIDCFixture(t)
+	f.customer.IsActive = false
+	f.repo.identity.identities["google|subject-1"] = f.customer
+
+	_, err := f.login(t, oidctest.Claims{Subject: "subject-1", Email: f.customer.Email, EmailVerified: true})
+	if !errors.Is(err, errConst.ErrUserDeactivated) {
+		t.Fatalf("OIDCLogin error = %v, want %v", err, errConst.ErrUserDeactivated)
+	}
+}
+
+func TestOIDCLinkFromLoggedInSession(t *testing.T) {
+	f := newOIDCFixture(t)
+
+	err := f.link(t, f.customer, oidctest.Claims{Subject: "subject-1", Email: f.customer.Email, EmailVerified: true})
+	if err != nil {
+		t.Fatalf("OIDCLink: %v", err)
+	}
+	want := models.UserIdentity{UserID: f.customer.ID, Provider: "google", Subject: "subject-1", Email: f.customer.Email}
+	if len(f.repo.identity.created) != 1 || f.repo.identity.created[0] != want {
+		t.Fatalf("created identities = %+v, want [%+v]", f.repo.identity.created, want)
+	}
+	if f.customer.EmailVerifiedAt == nil {
+		t.Fatal("email proven by the provider was not marked verified")
+	}
+}
+
+func TestOIDCLinkRejectsIdentityOfOtherUser(t *testing.T) {
+	f := newOIDCFixture(t)
+	f.repo.identity.identities["google|subject-1"] = &models.User{ID: 2, UUID: uuid.New()}
+
+	err := f.link(t, f.customer, oidctest.Claims{Subject: "subject-1", Email: f.customer.Email, EmailVerified: true})
+	if !errors.Is(err, errConst.ErrOIDCIdentityLinked) {
+		t.Fatalf("OIDCLink error = %v, want %v", err, errConst.ErrOIDCIdentityLinked)
+	}
+	if len(f.repo.identity.created) != 0 || f.customer.EmailVerifiedAt != nil {
+		t.Fatal("identity of another user was linked")
+	}
+}
+
+func TestOIDCLinkRejectsLoginState(t *testing.T) {
+	f := newOIDCFixture(t)
+
+	authorize, err := f.service.OIDCAuthorize(context.Background(), "google")
+	callback := f.issue(t, authorize, err, oidctest.Claims{Subject: "subject-1", Email: f.customer.Email, EmailVerified: true})
+
+	err = f.service.OIDCLink(loggedIn(f.customer), "google", callback)
+	if !errors.Is(err, errConst.ErrOIDCInvalidState) {
+		t.Fatalf("OIDCLink error = %v, want %v", err, errConst.ErrOIDCInvalidState)
+	}
+}
+
+func TestOIDCLoginRejectsCallbackWithoutBinding(t *testing.T) {
+	f := newOIDCFixture(t)
+
+	// penyerang memulai login dengan akunnya sendiri lalu memancing browser korban
+	// membuka redirect provider; browser korban tidak punya binding-nya
+	authorize, err := f.service.OIDCAuthorize(context.Background(), "google")
+	callback := f.issue(t, authorize, err, oidctest.Claims{Subject: "attacker", Email: "attacker@example.com", EmailVerified: true})
+	callback.Binding = ""
+
+	_, err = f.service.OIDCLogin(context.Background(), "google", callback)
+	if !errors.Is(err, errConst.ErrOIDCInvalidState) {
+		t.Fatalf("OIDCLogin error = %v, want %v", err, errConst.ErrOIDCInvalidState)
+	}
+}
@@ -3,15 +3,29 @@ package services
 import (
 	"context"
 
+	"github.com/anddriii/kita-futsal/user-service/common/util"
 	"github.com/anddriii/kita-futsal/user-service/domain/dto"
 )
 
 type IUserService interface {
 	Login(ctx context.Context, req *dto.LoginRequest) (*dto.LoginResponse, error)
 	Register(ctx context.Context, req *dto.RegisterRequest) (*dto.RegisterResponse, error)
-	Update(ctx context.Context, req *dto.UpdateRequest, username string) (*dto.UserResponse, error)
+	OIDCAuthorize(ctx context.Context, provider string) (*dto.OIDCAuthorizeResponse, error)
+	OIDCLogin(ctx context.Context, provider string, req *dto.OIDCCallbackRequest) (*dto.LoginResponse, error)
+	OIDCLinkAuthorize(ctx context.Context, provider string) (*dto.OIDCAuthorizeResponse, error)
+	OIDCLink(ctx context.Context, provider string, req *dto.OIDCCallbackRequest) error
+	SendPhoneVerification(ctx context.Context) error
+	VerifyPhone(ctx context.Context, req *dto.VerifyPhoneRequest) (*dto.UserResponse, error)
+	RequestLoginOTP(ctx context.Context, req *dto.OTPRequest) error
+	LoginWithOTP(ctx context.Context, req *dto.OTPLoginRequest) (*dto.LoginResponse, error)
+	UpdateProfile(ctx context.Context, req *dto.UpdateRequest) (*dto.UserResponse, error)
 	GetUserLogin(ctx context.Context) (*dto.UserResponse, error)
 	GetUserUUID(ctx context.Context, uuid string) (*dto.UserResponse, error)
+	GetUsersByUUIDs(ctx context.Context, req *dto.BatchUserRequest) ([]dto.UserResponse, error)
+	GetActiveUser(ctx context.Context, uuid string) (*dto.UserResponse, error)
+	GetAllWithPagination(ctx context.Context, param *dto.UserRequestParam) (*util.PaginationResult, error)
+	UpdateRole(ctx context.Context, uuid string, req *dto.UpdateRoleRequest) (*dto.UserResponse, error)
+	UpdateStatus(ctx context.Context, uuid string, req *dto.UpdateStatusRequest) (*dto.UserResponse, error)
 	ifUsernameExist(ctx context.Context, username string) bool
 	ifEmailExist(ctx context.Context, email string) bool
 }
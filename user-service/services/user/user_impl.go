@@ -2,11 +2,20 @@ package services
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"errors"
-	"log"
+	"fmt"
+	"math/big"
+	"regexp"
 	"strings"
 	"time"
 
+	"github.com/anddriii/kita-futsal/user-service/common/logger"
+	"github.com/anddriii/kita-futsal/user-service/common/oidc"
+	"github.com/anddriii/kita-futsal/user-service/common/otp"
+	"github.com/anddriii/kita-futsal/user-service/common/phone"
+	"github.com/anddriii/kita-futsal/user-service/common/util"
 	"github.com/anddriii/kita-futsal/user-service/config"
 	"github.com/anddriii/kita-futsal/user-service/constants"
 	errConst "github.com/anddriii/kita-futsal/user-service/constants/error"
@@ -14,18 +23,30 @@ import (
 	"github.com/anddriii/kita-futsal/user-service/domain/models"
 	"github.com/anddriii/kita-futsal/user-service/repositories"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 	"gorm.io/gorm"
 )
 
 type UserService struct {
 	repository repositories.IRepoRegistry
+	oidc       *oidc.Client
+	otp        *otp.Manager
 }
 
-func NewUserService(repository repositories.IRepoRegistry) IUserService {
-	return &UserService{repository: repository}
+func NewUserService(repository repositories.IRepoRegistry, oidc *oidc.Client, otp *otp.Manager) IUserService {
+	return &UserService{repository: repository, oidc: oidc, otp: otp}
 }
 
+// maxUsernameBase menyisakan tempat untuk akhiran "_12345" di kolom username (20 karakter).
+const maxUsernameBase = 14
+
+// usernameAttempts adalah jumlah percobaan mencari username acak yang belum dipakai.
+const usernameAttempts = 5
+
+// usernamePattern mencocokkan karakter yang tidak boleh ada di username hasil generate.
+var usernamePattern = regexp.MustCompile(`[^a-z0-9._]`)
+
 type Claims struct {
 	User *dto.UserResponse
 	jwt.RegisteredClaims
@@ -44,18 +65,28 @@ func (u *UserService) Login(ctx context.Context, req *dto.LoginRequest) (*dto.Lo
 		return nil, errConst.ErrPasswordIncorrect
 	}
 
+	// akun yang dinonaktifkan admin tidak boleh login
+	if !user.IsActive {
+		return nil, errConst.ErrUserDeactivated
+	}
+
+	return u.generateToken(user)
+}
+
+// generateToken membuat JWT untuk user yang berhasil login. Dipakai oleh login
+// username/password dan login lewat provider OIDC sehingga token keduanya sama.
+func (u *UserService) generateToken(user *models.User) (*dto.LoginResponse, error) {
 	//Menentukan Waktu Kadaluarsa Token
 	expirationTime := time.Now().Add(24 * time.Hour).Unix()
-	log.Println("JWT Expiration Time:", expirationTime)
-	log.Println("Current Unix Time:", time.Now().Unix())
 
 	data := &dto.UserResponse{
-		UUID:        user.UUID,
-		Name:        user.Name,
-		Username:    user.Username,
-		PhoneNumber: user.PhoneNumber,
-		Email:       user.Email,
-		Role:        strings.ToLower(user.Role.Code),
+		UUID:          user.UUID,
+		Name:          user.Name,
+		Username:      user.Username,
+		PhoneNumber:   user.PhoneNumber,
+		PhoneVerified: user.PhoneVerifiedAt != nil,
+		Email:         user.Email,
+		Role:          strings.ToLower(user.Role.Code),
 	}
 
 	claims := Claims{
@@ -80,14 +111,231 @@ func (u *UserService) Login(ctx context.Context, req *dto.LoginRequest) (*dto.Lo
 	return &response, nil
 }
 
+// OIDCAuthorize implements IUserService.
+func (u *UserService) OIDCAuthorize(ctx context.Context, provider string) (*dto.OIDCAuthorizeResponse, error) {
+	authorization, err := u.oidc.Authorize(ctx, provider, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return toOIDCAuthorizeResponse(authorization), nil
+}
+
+// OIDCLogin implements IUserService.
+// Identitas yang sudah pernah login langsung dipakai. Identitas baru dihubungkan ke user
+// dengan email terverifikasi yang sama, atau dibuatkan user customer baru, tetapi hanya
+// jika email tersebut sudah diverifikasi oleh provider.
+func (u *UserService) OIDCLogin(ctx context.Context, provider string, req *dto.OIDCCallbackRequest) (*dto.LoginResponse, error) {
+	identity, err := u.oidc.Callback(ctx, provider, req.Code, req.State, req.Binding, "")
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := u.repository.GetIdentity().FindUser(ctx, identity.Provider, identity.Subject)
+	if err != nil && !errors.Is(err, errConst.ErrUserNotFound) {
+		return nil, err
+	}
+
+	if user == nil {
+		user, err = u.linkIdentity(ctx, identity)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// akun yang dinonaktifkan admin tidak boleh login
+	if !user.IsActive {
+		return nil, errConst.ErrUserDeactivated
+	}
+
+	return u.generateToken(user)
+}
+
+// linkIdentity menghubungkan identitas baru ke user dengan email terverifikasi yang sama,
+// atau membuat user baru jika email tersebut belum terdaftar. Email dari registrasi
+// biasa tidak pernah diverifikasi, sehingga siapa pun bisa mendaftar dengan email orang
+// lain; user seperti itu harus login dengan password lalu menghubungkan provider lewat
+// OIDCLink.
+func (u *UserService) linkIdentity(ctx context.Context, identity *oidc.Identity) (*models.User, error) {
+	if identity.Email == "" || !identity.EmailVerified {
+		return nil, errConst.ErrOIDCEmailNotVerified
+	}
+
+	userIdentity := &models.UserIdentity{
+		Provider: identity.Provider,
+		Subject:  identity.Subject,
+		Email:    identity.Email,
+	}
+
+	user, err := u.repository.GetUser().FindByEmail(ctx, identity.Email)
+	if err == nil {
+		if user.EmailVerifiedAt == nil {
+			return nil, errConst.ErrOIDCAccountExists
+		}
+		userIdentity.UserID = user.ID
+		err = u.repository.GetIdentity().Create(ctx, userIdentity)
+		if err != nil {
+			return nil, err
+		}
+		return user, nil
+	}
+	if !errors.Is(err, errConst.ErrUserNotFound) {
+		return nil, err
+	}
+
+	// user dari provider tidak punya password; password acak ini tidak pernah diberikan
+	// ke siapa pun sehingga login username/password tidak bisa dipakai
+	password, err := randomToken()
+	if err != nil {
+		return nil, err
+	}
+	hashedPW, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+
+	username, err := u.availableUsername(ctx, identity.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	name := identity.Name
+	if name == "" {
+		name = username
+	}
+
+	verifiedAt := time.Now()
+	user = &models.User{
+		UUID:            uuid.New(),
+		Name:            name,
+		Username:        username,
+		Password:        string(hashedPW),
+		Email:           identity.Email,
+		RoleId:          constants.Customer,
+		IsActive:        true,
+		EmailVerifiedAt: &verifiedAt,
+	}
+	err = u.repository.GetIdentity().CreateWithUser(ctx, user, userIdentity)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.repository.GetUser().FindByUUID(ctx, user.UUID.String())
+}
+
+// OIDCLinkAuthorize implements IUserService.
+// Sama seperti OIDCAuthorize, tetapi sesinya terikat ke user yang sedang login sehingga
+// callback-nya hanya bisa diselesaikan lewat OIDCLink oleh user yang sama.
+func (u *UserService) OIDCLinkAuthorize(ctx context.Context, provider string) (*dto.OIDCAuthorizeResponse, error) {
+	userLogin := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+
+	authorization, err := u.oidc.Authorize(ctx, provider, userLogin.UUID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return toOIDCAuthorizeResponse(authorization), nil
+}
+
+func toOIDCAuthorizeResponse(authorization *oidc.Authorization) *dto.OIDCAuthorizeResponse {
+	return &dto.OIDCAuthorizeResponse{
+		AuthorizationURL: authorization.URL,
+		State:            authorization.State,
+		Binding:          authorization.Binding,
+	}
+}
+
+// OIDCLink implements IUserService.
+// Menghubungkan identitas provider ke user yang sedang login. Jika provider membuktikan
+// email yang sama dengan email user, email tersebut ikut ditandai terverifikasi.
+func (u *UserService) OIDCLink(ctx context.Context, provider string, req *dto.OIDCCallbackRequest) error {
+	userLogin := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	uuid := userLogin.UUID.String()
+
+	identity, err := u.oidc.Callback(ctx, provider, req.Code, req.State, req.Binding, uuid)
+	if err != nil {
+		return err
+	}
+
+	user, err := u.repository.GetUser().FindByUUID(ctx, uuid)
+	if err != nil {
+		return err
+	}
+
+	linked, err := u.repository.GetIdentity().FindUser(ctx, identity.Provider, identity.Subject)
+	if err != nil && !errors.Is(err, errConst.ErrUserNotFound) {
+		return err
+	}
+	if linked != nil {
+		if linked.ID != user.ID {
+			return errConst.ErrOIDCIdentityLinked
+		}
+		return nil
+	}
+
+	err = u.repository.GetIdentity().Create(ctx, &models.UserIdentity{
+		UserID:   user.ID,
+		Provider: identity.Provider,
+		Subject:  identity.Subject,
+		Email:    identity.Email,
+	})
+	if err != nil {
+		return err
+	}
+
+	if user.EmailVerifiedAt == nil && identity.EmailVerified && identity.Email == strings.ToLower(user.Email) {
+		verifiedAt := time.Now()
+		return u.repository.GetUser().UpdateEmailVerification(ctx, uuid, &verifiedAt)
+	}
+
+	return nil
+}
+
+// availableUsername membuat username dari bagian depan email, ditambah angka acak jika
+// username tersebut sudah dipakai.
+func (u *UserService) availableUsername(ctx context.Context, email string) (string, error) {
+	base := usernamePattern.ReplaceAllString(strings.ToLower(strings.Split(email, "@")[0]), "")
+	if len(base) > maxUsernameBase {
+		base = base[:maxUsernameBase]
+	}
+	if base == "" {
+		base = "user"
+	}
+
+	if !u.ifUsernameExist(ctx, base) {
+		return base, nil
+	}
+	for i := 0; i < usernameAttempts; i++ {
+		suffix, err := rand.Int(rand.Reader, big.NewInt(100000))
+		if err != nil {
+			return "", err
+		}
+		username := fmt.Sprintf("%s_%05d", base, suffix.Int64())
+		if !u.ifUsernameExist(ctx, username) {
+			return username, nil
+		}
+	}
+
+	return "", errConst.ErrUsernameExist
+}
+
+func randomToken() (string, error) {
+	buffer := make([]byte, 32)
+	_, err := rand.Read(buffer)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buffer), nil
+}
+
 func (u *UserService) ifUsernameExist(ctx context.Context, username string) bool {
 	user, err := u.repository.GetUser().FindByUsername(ctx, username)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
+		if errors.Is(err, errConst.ErrUserNotFound) || errors.Is(err, gorm.ErrRecordNotFound) {
 			return false // Username belum ada di database
 		}
-		log.Println("Error checking username:", err) // Log jika ada error lain
-		return false                                 // Menghindari kesalahan fatal
+		logger.FromContext(ctx).Errorf("gagal memeriksa username: %v", err)
+		return false // Menghindari kesalahan fatal
 	}
 
 	return user != nil
@@ -97,11 +345,11 @@ func (u *UserService) ifUsernameExist(ctx context.Context, username string) bool
 func (u *UserService) ifEmailExist(ctx context.Context, email string) bool {
 	user, err := u.repository.GetUser().FindByEmail(ctx, email)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
+		if errors.Is(err, errConst.ErrUserNotFound) || errors.Is(err, gorm.ErrRecordNotFound) {
 			return false // Email belum ada di database
 		}
-		log.Println("Error checking email:", err) // Log jika ada error lain
-		return false                              // Menghindari kesalahan fatal
+		logger.FromContext(ctx).Errorf("gagal memeriksa email: %v", err)
+		return false // Menghindari kesalahan fatal
 	}
 
 	return user != nil
@@ -126,18 +374,22 @@ func (u *UserService) Register(ctx context.Context, req *dto.RegisterRequest) (*
 		return nil, errConst.ErrPasswordDoesNotMatch
 	}
 
+	phoneNumber, err := phone.Normalize(req.PhoneNumber, config.Config.PhoneCountryCode)
+	if err != nil {
+		return nil, err
+	}
+
 	reqUser := &dto.RegisterRequest{
 		Name:        req.Name,
 		Username:    req.Username,
 		Password:    string(hashedPW),
 		Email:       req.Email,
-		PhoneNumber: req.PhoneNumber,
+		PhoneNumber: phoneNumber,
 		RoleId:      constants.Customer,
 	}
 
 	user, err := u.repository.GetUser().Register(ctx, reqUser)
 	if err != nil {
-		log.Println("Error saat menyimpan user:", err)
 		return nil, err
 	}
 
@@ -154,15 +406,17 @@ func (u *UserService) Register(ctx context.Context, req *dto.RegisterRequest) (*
 	return response, nil
 }
 
-// Update implements IUserService.
-func (u *UserService) Update(ctx context.Context, req *dto.UpdateRequest, uuid string) (*dto.UserResponse, error) {
+// UpdateProfile implements IUserService.
+// Hanya mengubah data user yang sedang login. Penggantian password wajib menyertakan
+// password lama, supaya token yang bocor tidak cukup untuk mengambil alih akun.
+func (u *UserService) UpdateProfile(ctx context.Context, req *dto.UpdateRequest) (*dto.UserResponse, error) {
 	var (
-		password                  string
-		checkUsername, checkEmail *models.User
-		hashedPW                  []byte
-		user, userResult          *models.User
-		err                       error
-		data                      dto.UserResponse
+		userLogin  = ctx.Value(constants.UserLogin).(*dto.UserResponse)
+		uuid       = userLogin.UUID.String()
+		password   *string
+		user       *models.User
+		userResult *models.User
+		err        error
 	)
 
 	user, err = u.repository.GetUser().FindByUUID(ctx, uuid)
@@ -170,47 +424,64 @@ func (u *UserService) Update(ctx context.Context, req *dto.UpdateRequest, uuid s
 		return nil, err
 	}
 
-	IsUsernameExist := u.ifUsernameExist(ctx, req.Username)
-	if IsUsernameExist && user.Username != req.Username {
-		checkUsername, err = u.repository.GetUser().FindByUsername(ctx, req.Username)
-		if err != nil {
-			return nil, err
-		}
-
-		if checkUsername != nil {
-			return nil, errConst.ErrUsernameExist
-		}
+	if user.Username != req.Username && u.ifUsernameExist(ctx, req.Username) {
+		return nil, errConst.ErrUsernameExist
 	}
 
-	IsEmailExist := u.ifEmailExist(ctx, req.Email)
-	if IsEmailExist && user.Email != req.Email {
-		checkEmail, err = u.repository.GetUser().FindByEmail(ctx, req.Email)
-		if err != nil {
-			return nil, err
-		}
+	if user.Email != req.Email && u.ifEmailExist(ctx, req.Email) {
+		return nil, errConst.ErrEmailExist
+	}
 
-		if checkEmail != nil {
-			return nil, errConst.ErrEmailExist
-		}
+	phoneNumber, err := phone.Normalize(req.PhoneNumber, config.Config.PhoneCountryCode)
+	if err != nil {
+		return nil, err
 	}
 
 	if req.Password != nil {
-		if *req.Password != *req.ConfirmPassword {
+		if req.CurrentPassword == nil {
+			return nil, errConst.ErrCurrentPassword
+		}
+		err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(*req.CurrentPassword))
+		if err != nil {
+			return nil, errConst.ErrPasswordIncorrect
+		}
+		if req.ConfirmPassword == nil || *req.Password != *req.ConfirmPassword {
 			return nil, errConst.ErrPasswordDoesNotMatch
 		}
-		hashedPW, err = bcrypt.GenerateFromPassword([]byte(*req.Password), bcrypt.DefaultCost)
+
+		hashedPW, err := bcrypt.GenerateFromPassword([]byte(*req.Password), bcrypt.DefaultCost)
 		if err != nil {
 			return nil, err
 		}
-		password = string(hashedPW)
+		hashed := string(hashedPW)
+		password = &hashed
 	}
 
 	userReq := &dto.UpdateRequest{
 		Name:        req.Name,
 		Username:    req.Username,
-		Password:    &password,
+		Password:    password,
 		Email:       req.Email,
-		PhoneNumber: req.PhoneNumber,
+		PhoneNumber: phoneNumber,
+	}
+
+	// email baru belum terbukti milik user, jadi tidak boleh lagi dipakai untuk
+	// menghubungkan login provider secara otomatis
+	if user.Email != req.Email && user.EmailVerifiedAt != nil {
+		err = u.repository.GetUser().UpdateEmailVerification(ctx, uuid, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// nomor baru harus diverifikasi ulang; status verifikasi dihapus lebih dulu supaya
+	// nomor yang belum diverifikasi tidak pernah tersimpan sebagai terverifikasi
+	phoneVerified := user.PhoneVerifiedAt != nil && user.PhoneNumber == phoneNumber
+	if user.PhoneNumber != phoneNumber {
+		err = u.repository.GetUser().UpdatePhoneVerification(ctx, uuid, phoneNumber, nil)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	userResult, err = u.repository.GetUser().Update(ctx, userReq, uuid)
@@ -218,16 +489,259 @@ func (u *UserService) Update(ctx context.Context, req *dto.UpdateRequest, uuid s
 		return nil, err
 	}
 
-	data = dto.UserResponse{
-		UUID:        user.UUID,
-		Name:        userResult.Name,
-		Username:    userResult.Username,
-		Email:       userResult.Email,
-		PhoneNumber: userResult.PhoneNumber,
+	data := dto.UserResponse{
+		UUID:          user.UUID,
+		Name:          userResult.Name,
+		Username:      userResult.Username,
+		Email:         userResult.Email,
+		Role:          strings.ToLower(user.Role.Code),
+		PhoneNumber:   userResult.PhoneNumber,
+		PhoneVerified: phoneVerified,
 	}
 
 	return &data, nil
+}
+
+// GetActiveUser implements IUserService.
+// Dipakai middleware untuk memuat ulang user pemilik token di setiap request, sehingga
+// user yang dinonaktifkan langsung ditolak dan perubahan role langsung berlaku tanpa
+// menunggu token kedaluwarsa.
+func (u *UserService) GetActiveUser(ctx context.Context, uuid string) (*dto.UserResponse, error) {
+	user, err := u.repository.GetUser().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.IsActive {
+		return nil, errConst.ErrUserDeactivated
+	}
+
+	return &dto.UserResponse{
+		UUID:          user.UUID,
+		Name:          user.Name,
+		Username:      user.Username,
+		Email:         user.Email,
+		Role:          strings.ToLower(user.Role.Code),
+		PhoneNumber:   user.PhoneNumber,
+		PhoneVerified: user.PhoneVerifiedAt != nil,
+	}, nil
+}
+
+// SendPhoneVerification implements IUserService.
+// Mengirim OTP ke nomor telepon user yang sedang login. Nomor yang sudah diverifikasi
+// user lain tidak bisa diverifikasi lagi.
+func (u *UserService) SendPhoneVerification(ctx context.Context) error {
+	user, phoneNumber, err := u.findPhoneToVerify(ctx)
+	if err != nil {
+		return err
+	}
+
+	if user.PhoneVerifiedAt != nil && user.PhoneNumber == phoneNumber {
+		return errConst.ErrPhoneAlreadyVerified
+	}
+
+	return u.otp.Send(ctx, otp.VerifyPhone, phoneNumber)
+}
+
+// VerifyPhone implements IUserService.
+func (u *UserService) VerifyPhone(ctx context.Context, req *dto.VerifyPhoneRequest) (*dto.UserResponse, error) {
+	user, phoneNumber, err := u.findPhoneToVerify(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.otp.Verify(ctx, otp.VerifyPhone, phoneNumber, req.Code)
+	if err != nil {
+		return nil, err
+	}
 
+	now := time.Now()
+	err = u.repository.GetUser().UpdatePhoneVerification(ctx, user.UUID.String(), phoneNumber, &now)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.UserResponse{
+		UUID:          user.UUID,
+		Name:          user.Name,
+		Username:      user.Username,
+		Email:         user.Email,
+		Role:          strings.ToLower(user.Role.Code),
+		PhoneNumber:   phoneNumber,
+		PhoneVerified: true,
+	}, nil
+}
+
+// findPhoneToVerify mengambil user yang sedang login beserta nomor teleponnya dalam format
+// E.164. Nomor lama yang tersimpan sebelum ada normalisasi ikut dinormalisasi di sini.
+func (u *UserService) findPhoneToVerify(ctx context.Context) (*models.User, string, error) {
+	userLogin := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	user, err := u.repository.GetUser().FindByUUID(ctx, userLogin.UUID.String())
+	if err != nil {
+		return nil, "", err
+	}
+
+	phoneNumber, err := phone.Normalize(user.PhoneNumber, config.Config.PhoneCountryCode)
+	if err != nil {
+		return nil, "", err
+	}
+
+	owner, err := u.repository.GetUser().FindByVerifiedPhone(ctx, phoneNumber)
+	if err != nil && !errors.Is(err, errConst.ErrUserNotFound) {
+		return nil, "", err
+	}
+	if owner != nil && owner.UUID != user.UUID {
+		return nil, "", errConst.ErrPhoneNumberExist
+	}
+
+	return user, phoneNumber, nil
+}
+
+// RequestLoginOTP implements IUserService.
+// Respons selalu berhasil walaupun nomor tidak terdaftar atau belum diverifikasi, supaya
+// endpoint ini tidak bisa dipakai untuk menebak nomor telepon yang terdaftar.
+func (u *UserService) RequestLoginOTP(ctx context.Context, req *dto.OTPRequest) error {
+	phoneNumber, err := phone.Normalize(req.PhoneNumber, config.Config.PhoneCountryCode)
+	if err != nil {
+		return err
+	}
+
+	user, err := u.repository.GetUser().FindByVerifiedPhone(ctx, phoneNumber)
+	if err != nil {
+		if errors.Is(err, errConst.ErrUserNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if !user.IsActive {
+		return nil
+	}
+
+	return u.otp.Send(ctx, otp.Login, phoneNumber)
+}
+
+// LoginWithOTP implements IUserService.
+// Kode diperiksa sebelum mencari user sehingga batas percobaan tetap berlaku untuk
+// nomor yang tidak terdaftar.
+func (u *UserService) LoginWithOTP(ctx context.Context, req *dto.OTPLoginRequest) (*dto.LoginResponse, error) {
+	phoneNumber, err := phone.Normalize(req.PhoneNumber, config.Config.PhoneCountryCode)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.otp.Verify(ctx, otp.Login, phoneNumber, req.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := u.repository.GetUser().FindByVerifiedPhone(ctx, phoneNumber)
+	if err != nil {
+		if errors.Is(err, errConst.ErrUserNotFound) {
+			return nil, errConst.ErrOTPInvalid
+		}
+		return nil, err
+	}
+
+	// akun yang dinonaktifkan admin tidak boleh login
+	if !user.IsActive {
+		return nil, errConst.ErrUserDeactivated
+	}
+
+	return u.generateToken(user)
+}
+
+// GetAllWithPagination implements IUserService.
+func (u *UserService) GetAllWithPagination(ctx context.Context, param *dto.UserRequestParam) (*util.PaginationResult, error) {
+	users, total, err := u.repository.GetUser().FindAllWithPagination(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]dto.UserResponse, 0, len(users))
+	for i := range users {
+		data = append(data, toAdminUserResponse(&users[i]))
+	}
+
+	response := util.GeneratePagination(util.PaginationParam{
+		Count: total,
+		Page:  param.Page,
+		Limit: param.Limit,
+		Data:  data,
+	})
+	return &response, nil
+}
+
+// UpdateRole implements IUserService.
+func (u *UserService) UpdateRole(ctx context.Context, uuid string, req *dto.UpdateRoleRequest) (*dto.UserResponse, error) {
+	user, err := u.findManagedUser(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := u.repository.GetUser().FindRoleByCode(ctx, req.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.repository.GetUser().UpdateRole(ctx, uuid, role.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	user.RoleId = role.ID
+	user.Role = *role
+	response := toAdminUserResponse(user)
+	return &response, nil
+}
+
+// UpdateStatus implements IUserService.
+// User yang dinonaktifkan tidak bisa login, dan token yang masih berlaku ikut ditolak
+// oleh middleware.
+func (u *UserService) UpdateStatus(ctx context.Context, uuid string, req *dto.UpdateStatusRequest) (*dto.UserResponse, error) {
+	user, err := u.findManagedUser(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.repository.GetUser().UpdateStatus(ctx, uuid, *req.Active)
+	if err != nil {
+		return nil, err
+	}
+
+	user.IsActive = *req.Active
+	response := toAdminUserResponse(user)
+	return &response, nil
+}
+
+// findManagedUser mencari user yang akan diubah admin. Admin tidak boleh mengubah role
+// atau status akunnya sendiri supaya tidak ada admin yang mengunci dirinya sendiri.
+func (u *UserService) findManagedUser(ctx context.Context, uuid string) (*models.User, error) {
+	user, err := u.repository.GetUser().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	userLogin := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	if userLogin.UUID == user.UUID {
+		return nil, errConst.ErrCannotManageSelf
+	}
+
+	return user, nil
+}
+
+func toAdminUserResponse(user *models.User) dto.UserResponse {
+	isActive := user.IsActive
+	return dto.UserResponse{
+		UUID:          user.UUID,
+		Name:          user.Name,
+		Username:      user.Username,
+		Email:         user.Email,
+		Role:          strings.ToLower(user.Role.Code),
+		PhoneNumber:   user.PhoneNumber,
+		PhoneVerified: user.PhoneVerifiedAt != nil,
+		IsActive:      &isActive,
+	}
 }
 
 // GetUserLogin implements IUserService.
@@ -239,12 +753,13 @@ func (u *UserService) GetUserLogin(ctx context.Context) (*dto.UserResponse, erro
 	)
 
 	data = dto.UserResponse{
-		UUID:        userLogin.UUID,
-		Name:        userLogin.Name,
-		Username:    userLogin.Username,
-		Email:       userLogin.Email,
-		Role:        userLogin.Role,
-		PhoneNumber: userLogin.PhoneNumber,
+		UUID:          userLogin.UUID,
+		Name:          userLogin.Name,
+		Username:      userLogin.Username,
+		Email:         userLogin.Email,
+		Role:          userLogin.Role,
+		PhoneNumber:   userLogin.PhoneNumber,
+		PhoneVerified: userLogin.PhoneVerified,
 	}
 
 	return &data, nil
@@ -258,12 +773,36 @@ func (u *UserService) GetUserUUID(ctx context.Context, uuid string) (*dto.UserRe
 	}
 
 	data := dto.UserResponse{
-		UUID:        user.UUID,
-		Name:        user.Name,
-		Username:    user.Username,
-		Email:       user.Email,
-		PhoneNumber: user.PhoneNumber,
+		UUID:          user.UUID,
+		Name:          user.Name,
+		Username:      user.Username,
+		Email:         user.Email,
+		PhoneNumber:   user.PhoneNumber,
+		PhoneVerified: user.PhoneVerifiedAt != nil,
 	}
 
 	return &data, nil
 }
+
+// GetUsersByUUIDs implements IUserService.
+// Dipakai service lain untuk mengambil banyak user sekaligus agar tidak memanggil GetUserUUID satu per satu.
+func (u *UserService) GetUsersByUUIDs(ctx context.Context, req *dto.BatchUserRequest) ([]dto.UserResponse, error) {
+	users, err := u.repository.GetUser().FindByUUIDs(ctx, req.UUIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]dto.UserResponse, 0, len(users))
+	for _, user := range users {
+		data = append(data, dto.UserResponse{
+			UUID:          user.UUID,
+			Name:          user.Name,
+			Username:      user.Username,
+			Email:         user.Email,
+			PhoneNumber:   user.PhoneNumber,
+			PhoneVerified: user.PhoneVerifiedAt != nil,
+		})
+	}
+
+	return data, nil
+}